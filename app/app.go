@@ -26,6 +26,10 @@ import (
 	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
 	"github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/sidechannel"
+	sidechannelTypes "github.com/maticnetwork/heimdall/sidechannel/types"
+	"github.com/maticnetwork/heimdall/slashing"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
 	"github.com/maticnetwork/heimdall/staking"
 	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
 	"github.com/maticnetwork/heimdall/supply"
@@ -56,6 +60,8 @@ var (
 		checkpoint.AppModuleBasic{},
 		bor.AppModuleBasic{},
 		clerk.AppModuleBasic{},
+		sidechannel.AppModuleBasic{},
+		slashing.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -81,14 +87,16 @@ type HeimdallApp struct {
 	subspaces map[string]params.Subspace
 
 	// keepers
-	AccountKeeper    auth.AccountKeeper
-	BankKeeper       bank.Keeper
-	SupplyKeeper     supply.Keeper
-	GovKeeper        gov.Keeper
-	CheckpointKeeper checkpoint.Keeper
-	StakingKeeper    staking.Keeper
-	BorKeeper        bor.Keeper
-	ClerkKeeper      clerk.Keeper
+	AccountKeeper     auth.AccountKeeper
+	BankKeeper        bank.Keeper
+	SupplyKeeper      supply.Keeper
+	GovKeeper         gov.Keeper
+	CheckpointKeeper  checkpoint.Keeper
+	StakingKeeper     staking.Keeper
+	BorKeeper         bor.Keeper
+	ClerkKeeper       clerk.Keeper
+	SidechannelKeeper sidechannel.Keeper
+	SlashingKeeper    slashing.Keeper
 
 	// param keeper
 	ParamsKeeper params.Keeper
@@ -119,6 +127,21 @@ func (d CrossCommunicator) GetACKCount(ctx sdk.Context) uint64 {
 	return d.App.CheckpointKeeper.GetACKCount(ctx)
 }
 
+// GetConfirmationBlocks returns number of mainchain confirmations required for a mainchain tx
+func (d CrossCommunicator) GetConfirmationBlocks(ctx sdk.Context) uint64 {
+	return d.App.CheckpointKeeper.GetParams(ctx).ConfirmationBlocks
+}
+
+// RefundRelayerFee refunds fee collected from relayer of current tx
+func (d CrossCommunicator) RefundRelayerFee(ctx sdk.Context) (types.HeimdallAddress, sdk.Error) {
+	relayer, fee := auth.GetCollectedFee(ctx)
+	if relayer.Empty() || fee.IsZero() {
+		return relayer, nil
+	}
+
+	return relayer, d.App.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, authTypes.FeeCollectorName, relayer, fee)
+}
+
 // IsCurrentValidatorByAddress check if validator is current validator
 func (d CrossCommunicator) IsCurrentValidatorByAddress(ctx sdk.Context, address []byte) bool {
 	return d.App.StakingKeeper.IsCurrentValidatorByAddress(ctx, address)
@@ -156,6 +179,8 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		checkpointTypes.StoreKey,
 		borTypes.StoreKey,
 		clerkTypes.StoreKey,
+		sidechannelTypes.StoreKey,
+		slashingTypes.StoreKey,
 		params.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(params.TStoreKey)
@@ -179,6 +204,8 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 	app.subspaces[checkpointTypes.ModuleName] = app.ParamsKeeper.Subspace(checkpointTypes.DefaultParamspace)
 	app.subspaces[borTypes.ModuleName] = app.ParamsKeeper.Subspace(borTypes.DefaultParamspace)
 	app.subspaces[clerkTypes.ModuleName] = app.ParamsKeeper.Subspace(clerkTypes.DefaultParamspace)
+	app.subspaces[sidechannelTypes.ModuleName] = app.ParamsKeeper.Subspace(sidechannelTypes.DefaultParamspace)
+	app.subspaces[slashingTypes.ModuleName] = app.ParamsKeeper.Subspace(slashingTypes.DefaultParamspace)
 
 	//
 	// Contract caller
@@ -217,6 +244,22 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		crossCommunicator,
 	)
 
+	// slashing keeper refers to staking keeper, so it sees hooks set below
+	app.SlashingKeeper = slashing.NewKeeper(
+		app.cdc,
+		keys[slashingTypes.StoreKey], // target store
+		app.subspaces[slashingTypes.ModuleName],
+		slashingTypes.DefaultCodespace,
+		&app.StakingKeeper,
+	)
+
+	// staking hooks must be set before staking keeper is passed to other keepers
+	app.StakingKeeper.SetHooks(
+		stakingTypes.NewMultiStakingHooks(
+			app.SlashingKeeper.Hooks(),
+		),
+	)
+
 	// bank keeper
 	app.BankKeeper = bank.NewKeeper(
 		app.cdc,
@@ -255,6 +298,13 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		app.StakingKeeper,
 	)
 
+	// checkpoint proposer rotation is done by staking
+	app.CheckpointKeeper = *app.CheckpointKeeper.SetHooks(
+		checkpointTypes.NewMultiCheckpointHooks(
+			app.StakingKeeper.CheckpointHooks(),
+		),
+	)
+
 	app.BorKeeper = bor.NewKeeper(
 		app.cdc,
 		keys[borTypes.StoreKey], // target store
@@ -269,6 +319,20 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		keys[clerkTypes.StoreKey], // target store
 		app.subspaces[clerkTypes.ModuleName],
 		common.DefaultCodespace,
+		crossCommunicator,
+	)
+
+	// side and post tx handlers for msgs which depend on external chains,
+	// routes are added from side modules once module manager is created
+	sideRouter := sidechannelTypes.NewRouter()
+
+	app.SidechannelKeeper = sidechannel.NewKeeper(
+		app.cdc,
+		keys[sidechannelTypes.StoreKey], // target store
+		app.subspaces[sidechannelTypes.ModuleName],
+		sidechannelTypes.DefaultCodespace,
+		app.StakingKeeper,
+		sideRouter,
 	)
 
 	// NOTE: Any module instantiated in the module manager that is later modified
@@ -281,6 +345,8 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		checkpoint.NewAppModule(app.CheckpointKeeper, &app.caller),
 		bor.NewAppModule(app.BorKeeper, &app.caller),
 		clerk.NewAppModule(app.ClerkKeeper, &app.caller),
+		sidechannel.NewAppModule(app.SidechannelKeeper),
+		slashing.NewAppModule(app.SlashingKeeper),
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -293,10 +359,25 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		checkpointTypes.ModuleName,
 		borTypes.ModuleName,
 		clerkTypes.ModuleName,
+		sidechannelTypes.ModuleName,
+		slashingTypes.ModuleName,
 	)
 
 	// register message routes and query routes
-	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
+	// side tx msgs accepted by module handlers are held by sidechannel until validators vote on them
+	for _, m := range app.mm.Modules {
+		if m.Route() != "" {
+			app.Router().AddRoute(m.Route(), sidechannel.NewSideTxMsgHandler(app.SidechannelKeeper, m.NewHandler()))
+
+			if sideModule, ok := m.(types.SideModule); ok {
+				sideRouter.AddRoute(m.Route(), sideModule.NewSideTxHandler(), sideModule.NewPostTxHandler())
+			}
+		}
+		if m.QuerierRoute() != "" {
+			app.QueryRouter().AddRoute(m.QuerierRoute(), m.NewQuerierHandler())
+		}
+	}
+	sideRouter.Seal()
 
 	// register message routes
 	// app.Router().
@@ -327,7 +408,6 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		auth.NewAnteHandler(
 			app.AccountKeeper,
 			app.SupplyKeeper,
-			&app.caller,
 			auth.DefaultSigVerificationGasConsumer,
 		),
 	)
@@ -356,6 +436,8 @@ func MakeCodec() *codec.Codec {
 	stakingTypes.RegisterCodec(cdc)
 	borTypes.RegisterCodec(cdc)
 	clerkTypes.RegisterCodec(cdc)
+	sidechannelTypes.RegisterCodec(cdc)
+	slashingTypes.RegisterCodec(cdc)
 
 	cdc.Seal()
 	return cdc
@@ -371,6 +453,8 @@ func MakePulp() *authTypes.Pulp {
 	checkpointTypes.RegisterPulp(pulp)
 	borTypes.RegisterPulp(pulp)
 	clerkTypes.RegisterPulp(pulp)
+	sidechannelTypes.RegisterPulp(pulp)
+	slashingTypes.RegisterPulp(pulp)
 
 	return pulp
 }
@@ -389,25 +473,24 @@ func (app *HeimdallApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain)
 	// get validator updates
 	app.mm.InitGenesis(ctx, genesisState)
 
-	stakingState := stakingTypes.GetGenesisStateFromAppState(genesisState)
-	checkpointState := checkpointTypes.GetGenesisStateFromAppState(genesisState)
+	// remove validators which are not current at genesis ack count from validator set
+	if _, err := app.StakingKeeper.ApplyValidatorSetUpdates(ctx); err != nil {
+		panic(err)
+	}
 
-	// check if validator is current validator
-	// add to val updates else skip
+	// send validator set to tendermint
+	validatorSet := app.StakingKeeper.GetValidatorSet(ctx)
 	var valUpdates []abci.ValidatorUpdate
-	for _, validator := range stakingState.Validators {
-		if validator.IsCurrentValidator(checkpointState.AckCount) {
-			// convert to Validator Update
-			updateVal := abci.ValidatorUpdate{
-				Power:  int64(validator.VotingPower),
-				PubKey: validator.PubKey.ABCIPubKey(),
-			}
-			// Add validator to validator updated to be processed below
-			valUpdates = append(valUpdates, updateVal)
+	for _, validator := range validatorSet.Validators {
+		// convert to Validator Update
+		updateVal := abci.ValidatorUpdate{
+			Power:  int64(validator.VotingPower),
+			PubKey: validator.PubKey.ABCIPubKey(),
 		}
+		// Add validator to validator updated to be processed below
+		valUpdates = append(valUpdates, updateVal)
 	}
 
-	// TODO make sure old validtors dont go in validator updates ie deactivated validators have to be removed
 	// udpate validators
 	return abci.ResponseInitChain{
 		// validator updates
@@ -431,11 +514,29 @@ func (app *HeimdallApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock
 		ctx,
 		types.BytesToHeimdallAddress(req.Header.GetProposerAddress()),
 	)
+
+	// migrate decimal store keys written by older versions on first block of this version
+	app.migrateStoreKeys(ctx)
+
 	return app.mm.BeginBlock(ctx, req)
 }
 
+// migrateStoreKeys moves headers, spans and event records from decimal keys to big endian keys.
+// Each store keeps a migrated flag in state, so migration runs once and at the same height on every node.
+func (app *HeimdallApp) migrateStoreKeys(ctx sdk.Context) {
+	headers := app.CheckpointKeeper.MigrateHeaderKeys(ctx)
+	spans := app.BorKeeper.MigrateSpanKeys(ctx)
+	records := app.ClerkKeeper.MigrateEventRecordKeys(ctx)
+	if headers+spans+records > 0 {
+		logger.Info("Migrated legacy store keys", "headers", headers, "spans", spans, "eventRecords", records)
+	}
+}
+
 // EndBlocker executes on each end block
 func (app *HeimdallApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+	// run module end blockers (drops expired side txs, updates validator set)
+	res := app.mm.EndBlock(ctx, req)
+
 	// transfer fees to current proposer
 	if proposer, ok := app.AccountKeeper.GetBlockProposer(ctx); ok {
 		moduleAccount := app.SupplyKeeper.GetModuleAccount(ctx, authTypes.FeeCollectorName)
@@ -449,46 +550,10 @@ func (app *HeimdallApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) ab
 		app.AccountKeeper.RemoveBlockProposer(ctx)
 	}
 
-	var tmValUpdates []abci.ValidatorUpdate
-	if ctx.BlockHeader().NumTxs > 0 {
-		// --- Start update to new validators
-		currentValidatorSet := app.StakingKeeper.GetValidatorSet(ctx)
-		allValidators := app.StakingKeeper.GetAllValidators(ctx)
-		ackCount := app.CheckpointKeeper.GetACKCount(ctx)
-
-		// get validator updates
-		setUpdates := helper.GetUpdatedValidators(
-			&currentValidatorSet, // pointer to current validator set -- UpdateValidators will modify it
-			allValidators,        // All validators
-			ackCount,             // ack count
-		)
-
-		// create new validator set
-		if err := currentValidatorSet.UpdateWithChangeSet(setUpdates); err != nil {
-			// return with nothing
-			logger.Error("Unable to update current validator set", "Error", err)
-			return abci.ResponseEndBlock{}
-		}
-
-		// save set in store
-		if err := app.StakingKeeper.UpdateValidatorSetInStore(ctx, currentValidatorSet); err != nil {
-			// return with nothing
-			logger.Error("Unable to update current validator set in state", "Error", err)
-			return abci.ResponseEndBlock{}
-		}
-
-		// convert updates from map to array
-		for _, v := range setUpdates {
-			tmValUpdates = append(tmValUpdates, abci.ValidatorUpdate{
-				Power:  int64(v.VotingPower),
-				PubKey: v.PubKey.ABCIPubKey(),
-			})
-		}
-	}
-
-	// send validator updates to peppermint
+	// send validator updates from staking end blocker to peppermint
 	return abci.ResponseEndBlock{
-		ValidatorUpdates: tmValUpdates,
+		ValidatorUpdates: res.ValidatorUpdates,
+		Events:           res.Events,
 	}
 }
 
@@ -11,7 +11,6 @@ import (
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
-	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/types"
 )
 
@@ -35,6 +34,26 @@ var (
 	FeeWantedPerTx = types.Coins{types.Coin{Denom: authTypes.FeeToken, Amount: types.NewIntFromBigInt(feeInMatic)}}
 )
 
+// collectedFeeKey is context key for fee collected from fee payer of current tx
+type collectedFeeKey struct{}
+
+// collectedFee is fee collected from fee payer
+type collectedFee struct {
+	payer types.HeimdallAddress
+	fee   types.Coins
+}
+
+// WithCollectedFee returns context carrying fee collected from payer, handlers may refund it
+func WithCollectedFee(ctx sdk.Context, payer types.HeimdallAddress, fee types.Coins) sdk.Context {
+	return ctx.WithValue(collectedFeeKey{}, collectedFee{payer: payer, fee: fee})
+}
+
+// GetCollectedFee returns payer and fee collected by ante handler from fee payer of current tx
+func GetCollectedFee(ctx sdk.Context) (types.HeimdallAddress, types.Coins) {
+	collected, _ := ctx.Value(collectedFeeKey{}).(collectedFee)
+	return collected.payer, collected.fee
+}
+
 func init() {
 	// This decodes a valid hex string into a sepc256k1Pubkey for use in transaction simulation
 	bz, _ := hex.DecodeString("035AD6810A47F073553FF30D2FCC7E0D3B1C0B74B61A1AAA2582344037151E143A")
@@ -74,7 +93,6 @@ type MainTxMsg interface {
 func NewAnteHandler(
 	ak AccountKeeper,
 	feeCollector FeeCollector,
-	contractCaller helper.IContractCaller,
 	sigGasConsumer SignatureVerificationGasConsumer,
 ) sdk.AnteHandler {
 	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, res sdk.Result, abort bool) {
@@ -160,12 +178,9 @@ func NewAnteHandler(
 
 			// reload the account as fees have been deducted
 			signerAccs[0] = ak.GetAccount(newCtx, signerAccs[0].GetAddress())
-		}
 
-		// check main chain tx is confirmed transaction
-		mainTxMsg, ok := stdTx.Msg.(MainTxMsg)
-		if ok && !contractCaller.IsTxConfirmed(mainTxMsg.GetTxHash().EthHash()) {
-			return newCtx, sdk.ErrInternal(fmt.Sprintf("Not enough tx confirmations for %s", mainTxMsg.GetTxHash().Hex())).Result(), true
+			// keep collected fee, handlers may refund it
+			newCtx = WithCollectedFee(newCtx, signerAccs[0].GetAddress(), feeForTx)
 		}
 
 		// stdSigs contains the sequence number, account number, and signatures.
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -13,6 +14,7 @@ import (
 
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
 	bankTypes "github.com/maticnetwork/heimdall/bank/types"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	hmClient "github.com/maticnetwork/heimdall/client"
 	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/types"
@@ -112,12 +114,51 @@ func TopupTxCmd(cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("transaction hash has to be supplied")
 			}
 
+			logIndex := uint64(viper.GetInt64(FlagLogIndex))
+
+			contractCallerObj, err := helper.NewContractCaller()
+			if err != nil {
+				return err
+			}
+
+			// get confirmation blocks from checkpoint params
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", checkpointTypes.QuerierRoute, checkpointTypes.QueryParams), nil)
+			if err != nil {
+				return err
+			}
+
+			var checkpointParams checkpointTypes.Params
+			if err := json.Unmarshal(res, &checkpointParams); err != nil {
+				return err
+			}
+
+			// get main tx receipt
+			receipt, err := contractCallerObj.GetConfirmedTxReceipt(types.HexToHeimdallHash(txhash).EthHash(), checkpointParams.ConfirmationBlocks)
+			if err != nil || receipt == nil {
+				return errors.New("Transaction is not confirmed yet. Please for sometime and try again")
+			}
+
+			// get topup event
+			event, err := contractCallerObj.DecodeValidatorTopupFeesEvent(receipt, logIndex)
+			if err != nil {
+				return err
+			}
+
+			// get validator signer from mainchain
+			validator, err := contractCallerObj.GetValidatorInfo(types.NewValidatorID(uint64(validatorID)))
+			if err != nil {
+				return err
+			}
+
 			// build and sign the transaction, then broadcast to Tendermint
 			msg := bankTypes.NewMsgTopup(
 				proposer,
 				uint64(validatorID),
+				validator.Signer,
+				types.NewIntFromBigInt(event.Fee),
 				types.HexToHeimdallHash(txhash),
-				uint64(viper.GetInt64(FlagLogIndex)),
+				logIndex,
+				receipt.BlockNumber.Uint64(),
 			)
 
 			// broadcast msg with cli
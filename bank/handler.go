@@ -5,7 +5,6 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	"github.com/maticnetwork/heimdall/auth"
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
 	"github.com/maticnetwork/heimdall/bank/types"
 	hmCommon "github.com/maticnetwork/heimdall/common"
@@ -24,7 +23,7 @@ func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
 		case types.MsgMultiSend:
 			return handleMsgMultiSend(ctx, k, msg)
 		case types.MsgTopup:
-			return handleMsgTopup(ctx, k, msg, contractCaller)
+			return handleMsgTopup(ctx, k, msg)
 		case types.MsgWithdrawFee:
 			return handleMsgWithdrawFee(ctx, k, msg)
 		default:
@@ -82,98 +81,33 @@ func handleMsgMultiSend(ctx sdk.Context, k Keeper, msg types.MsgMultiSend) sdk.R
 }
 
 // Handle MsgMintFeeToken
-func handleMsgTopup(ctx sdk.Context, k Keeper, msg types.MsgTopup, contractCaller helper.IContractCaller) sdk.Result {
-	if !k.GetSendEnabled(ctx) {
-		return types.ErrSendDisabled(k.Codespace()).Result()
-	}
-
-	// get main tx receipt
-	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash())
-	if err != nil || receipt == nil {
-		return hmCommon.ErrWaitForConfirmation(k.Codespace()).Result()
-	}
-
-	// get event log for topup
-	eventLog, err := contractCaller.DecodeValidatorTopupFeesEvent(receipt, msg.LogIndex)
-	if err != nil || eventLog == nil {
-		k.Logger(ctx).Error("Error fetching log from txhash")
-		return hmCommon.ErrInvalidMsg(k.Codespace(), "Unable to fetch logs for txHash").Result()
-	}
-
-	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
-		k.Logger(ctx).Error("ID in message doesn't match id in logs", "MsgID", msg.ID, "IdFromTx", eventLog.ValidatorId)
-		return hmCommon.ErrInvalidMsg(k.Codespace(), "Invalid txhash and id don't match. Id from tx hash is %v", eventLog.ValidatorId.Uint64()).Result()
-	}
-
-	// fetch validator from mainchain
-	validator, err := contractCaller.GetValidatorInfo(msg.ID)
-	if err != nil {
-		k.Logger(ctx).Error(
-			"Unable to fetch validator from rootchain",
-			"error", err,
-		)
-		return hmCommon.ErrNoValidator(k.Codespace()).Result()
+func handleMsgTopup(ctx sdk.Context, k Keeper, msg types.MsgTopup) sdk.Result {
+	if err := validateTopup(ctx, k, msg); err != nil {
+		return err.Result()
 	}
 
-	// validator topup
-	topupObject, err := k.GetValidatorTopup(ctx, validator.Signer)
-	if err != nil {
-		return types.ErrNoValidatorTopup(k.Codespace()).Result()
+	// topup is applied by post tx handler once validators vote on it
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
 	}
+}
 
-	// create topup object
-	if topupObject == nil {
-		topupObject = &types.ValidatorTopup{
-			ID:          validator.ID,
-			TotalTopups: hmTypes.Coins{hmTypes.Coin{Denom: authTypes.FeeToken, Amount: hmTypes.NewInt(0)}},
-		}
+// validateTopup checks topup against current state
+func validateTopup(ctx sdk.Context, k Keeper, msg types.MsgTopup) sdk.Error {
+	if !k.GetSendEnabled(ctx) {
+		return types.ErrSendDisabled(k.Codespace())
 	}
 
-	// create topup amount
-	topupAmount := hmTypes.Coins{hmTypes.Coin{Denom: authTypes.FeeToken, Amount: hmTypes.NewIntFromBigInt(eventLog.Fee)}}
-
 	// sequence id
-	sequence := (receipt.BlockNumber.Uint64() * hmTypes.DefaultLogIndexUnit) + msg.LogIndex
+	sequence := (msg.BlockNumber * hmTypes.DefaultLogIndexUnit) + msg.LogIndex
 
 	// check if incoming tx already exists
 	if k.HasTopupSequence(ctx, sequence) {
 		k.Logger(ctx).Error("Older invalid tx found")
-		return hmCommon.ErrOldTx(k.Codespace()).Result()
+		return hmCommon.ErrOldTx(k.Codespace())
 	}
 
-	// add total topups amount
-	topupObject.TotalTopups = topupObject.TotalTopups.Add(topupAmount)
-
-	// increase coins in account
-	if _, ec := k.AddCoins(ctx, validator.Signer, topupAmount); ec != nil {
-		return ec.Result()
-	}
-
-	// transfer fees to sender (proposer)
-	if ec := k.SendCoins(ctx, validator.Signer, msg.FromAddress, auth.FeeWantedPerTx); ec != nil {
-		return ec.Result()
-	}
-
-	// save old validator
-	if err := k.SetValidatorTopup(ctx, validator.Signer, *topupObject); err != nil {
-		k.Logger(ctx).Error("Unable to update signer", "error", err, "validatorId", validator.ID)
-		return hmCommon.ErrSignerUpdateError(k.Codespace()).Result()
-	}
-	// save topup
-	k.SetTopupSequence(ctx, sequence)
-
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeTopup,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(uint64(msg.ID), 10)),
-			sdk.NewAttribute(types.AttributeKeyTopupAmount, strconv.FormatUint(eventLog.Fee.Uint64(), 10)),
-		),
-	})
-
-	return sdk.Result{
-		Events: ctx.EventManager().Events(),
-	}
+	return nil
 }
 
 // Handle MsgWithdrawFee.
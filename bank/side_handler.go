@@ -0,0 +1,154 @@
+package bank
+
+import (
+	"bytes"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/auth"
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/bank/types"
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// NewSideTxHandler returns side tx handler for bank module
+func NewSideTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxHandler {
+	return func(ctx sdk.Context, msg sdk.Msg) hmTypes.SideTxResultType {
+		switch msg := msg.(type) {
+		case types.MsgTopup:
+			return sideHandleMsgTopup(ctx, k, msg, contractCaller)
+		default:
+			return hmTypes.SideTxResultSkip
+		}
+	}
+}
+
+// NewPostTxHandler returns post tx handler for bank module
+func NewPostTxHandler(k Keeper) hmTypes.PostTxHandler {
+	return func(ctx sdk.Context, msg sdk.Msg, result hmTypes.SideTxResultType) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgTopup:
+			return postHandleMsgTopup(ctx, k, msg, result)
+		default:
+			return sdk.ErrTxDecode("Invalid message in bank module").Result()
+		}
+	}
+}
+
+// sideHandleMsgTopup validates topup event against mainchain
+func sideHandleMsgTopup(ctx sdk.Context, k Keeper, msg types.MsgTopup, contractCaller helper.IContractCaller) hmTypes.SideTxResultType {
+	// get main tx receipt
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), k.sk.GetConfirmationBlocks(ctx))
+	if err != nil || receipt == nil {
+		k.Logger(ctx).Error("Unable to fetch confirmed tx receipt", "error", err, "txHash", msg.TxHash)
+		return hmTypes.SideTxResultSkip
+	}
+
+	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+		k.Logger(ctx).Error("BlockNumber in message doesn't match block number in receipt", "MsgBlockNumber", msg.BlockNumber, "ReceiptBlockNumber", receipt.BlockNumber)
+		return hmTypes.SideTxResultNo
+	}
+
+	// get event log for topup
+	eventLog, err := contractCaller.DecodeValidatorTopupFeesEvent(receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Error fetching log from txhash")
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
+		k.Logger(ctx).Error("ID in message doesn't match id in logs", "MsgID", msg.ID, "IdFromTx", eventLog.ValidatorId)
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.Fee.Cmp(msg.Fee.BigInt()) != 0 {
+		k.Logger(ctx).Error("Fee in message doesn't match fee in logs", "MsgFee", msg.Fee, "FeeFromTx", eventLog.Fee)
+		return hmTypes.SideTxResultNo
+	}
+
+	// fetch validator from mainchain
+	validator, err := contractCaller.GetValidatorInfo(msg.ID)
+	if err != nil {
+		k.Logger(ctx).Error("Unable to fetch validator from rootchain", "error", err)
+		return hmTypes.SideTxResultSkip
+	}
+
+	if !bytes.Equal(validator.Signer.Bytes(), msg.Signer.Bytes()) {
+		k.Logger(ctx).Error("Signer in message doesn't match signer on rootchain", "MsgSigner", msg.Signer, "SignerFromChain", validator.Signer)
+		return hmTypes.SideTxResultNo
+	}
+
+	return hmTypes.SideTxResultYes
+}
+
+// postHandleMsgTopup adds voted topup to validator's fee balance
+func postHandleMsgTopup(ctx sdk.Context, k Keeper, msg types.MsgTopup, result hmTypes.SideTxResultType) sdk.Result {
+	if result != hmTypes.SideTxResultYes {
+		k.Logger(ctx).Debug("Skipping topup rejected by validators", "validatorId", msg.ID)
+		return sdk.Result{}
+	}
+
+	// state might have changed while validators were voting
+	if err := validateTopup(ctx, k, msg); err != nil {
+		return err.Result()
+	}
+
+	// validator topup
+	topupObject, err := k.GetValidatorTopup(ctx, msg.Signer)
+	if err != nil {
+		return types.ErrNoValidatorTopup(k.Codespace()).Result()
+	}
+
+	// create topup object
+	if topupObject == nil {
+		topupObject = &types.ValidatorTopup{
+			ID:          msg.ID,
+			TotalTopups: hmTypes.Coins{hmTypes.Coin{Denom: authTypes.FeeToken, Amount: hmTypes.NewInt(0)}},
+		}
+	}
+
+	// create topup amount
+	topupAmount := hmTypes.Coins{hmTypes.Coin{Denom: authTypes.FeeToken, Amount: msg.Fee}}
+
+	// sequence id
+	sequence := (msg.BlockNumber * hmTypes.DefaultLogIndexUnit) + msg.LogIndex
+
+	// add total topups amount
+	topupObject.TotalTopups = topupObject.TotalTopups.Add(topupAmount)
+
+	// increase coins in account
+	if _, ec := k.AddCoins(ctx, msg.Signer, topupAmount); ec != nil {
+		return ec.Result()
+	}
+
+	// transfer fees to sender (proposer)
+	if ec := k.SendCoins(ctx, msg.Signer, msg.FromAddress, auth.FeeWantedPerTx); ec != nil {
+		return ec.Result()
+	}
+
+	// save old validator
+	if err := k.SetValidatorTopup(ctx, msg.Signer, *topupObject); err != nil {
+		k.Logger(ctx).Error("Unable to update signer", "error", err, "validatorId", msg.ID)
+		return hmCommon.ErrSignerUpdateError(k.Codespace()).Result()
+	}
+	// save topup
+	k.SetTopupSequence(ctx, sequence)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTopup,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(uint64(msg.ID), 10)),
+			sdk.NewAttribute(types.AttributeKeyTopupAmount, msg.Fee.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
@@ -200,8 +200,11 @@ func ValidateInputsOutputs(inputs []Input, outputs []Output) sdk.Error {
 type MsgTopup struct {
 	FromAddress types.HeimdallAddress `json:"from_address"`
 	ID          types.ValidatorID     `json:"id"`
+	Signer      types.HeimdallAddress `json:"signer"`
+	Fee         types.Int             `json:"fee"`
 	TxHash      types.HeimdallHash    `json:"tx_hash"`
 	LogIndex    uint64                `json:"log_index"`
+	BlockNumber uint64                `json:"block_number"`
 }
 
 var _ sdk.Msg = MsgTopup{}
@@ -210,14 +213,20 @@ var _ sdk.Msg = MsgTopup{}
 func NewMsgTopup(
 	fromAddr types.HeimdallAddress,
 	id uint64,
+	signer types.HeimdallAddress,
+	fee types.Int,
 	txhash types.HeimdallHash,
 	logIndex uint64,
+	blockNumber uint64,
 ) MsgTopup {
 	return MsgTopup{
 		FromAddress: fromAddr,
 		ID:          types.NewValidatorID(id),
+		Signer:      signer,
+		Fee:         fee,
 		TxHash:      txhash,
 		LogIndex:    logIndex,
+		BlockNumber: blockNumber,
 	}
 }
 
@@ -241,6 +250,14 @@ func (msg MsgTopup) ValidateBasic() sdk.Error {
 		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.FromAddress.String())
 	}
 
+	if msg.Signer.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid signer %v", msg.Signer.String())
+	}
+
+	if msg.Fee.I == nil || !msg.Fee.IsPositive() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid fee %v", msg.Fee)
+	}
+
 	return nil
 }
 
@@ -254,6 +271,11 @@ func (msg MsgTopup) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{types.HeimdallAddressToAccAddress(msg.FromAddress)}
 }
 
+// IsSideTxMsg marks topup as side tx msg, topup event is validated by validators before fee gets added
+func (msg MsgTopup) IsSideTxMsg() bool {
+	return true
+}
+
 //
 // Fee token withdrawal
 //
@@ -97,12 +97,27 @@ func PostSendProposeSpanTx(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
+			// fetch seed
+			res, _, err = cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNextSpanSeed), nil)
+			if err != nil {
+				return err
+			}
+			if len(res) == 0 {
+				return errors.New("next span seed not found")
+			}
+
+			var seed hmTypes.HeimdallHash
+			if err := json.Unmarshal(res, &seed); err != nil {
+				return err
+			}
+
 			msg := types.NewMsgProposeSpan(
 				spanID,
 				proposer,
 				startBlock,
 				startBlock+spanDuration,
 				chainID,
+				seed,
 			)
 
 			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
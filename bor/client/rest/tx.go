@@ -67,6 +67,26 @@ func postProposeSpanHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
+		//
+		// Get next span seed
+		//
+
+		res, _, err = cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNextSpanSeed), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if len(res) == 0 {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, errors.New("Next span seed not found ").Error())
+			return
+		}
+
+		var seed hmTypes.HeimdallHash
+		if err := json.Unmarshal(res, &seed); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
 		// draft a propose span message
 		msg := types.NewMsgProposeSpan(
 			req.ID,
@@ -74,6 +94,7 @@ func postProposeSpanHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			req.StartBlock,
 			req.StartBlock+spanDuration,
 			req.BorChainID,
+			seed,
 		)
 
 		// send response
@@ -1,8 +1,6 @@
 package bor
 
 import (
-	"strconv"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/maticnetwork/heimdall/bor/types"
@@ -27,11 +25,23 @@ func NewHandler(k Keeper) sdk.Handler {
 func HandleMsgProposeSpan(ctx sdk.Context, msg types.MsgProposeSpan, k Keeper) sdk.Result {
 	k.Logger(ctx).Debug("Proposing span", "TxData", msg)
 
+	if err := validateProposeSpan(ctx, msg, k); err != nil {
+		return err.Result()
+	}
+
+	// span is frozen by post tx handler once validators vote on it
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// validateProposeSpan checks proposed span against current state
+func validateProposeSpan(ctx sdk.Context, msg types.MsgProposeSpan, k Keeper) sdk.Error {
 	// check if last span is up or if greater diff than threshold is found between validator set
 	lastSpan, err := k.GetLastSpan(ctx)
 	if err != nil {
 		k.Logger(ctx).Error("Unable to fetch last span", "Error", err)
-		return common.ErrSpanNotFound(k.Codespace()).Result()
+		return common.ErrSpanNotFound(k.Codespace())
 	}
 
 	// check all conditions
@@ -42,37 +52,8 @@ func HandleMsgProposeSpan(ctx sdk.Context, msg types.MsgProposeSpan, k Keeper) s
 			"spanId", msg.ID,
 			"spanStartBlock", msg.StartBlock,
 		)
-		return common.ErrSpanNotInCountinuity(k.Codespace()).Result()
+		return common.ErrSpanNotInCountinuity(k.Codespace())
 	}
 
-	// freeze for new span
-	err = k.FreezeSet(ctx, msg.ID, msg.StartBlock, msg.ChainID)
-	if err != nil {
-		k.Logger(ctx).Error("Unable to freeze validator set for span", "Error", err)
-		return common.ErrUnableToFreezeValSet(k.Codespace()).Result()
-	}
-
-	// get last span
-	lastSpan, err = k.GetLastSpan(ctx)
-	if err != nil {
-		k.Logger(ctx).Error("Unable to fetch last span", "Error", err)
-		return common.ErrSpanNotFound(k.Codespace()).Result()
-	}
-
-	// add events
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeProposeSpan,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(types.AttributeKeySuccess, "true"),
-			sdk.NewAttribute(types.AttributeKeyBorSyncID, strconv.FormatUint(uint64(msg.ID), 10)),
-			sdk.NewAttribute(sdk.AttributeKeyAmount, strconv.FormatUint(uint64(msg.ID), 10)),
-			sdk.NewAttribute(types.AttributeKeySpanStartBlock, strconv.FormatUint(uint64(msg.StartBlock), 10)),
-		),
-	})
-
-	// draft result with events
-	return sdk.Result{
-		Events: ctx.EventManager().Events(),
-	}
+	return nil
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/maticnetwork/bor/common"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/maticnetwork/heimdall/bor/types"
@@ -25,6 +26,7 @@ var (
 	SpanPrefixKey         = []byte{0x36} // prefix key to store span
 	SpanCacheKey          = []byte{0x37} // key to store Cache for span
 	LastProcessedEthBlock = []byte{0x38} // key to store last processed eth block for seed
+	SpanKeysMigrated      = []byte{0x39} // key to mark spans as migrated to big endian keys
 )
 
 // Keeper stores all related data
@@ -74,7 +76,12 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 
 // GetSpanKey appends prefix to start block
 func GetSpanKey(id uint64) []byte {
-	return append(SpanPrefixKey, []byte(strconv.FormatUint(id, 10))...)
+	return append(SpanPrefixKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// MigrateSpanKeys moves spans stored with decimal keys by older versions to big endian keys, once
+func (k *Keeper) MigrateSpanKeys(ctx sdk.Context) int {
+	return hmTypes.MigrateLegacyKeysOnce(ctx.KVStore(k.storeKey), SpanKeysMigrated, SpanPrefixKey, GetSpanKey)
 }
 
 // AddNewSpan adds new span for bor to store
@@ -180,7 +187,7 @@ func (k *Keeper) GetLastSpan(ctx sdk.Context) (*hmTypes.Span, error) {
 }
 
 // FreezeSet freezes validator set for next span
-func (k *Keeper) FreezeSet(ctx sdk.Context, id uint64, startBlock uint64, borChainID string) error {
+func (k *Keeper) FreezeSet(ctx sdk.Context, id uint64, startBlock uint64, borChainID string, seed common.Hash) error {
 	duration := k.GetSpanDuration(ctx)
 	endBlock := startBlock
 	if duration > 0 {
@@ -188,7 +195,7 @@ func (k *Keeper) FreezeSet(ctx sdk.Context, id uint64, startBlock uint64, borCha
 	}
 
 	// select next producers
-	newProducers, err := k.SelectNextProducers(ctx)
+	newProducers, err := k.SelectNextProducers(ctx, seed)
 	if err != nil {
 		return err
 	}
@@ -210,10 +217,7 @@ func (k *Keeper) FreezeSet(ctx sdk.Context, id uint64, startBlock uint64, borCha
 }
 
 // SelectNextProducers selects producers for next span
-func (k *Keeper) SelectNextProducers(ctx sdk.Context) (vals []hmTypes.Validator, err error) {
-	// fetch last block used for seed
-	lastEthBlock := k.GetLastEthBlock(ctx)
-
+func (k *Keeper) SelectNextProducers(ctx sdk.Context, seed common.Hash) (vals []hmTypes.Validator, err error) {
 	// spanEligibleVals are current validators who are not getting deactivated in between next span
 	spanEligibleVals := k.sk.GetSpanEligibleValidators(ctx)
 	producerCount, err := k.GetProducerCount(ctx)
@@ -226,17 +230,8 @@ func (k *Keeper) SelectNextProducers(ctx sdk.Context) (vals []hmTypes.Validator,
 		return spanEligibleVals, nil
 	}
 
-	// increment last processed header block number
-	newEthBlock := lastEthBlock.Add(lastEthBlock, big.NewInt(1))
-
-	// fetch block header from mainchain
-	blockHeader, err := k.contractCaller.GetMainChainBlock(newEthBlock)
-	if err != nil {
-		return vals, err
-	}
-
 	// select next producers using seed as blockheader hash
-	newProducersIds, err := SelectNextProducers(blockHeader.Hash(), spanEligibleVals, producerCount)
+	newProducersIds, err := SelectNextProducers(seed, spanEligibleVals, producerCount)
 	if err != nil {
 		return vals, err
 	}
@@ -257,6 +252,23 @@ func (k *Keeper) SelectNextProducers(ctx sdk.Context) (vals []hmTypes.Validator,
 	return vals, nil
 }
 
+// GetNextSpanSeed returns seed for next span, which is hash of next unprocessed mainchain block
+func (k *Keeper) GetNextSpanSeed(ctx sdk.Context) (common.Hash, error) {
+	// fetch last block used for seed
+	lastEthBlock := k.GetLastEthBlock(ctx)
+
+	// increment last processed header block number
+	newEthBlock := lastEthBlock.Add(lastEthBlock, big.NewInt(1))
+
+	// fetch block header from mainchain
+	blockHeader, err := k.contractCaller.GetMainChainBlock(newEthBlock)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return blockHeader.Hash(), nil
+}
+
 // UpdateLastSpan updates the last span start block
 func (k *Keeper) UpdateLastSpan(ctx sdk.Context, id uint64) {
 	store := ctx.KVStore(k.storeKey)
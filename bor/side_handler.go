@@ -0,0 +1,95 @@
+package bor
+
+import (
+	"bytes"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/bor/types"
+	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// NewSideTxHandler returns side tx handler for bor module
+func NewSideTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxHandler {
+	return func(ctx sdk.Context, msg sdk.Msg) hmTypes.SideTxResultType {
+		switch msg := msg.(type) {
+		case types.MsgProposeSpan:
+			return SideHandleMsgProposeSpan(ctx, msg, k)
+		default:
+			return hmTypes.SideTxResultSkip
+		}
+	}
+}
+
+// NewPostTxHandler returns post tx handler for bor module
+func NewPostTxHandler(k Keeper) hmTypes.PostTxHandler {
+	return func(ctx sdk.Context, msg sdk.Msg, result hmTypes.SideTxResultType) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgProposeSpan:
+			return PostHandleMsgProposeSpan(ctx, msg, result, k)
+		default:
+			return sdk.ErrTxDecode("Invalid message in bor module").Result()
+		}
+	}
+}
+
+// SideHandleMsgProposeSpan validates seed of proposed span against mainchain
+func SideHandleMsgProposeSpan(ctx sdk.Context, msg types.MsgProposeSpan, k Keeper) hmTypes.SideTxResultType {
+	// fetch seed from mainchain
+	nextSpanSeed, err := k.GetNextSpanSeed(ctx)
+	if err != nil {
+		k.Logger(ctx).Error("Unable to fetch next span seed", "Error", err)
+		return hmTypes.SideTxResultSkip
+	}
+
+	if !bytes.Equal(nextSpanSeed.Bytes(), msg.Seed.Bytes()) {
+		k.Logger(ctx).Error("Seed in message doesn't match next span seed",
+			"msgSeed", msg.Seed.String(),
+			"nextSpanSeed", nextSpanSeed.Hex(),
+		)
+		return hmTypes.SideTxResultNo
+	}
+
+	return hmTypes.SideTxResultYes
+}
+
+// PostHandleMsgProposeSpan freezes validator set for voted span
+func PostHandleMsgProposeSpan(ctx sdk.Context, msg types.MsgProposeSpan, result hmTypes.SideTxResultType, k Keeper) sdk.Result {
+	if result != hmTypes.SideTxResultYes {
+		k.Logger(ctx).Debug("Skipping span rejected by validators", "spanId", msg.ID)
+		return sdk.Result{}
+	}
+
+	// state might have changed while validators were voting
+	if err := validateProposeSpan(ctx, msg, k); err != nil {
+		return err.Result()
+	}
+
+	// freeze for new span
+	if err := k.FreezeSet(ctx, msg.ID, msg.StartBlock, msg.ChainID, msg.Seed.EthHash()); err != nil {
+		k.Logger(ctx).Error("Unable to freeze validator set for span", "Error", err)
+		return common.ErrUnableToFreezeValSet(k.Codespace()).Result()
+	}
+
+	// add events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeProposeSpan,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySuccess, "true"),
+			sdk.NewAttribute(types.AttributeKeyBorSyncID, strconv.FormatUint(uint64(msg.ID), 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, strconv.FormatUint(uint64(msg.ID), 10)),
+			sdk.NewAttribute(types.AttributeKeySpanStartBlock, strconv.FormatUint(uint64(msg.StartBlock), 10)),
+		),
+	})
+
+	// draft result with events
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
@@ -18,6 +18,7 @@ type MsgProposeSpan struct {
 	StartBlock uint64                  `json:"start_block"`
 	EndBlock   uint64                  `json:"end_block"`
 	ChainID    string                  `json:"bor_chain_id"`
+	Seed       hmTypes.HeimdallHash    `json:"seed"`
 }
 
 // NewMsgProposeSpan creates new propose span message
@@ -27,6 +28,7 @@ func NewMsgProposeSpan(
 	startBlock uint64,
 	endBlock uint64,
 	chainID string,
+	seed hmTypes.HeimdallHash,
 ) MsgProposeSpan {
 	return MsgProposeSpan{
 		ID:         id,
@@ -34,6 +36,7 @@ func NewMsgProposeSpan(
 		StartBlock: startBlock,
 		EndBlock:   endBlock,
 		ChainID:    chainID,
+		Seed:       seed,
 	}
 }
 
@@ -67,5 +70,14 @@ func (msg MsgProposeSpan) ValidateBasic() sdk.Error {
 		return sdk.ErrInvalidAddress(msg.Proposer.String())
 	}
 
+	if msg.Seed.Empty() {
+		return sdk.ErrUnknownRequest("missing seed")
+	}
+
 	return nil
 }
+
+// IsSideTxMsg marks propose span as side tx msg, seed is validated by validators before span gets frozen
+func (msg MsgProposeSpan) IsSideTxMsg() bool {
+	return true
+}
@@ -127,6 +127,7 @@ func SelectedServices(cdc *codec.Codec, _httpClient *httpClient.HTTP, _queueConn
 			pier.NewAckService(cdc, _queueConnector, _httpClient),
 			pier.NewSpanService(cdc, _queueConnector, _httpClient),
 			pier.NewClerkService(cdc, _queueConnector, _httpClient),
+			pier.NewSideTxService(cdc, _queueConnector, _httpClient),
 		)
 	} else {
 		for _, service := range onlyServices {
@@ -141,6 +142,8 @@ func SelectedServices(cdc *codec.Codec, _httpClient *httpClient.HTTP, _queueConn
 				services = append(services, pier.NewSpanService(cdc, _queueConnector, _httpClient))
 			case "clerk":
 				services = append(services, pier.NewClerkService(cdc, _queueConnector, _httpClient))
+			case "sidetx":
+				services = append(services, pier.NewSideTxService(cdc, _queueConnector, _httpClient))
 			}
 		}
 	}
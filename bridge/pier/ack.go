@@ -159,12 +159,25 @@ func (ackService *AckService) processCheckpoint(lastCreatedAt int64) {
 		index = 1
 	}
 
+	// use latest heimdall block time as reference, same as checkpoint module
+	currentTime, err := GetHeimdallBlockTime(ackService.cliCtx)
+	if err != nil {
+		ackService.Logger.Error("Error while fetching heimdall block time", "error", err)
+		return
+	}
+
+	// fetch checkpoint params from heimdall
+	checkpointParams, err := GetCheckpointParams(ackService.cliCtx)
+	if err != nil {
+		ackService.Logger.Error("Error while fetching checkpoint params", "error", err)
+		return
+	}
+
 	checkpointCreationTime := time.Unix(lastCreatedAt, 0)
-	currentTime := time.Now().UTC()
 	timeDiff := currentTime.Sub(checkpointCreationTime)
 	// check if last checkpoint was < NoACK wait time
-	if timeDiff.Seconds() >= helper.GetConfig().NoACKWaitTime.Seconds() && index == 0 {
-		index = math.Floor(timeDiff.Seconds() / helper.GetConfig().NoACKWaitTime.Seconds())
+	if timeDiff.Seconds() >= checkpointParams.NoACKWaitTime.Seconds() && index == 0 {
+		index = math.Floor(timeDiff.Seconds() / checkpointParams.NoACKWaitTime.Seconds())
 	}
 
 	if index == 0 {
@@ -177,8 +190,8 @@ func (ackService *AckService) processCheckpoint(lastCreatedAt int64) {
 	lastNoAckTime := time.Unix(int64(lastNoAck), 0)
 	timeDiff = currentTime.Sub(lastNoAckTime)
 	// if last no ack == 0 , first no-ack to be sent
-	if currentTime.Sub(lastNoAckTime).Seconds() < helper.GetConfig().CheckpointBufferTime.Seconds() && lastNoAck != 0 {
-		ackService.Logger.Debug("Cannot send multiple no-ack in short time", "timeDiff", currentTime.Sub(lastNoAckTime).Seconds(), "ExpectedDiff", helper.GetConfig().CheckpointBufferTime.Seconds())
+	if currentTime.Sub(lastNoAckTime).Seconds() < checkpointParams.CheckpointBufferTime.Seconds() && lastNoAck != 0 {
+		ackService.Logger.Debug("Cannot send multiple no-ack in short time", "timeDiff", currentTime.Sub(lastNoAckTime).Seconds(), "ExpectedDiff", checkpointParams.CheckpointBufferTime.Seconds())
 		return
 	}
 
@@ -195,7 +208,7 @@ func (ackService *AckService) processCheckpoint(lastCreatedAt int64) {
 		// send NO ACK
 		msg := checkpointTypes.NewMsgCheckpointNoAck(
 			hmtypes.BytesToHeimdallAddress(helper.GetAddress()),
-			uint64(time.Now().UTC().Unix()),
+			uint64(currentTime.Unix()),
 		)
 
 		// send
@@ -81,6 +81,11 @@ func NewCheckpointer(cdc *codec.Codec, queueConnector *QueueConnector, httpClien
 		httpClient:     httpClient,
 	}
 
+	// cache bor headers used to build checkpoint roots in bridge db
+	if checkpointer.storageClient != nil {
+		checkpointTypes.SetHeaderCache(checkpointTypes.NewHeaderCache(checkpointer.storageClient))
+	}
+
 	checkpointer.BaseService = *common.NewBaseService(logger, HeimdallCheckpointer, checkpointer)
 	return checkpointer
 }
@@ -134,6 +139,9 @@ func (c *Checkpointer) OnStart() error {
 func (c *Checkpointer) OnStop() {
 	c.BaseService.OnStop() // Always call the overridden method.
 
+	// stop caching headers in bridge db
+	checkpointTypes.SetHeaderCache(nil)
+
 	// close bridge db instance
 	closeBridgeDBInstance()
 
@@ -190,6 +198,13 @@ func (c *Checkpointer) startSubscription(ctx context.Context, subscription ether
 func (c *Checkpointer) sendRequest(newHeader *types.Header) {
 	c.Logger.Debug("New block detected", "blockNumber", newHeader.Number)
 
+	// fetch checkpoint params from heimdall
+	checkpointParams, err := GetCheckpointParams(c.cliCtx)
+	if err != nil {
+		c.Logger.Error("Error while fetching checkpoint params", "error", err)
+		return
+	}
+
 	// get state
 	var expectedCheckpointState *ContractCheckpoint
 	var bufferedCheckpoint *HeimdallCheckpoint
@@ -202,7 +217,7 @@ func (c *Checkpointer) sendRequest(newHeader *types.Header) {
 
 	go func() {
 		defer wg.Done()
-		expectedCheckpointState, _ = c.nextExpectedCheckpoint(newHeader.Number.Uint64())
+		expectedCheckpointState, _ = c.nextExpectedCheckpoint(checkpointParams, newHeader.Number.Uint64())
 	}()
 
 	go func() {
@@ -280,13 +295,13 @@ func (c *Checkpointer) sendRequest(newHeader *types.Header) {
 
 	start := expectedCheckpointState.newStart
 	end := expectedCheckpointState.newEnd
-	if err := c.sendCheckpointToHeimdall(start, end); err != nil {
+	if err := c.sendCheckpointToHeimdall(checkpointParams, start, end); err != nil {
 		c.Logger.Error("Error while sending checkpoint", "error", err)
 	}
 }
 
 // fetched contract checkpoint state and returns the next probable checkpoint that needs to be sent
-func (c *Checkpointer) nextExpectedCheckpoint(latestChildBlock uint64) (*ContractCheckpoint, error) {
+func (c *Checkpointer) nextExpectedCheckpoint(checkpointParams *checkpointTypes.Params, latestChildBlock uint64) (*ContractCheckpoint, error) {
 	// fetch current header block from mainchain contract
 	_currentHeaderBlock, err := c.contractConnector.CurrentHeaderBlock()
 	if err != nil {
@@ -322,14 +337,14 @@ func (c *Checkpointer) nextExpectedCheckpoint(latestChildBlock uint64) (*Contrac
 
 	// process if diff > 0 (positive)
 	if diff > 0 {
-		expectedDiff := diff - diff%helper.GetConfig().AvgCheckpointLength
+		expectedDiff := diff - diff%checkpointParams.AvgCheckpointLength
 		if expectedDiff > 0 {
 			expectedDiff = expectedDiff - 1
 		}
 
 		// cap with max checkpoint length
-		if expectedDiff > helper.GetConfig().MaxCheckpointLength-1 {
-			expectedDiff = helper.GetConfig().MaxCheckpointLength - 1
+		if expectedDiff > checkpointParams.MaxCheckpointLength-1 {
+			expectedDiff = checkpointParams.MaxCheckpointLength - 1
 		}
 
 		// get end result
@@ -343,11 +358,11 @@ func (c *Checkpointer) nextExpectedCheckpoint(latestChildBlock uint64) (*Contrac
 	}
 
 	// Handle when block producers go down
-	if end == 0 || end == start || (0 < diff && diff < helper.GetConfig().AvgCheckpointLength) {
+	if end == 0 || end == start || (0 < diff && diff < checkpointParams.AvgCheckpointLength) {
 		c.Logger.Debug("Fetching last header block to calculate time")
 
 		currentTime := time.Now().UTC().Unix()
-		defaultForcePushInterval := helper.GetConfig().MaxCheckpointLength * 2 // in seconds (1024 * 2 seconds)
+		defaultForcePushInterval := checkpointParams.MaxCheckpointLength * 2 // in seconds (1024 * 2 seconds)
 		if currentTime-int64(lastCheckpointTime) > int64(defaultForcePushInterval) {
 			end = latestChildBlock
 			c.Logger.Info("Force push checkpoint",
@@ -429,14 +444,14 @@ func (c *Checkpointer) fetchDividendAccountRoot() (accountroothash hmtypes.Heimd
 }
 
 // broadcast checkpoint
-func (c *Checkpointer) sendCheckpointToHeimdall(start uint64, end uint64) error {
+func (c *Checkpointer) sendCheckpointToHeimdall(checkpointParams *checkpointTypes.Params, start uint64, end uint64) error {
 	if end == 0 || start >= end {
 		c.Logger.Info("Waiting for blocks or invalid start end formation", "start", start, "end", end)
 		return errors.New("No new valid checkpoint, yet. Waiting for more blocks or time")
 	}
 
 	// Get root hash
-	root, err := checkpointTypes.GetHeaders(start, end)
+	root, err := checkpointTypes.GetHeaders(start, end, checkpointParams.AvgCheckpointLength, checkpointParams.LeafVersion)
 	if err != nil {
 		return err
 	}
@@ -448,6 +463,13 @@ func (c *Checkpointer) sendCheckpointToHeimdall(start uint64, end uint64) error
 		return err
 	}
 
+	// checkpoint timestamp must not be ahead of heimdall block time
+	blockTime, err := GetHeimdallBlockTime(c.cliCtx)
+	if err != nil {
+		c.Logger.Error("Error while fetching heimdall block time", "error", err)
+		return err
+	}
+
 	c.Logger.Info("✅Creating and broadcasting new checkpoint",
 		"start", start,
 		"end", end,
@@ -462,7 +484,8 @@ func (c *Checkpointer) sendCheckpointToHeimdall(start uint64, end uint64) error
 		end,
 		hmtypes.BytesToHeimdallHash(root),
 		accountRootHash,
-		uint64(time.Now().UTC().Unix()),
+		uint64(blockTime.Unix()),
+		checkpointParams.LeafVersion,
 	)
 
 	// return broadcast to heimdall
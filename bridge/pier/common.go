@@ -20,6 +20,7 @@ import (
 	httpClient "github.com/tendermint/tendermint/rpc/client"
 	tmTypes "github.com/tendermint/tendermint/types"
 
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/helper"
 	hmtypes "github.com/maticnetwork/heimdall/types"
 	rest "github.com/maticnetwork/heimdall/types/rest"
@@ -31,6 +32,7 @@ const (
 	NoackService         = "checkpoint-no-ack"
 	SpanServiceStr       = "span-service"
 	ClerkServiceStr      = "clerk-service"
+	SideTxServiceStr     = "side-tx-service"
 	AMQPConsumerService  = "amqp-consumer-service"
 
 	// TxsURL represents txs url
@@ -44,8 +46,13 @@ const (
 	CurrentProposerURL     = "/staking/current-proposer"
 	LatestSpanURL          = "/bor/latest-span"
 	NextSpanInfoURL        = "/bor/prepare-next-span"
+	NextSpanSeedURL        = "/bor/next-span-seed"
 	DividendAccountRootURL = "/staking/dividend-account-root"
 	ValidatorURL           = "/staking/validator/%v"
+	CheckpointParamsURL    = "/checkpoint/params"
+	PendingSideTxsURL      = "/sidechannel/pending-txs"
+	SideTxVotesURL         = "/sidechannel/votes/%v"
+	SideTxResultURL        = "/sidechannel/side-tx-result/%v"
 
 	TransactionTimeout = 1 * time.Minute
 	CommitTimeout      = 2 * time.Minute
@@ -85,33 +92,6 @@ func isProposer(cliCtx cliContext.CLIContext) bool {
 	return false
 }
 
-// check if we are the EventSender
-func isEventSender(cliCtx cliContext.CLIContext, validatorID uint64) bool {
-
-	var validator hmtypes.Validator
-
-	result, err := FetchFromAPI(cliCtx,
-		GetHeimdallServerEndpoint(fmt.Sprintf(ValidatorURL, strconv.FormatUint(validatorID, 10))),
-	)
-	if err != nil {
-		Logger.Error("Error fetching proposers", "error", err)
-		return false
-	}
-	err = json.Unmarshal(result.Result, &validator)
-	if err != nil {
-		Logger.Error("error unmarshalling proposer slice", "error", err)
-		return false
-	}
-	Logger.Debug("Current event sender received", "validator", validator.String())
-
-	if bytes.Equal(validator.Signer.Bytes(), helper.GetAddress()) {
-		return true
-	}
-
-	return false
-
-}
-
 // GetHeimdallServerEndpoint returns heimdall server endpoint
 func GetHeimdallServerEndpoint(endpoint string) string {
 	u, _ := url.Parse(helper.GetConfig().HeimdallServerURL)
@@ -201,6 +181,29 @@ func FetchVotes(
 	return preCommits, valSigs, chainID, nil
 }
 
+// GetHeimdallBlockTime returns time of the latest heimdall block
+func GetHeimdallBlockTime(cliCtx cliContext.CLIContext) (time.Time, error) {
+	resp, err := helper.GetNodeStatus(cliCtx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return resp.SyncInfo.LatestBlockTime.UTC(), nil
+}
+
+// GetCheckpointParams fetches checkpoint params from heimdall chain state
+func GetCheckpointParams(cliCtx cliContext.CLIContext) (*checkpointTypes.Params, error) {
+	response, err := FetchFromAPI(cliCtx, GetHeimdallServerEndpoint(CheckpointParamsURL))
+	if err != nil {
+		return nil, err
+	}
+
+	var params checkpointTypes.Params
+	if err := json.Unmarshal(response.Result, &params); err != nil {
+		return nil, err
+	}
+	return &params, nil
+}
+
 // IsCatchingUp checks if the heimdall node you are connected to is fully synced or not
 // returns true when synced
 func IsCatchingUp(cliCtx cliContext.CLIContext) bool {
@@ -0,0 +1,217 @@
+package pier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/tendermint/tendermint/libs/common"
+	httpClient "github.com/tendermint/tendermint/rpc/client"
+
+	"github.com/maticnetwork/heimdall/helper"
+	sidechannelTypes "github.com/maticnetwork/heimdall/sidechannel/types"
+	"github.com/maticnetwork/heimdall/types"
+)
+
+// SideTxService votes on pending side txs
+type SideTxService struct {
+	// Base service
+	common.BaseService
+
+	// side tx polling cancel function
+	cancelSideTxService context.CancelFunc
+
+	// cli context
+	cliCtx cliContext.CLIContext
+
+	// queue connector
+	queueConnector *QueueConnector
+
+	// http client to subscribe to
+	httpClient *httpClient.HTTP
+
+	// side txs voted by this validator, which are not yet removed from pending list
+	voted map[string]bool
+}
+
+// NewSideTxService returns new service object
+func NewSideTxService(cdc *codec.Codec, queueConnector *QueueConnector, httpClient *httpClient.HTTP) *SideTxService {
+	// create logger
+	logger := Logger.With("module", SideTxServiceStr)
+
+	cliCtx := cliContext.NewCLIContext().WithCodec(cdc)
+	cliCtx.BroadcastMode = client.BroadcastSync
+	cliCtx.TrustNode = true
+
+	// creating side tx service object
+	sideTxService := &SideTxService{
+		cliCtx:         cliCtx,
+		queueConnector: queueConnector,
+		httpClient:     httpClient,
+		voted:          make(map[string]bool),
+	}
+
+	sideTxService.BaseService = *common.NewBaseService(logger, SideTxServiceStr, sideTxService)
+	return sideTxService
+}
+
+// OnStart starts polling for pending side txs
+func (s *SideTxService) OnStart() error {
+	s.BaseService.OnStart() // Always call the overridden method.
+
+	// create cancellable context
+	sideTxCtx, cancelSideTxService := context.WithCancel(context.Background())
+
+	s.cancelSideTxService = cancelSideTxService
+
+	// start polling for pending side txs
+	go s.startPolling(sideTxCtx, helper.GetConfig().SideTxPollingInterval)
+
+	s.Logger.Debug("Started side tx service")
+	return nil
+}
+
+// OnStop stops all necessary go routines
+func (s *SideTxService) OnStop() {
+	s.Logger.Info("Terminating side tx service")
+	s.BaseService.OnStop()
+	s.httpClient.Stop()
+	// cancel side tx process
+	s.cancelSideTxService()
+}
+
+// polls heimdall for pending side txs
+func (s *SideTxService) startPolling(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	// stop ticker when everything done
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.voteOnPendingSideTxs()
+		case <-ctx.Done():
+			ticker.Stop()
+			return
+		}
+	}
+}
+
+// voteOnPendingSideTxs casts vote of current validator on all pending side txs
+func (s *SideTxService) voteOnPendingSideTxs() {
+	pendingTxs, err := s.fetchPendingSideTxs()
+	if err != nil {
+		s.Logger.Error("Unable to fetch pending side txs", "error", err)
+		return
+	}
+
+	// forget side txs which are not pending anymore
+	pending := make(map[string]bool)
+	for _, tx := range pendingTxs {
+		pending[tx.TxHash.String()] = true
+	}
+	for txHash := range s.voted {
+		if !pending[txHash] {
+			delete(s.voted, txHash)
+		}
+	}
+
+	for _, tx := range pendingTxs {
+		if s.voted[tx.TxHash.String()] {
+			continue
+		}
+
+		hasVoted, err := s.hasVoted(tx.TxHash)
+		if err != nil {
+			s.Logger.Error("Unable to fetch side tx votes", "txHash", tx.TxHash, "error", err)
+			continue
+		}
+
+		if !hasVoted {
+			voted, err := s.vote(tx)
+			if err != nil {
+				s.Logger.Error("Unable to vote on side tx", "txHash", tx.TxHash, "error", err)
+				continue
+			}
+
+			// side tx couldn't be verified yet, retry on next poll
+			if !voted {
+				continue
+			}
+		}
+
+		s.voted[tx.TxHash.String()] = true
+	}
+}
+
+// vote checks side tx against external chains and broadcasts vote to heimdall.
+// Nothing is broadcasted if side tx can't be verified yet.
+func (s *SideTxService) vote(tx sidechannelTypes.PendingSideTx) (bool, error) {
+	result, err := FetchFromAPI(s.cliCtx, GetHeimdallServerEndpoint(fmt.Sprintf(SideTxResultURL, tx.TxHash.String())))
+	if err != nil {
+		return false, err
+	}
+
+	var sideTxResult types.SideTxResultType
+	if err := json.Unmarshal(result.Result, &sideTxResult); err != nil {
+		return false, err
+	}
+
+	if sideTxResult == types.SideTxResultSkip {
+		s.Logger.Debug("Unable to verify side tx yet, skipping vote", "txHash", tx.TxHash, "type", tx.Msg.Type())
+		return false, nil
+	}
+
+	s.Logger.Info("✅ Voting on side tx", "txHash", tx.TxHash, "type", tx.Msg.Type(), "result", sideTxResult)
+
+	// broadcast to heimdall
+	msg := sidechannelTypes.NewMsgSideTxVote(
+		types.BytesToHeimdallAddress(helper.GetAddress()),
+		tx.TxHash,
+		sideTxResult,
+	)
+	if err := s.queueConnector.BroadcastToHeimdall(msg); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// hasVoted checks if current validator already voted on side tx
+func (s *SideTxService) hasVoted(txHash types.HeimdallHash) (bool, error) {
+	result, err := FetchFromAPI(s.cliCtx, GetHeimdallServerEndpoint(fmt.Sprintf(SideTxVotesURL, txHash.String())))
+	if err != nil {
+		return false, err
+	}
+
+	var votes sidechannelTypes.SideTxVotes
+	if err := json.Unmarshal(result.Result, &votes); err != nil {
+		return false, err
+	}
+
+	for _, vote := range votes.Votes {
+		if bytes.Equal(vote.Validator.Bytes(), helper.GetAddress()) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// fetchPendingSideTxs fetches side txs waiting for votes
+func (s *SideTxService) fetchPendingSideTxs() ([]sidechannelTypes.PendingSideTx, error) {
+	result, err := FetchFromAPI(s.cliCtx, GetHeimdallServerEndpoint(PendingSideTxsURL))
+	if err != nil {
+		return nil, err
+	}
+
+	// pending side txs contain msg interfaces
+	var txs []sidechannelTypes.PendingSideTx
+	if err := s.cliCtx.Codec.UnmarshalJSON(result.Result, &txs); err != nil {
+		return nil, err
+	}
+	return txs, nil
+}
@@ -148,8 +148,15 @@ func (s *SpanService) propose(lastSpan *types.Span, nextSpanMsg *types.Span) {
 	}
 
 	if lastSpan.StartBlock <= currentBlock && currentBlock <= lastSpan.EndBlock {
+		// fetch seed for next span
+		seed, err := s.fetchNextSpanSeed()
+		if err != nil {
+			s.Logger.Error("Unable to fetch next span seed", "error", err)
+			return
+		}
+
 		// log new span
-		s.Logger.Info("✅Proposing new span", "spanId", nextSpanMsg.ID, "startBlock", nextSpanMsg.StartBlock, "endBlock", nextSpanMsg.EndBlock)
+		s.Logger.Info("✅Proposing new span", "spanId", nextSpanMsg.ID, "startBlock", nextSpanMsg.StartBlock, "endBlock", nextSpanMsg.EndBlock, "seed", seed.String())
 
 		// broadcast to heimdall
 		msg := borTypes.MsgProposeSpan{
@@ -158,6 +165,7 @@ func (s *SpanService) propose(lastSpan *types.Span, nextSpanMsg *types.Span) {
 			StartBlock: nextSpanMsg.StartBlock,
 			EndBlock:   nextSpanMsg.EndBlock,
 			ChainID:    nextSpanMsg.ChainID,
+			Seed:       seed,
 		}
 		if err := s.queueConnector.BroadcastToHeimdall(msg); err != nil {
 			s.Logger.Error("Error while broadcasting msg to heimdall", "error", err)
@@ -203,6 +211,21 @@ func (s *SpanService) getLastSpan() (*types.Span, error) {
 	return &lastSpan, nil
 }
 
+// fetchNextSpanSeed fetches seed for next span from heimdall
+func (s *SpanService) fetchNextSpanSeed() (seed types.HeimdallHash, err error) {
+	result, err := FetchFromAPI(s.cliCtx, GetHeimdallServerEndpoint(NextSpanSeedURL))
+	if err != nil {
+		s.Logger.Error("Error while fetching next span seed")
+		return seed, err
+	}
+
+	if err := json.Unmarshal(result.Result, &seed); err != nil {
+		s.Logger.Error("Error unmarshalling", "error", err)
+		return seed, err
+	}
+	return seed, nil
+}
+
 // getCurrentChildBlock gets the current child block
 func (s *SpanService) getCurrentChildBlock() (uint64, error) {
 	childBlock, err := s.contractConnector.GetMaticChainBlock(nil)
@@ -214,8 +214,14 @@ func (syncer *Syncer) processHeader(newHeader *types.Header) {
 
 	latestNumber := newHeader.Number
 
-	// confirmation
-	confirmationBlocks := big.NewInt(0).SetUint64(helper.GetConfig().ConfirmationBlocks)
+	// confirmation blocks from checkpoint params
+	checkpointParams, err := GetCheckpointParams(syncer.cliCtx)
+	if err != nil {
+		syncer.Logger.Error("Error while fetching checkpoint params", "error", err)
+		return
+	}
+
+	confirmationBlocks := big.NewInt(0).SetUint64(checkpointParams.ConfirmationBlocks)
 	confirmationBlocks = confirmationBlocks.Add(confirmationBlocks, big.NewInt(1))
 	if latestNumber.Uint64() > confirmationBlocks.Uint64() {
 		latestNumber = latestNumber.Sub(latestNumber, confirmationBlocks)
@@ -289,9 +295,8 @@ func (syncer *Syncer) processHeader(newHeader *types.Header) {
 				switch selectedEvent.Name {
 				case "NewHeaderBlock":
 					syncer.processCheckpointEvent(selectedEvent.Name, abiObject, &vLog)
-				// TODO remove post new bridge design
-				// case "Staked":
-				// 	syncer.processStakedEvent(selectedEvent.Name, abiObject, &vLog)
+				case "Staked":
+					syncer.processStakedEvent(selectedEvent.Name, abiObject, &vLog)
 				case "UnstakeInit":
 					syncer.processUnstakeInitEvent(selectedEvent.Name, abiObject, &vLog)
 				case "StakeUpdate":
@@ -302,6 +307,12 @@ func (syncer *Syncer) processHeader(newHeader *types.Header) {
 					syncer.processReStakedEvent(selectedEvent.Name, abiObject, &vLog)
 				case "Jailed":
 					syncer.processJailedEvent(selectedEvent.Name, abiObject, &vLog)
+				case "UnJailed":
+					syncer.processUnJailedEvent(selectedEvent.Name, abiObject, &vLog)
+				case "ShareMinted":
+					syncer.processShareMintedEvent(selectedEvent.Name, abiObject, &vLog)
+				case "ShareBurned":
+					syncer.processShareBurnedEvent(selectedEvent.Name, abiObject, &vLog)
 				case "StateSynced":
 					syncer.processStateSyncedEvent(selectedEvent.Name, abiObject, &vLog)
 				case "TopUpFee":
@@ -332,11 +343,27 @@ func (syncer *Syncer) processCheckpointEvent(eventName string, abiObject *abi.AB
 		)
 
 		// create msg checkpoint ack message
-		msg := checkpointTypes.NewMsgCheckpointAck(helper.GetFromAddress(syncer.cliCtx), event.HeaderBlockId.Uint64(), hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()), uint64(vLog.Index))
+		msg := checkpointTypes.NewMsgCheckpointAck(
+			helper.GetFromAddress(syncer.cliCtx),
+			event.HeaderBlockId.Uint64(),
+			hmTypes.BytesToHeimdallAddress(event.Proposer.Bytes()),
+			event.Start.Uint64(),
+			event.End.Uint64(),
+			hmTypes.BytesToHeimdallHash(event.Root[:]),
+			hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+			uint64(vLog.Index),
+		)
 		syncer.queueConnector.BroadcastToHeimdall(msg)
 	}
 }
 
+//
+// Process staking events
+//
+// Staking events are relayed by every bridge, not just by affected validator.
+// Heimdall rejects duplicate msgs (mostly by staking sequence) and refunds fee to relayer of applied msg.
+//
+
 func (syncer *Syncer) processStakedEvent(eventName string, abiObject *abi.ABI, vLog *types.Log) {
 	event := new(stakinginfo.StakinginfoStaked)
 	if err := helper.UnpackLog(abiObject, event, eventName, vLog); err != nil {
@@ -351,20 +378,26 @@ func (syncer *Syncer) processStakedEvent(eventName string, abiObject *abi.ABI, v
 			"amount", event.Amount,
 		)
 
-		// compare user to get address
-		if isEventSender(syncer.cliCtx, event.ValidatorId.Uint64()) {
-			pubkey := helper.GetPubKey()
-			msg := stakingTypes.NewMsgValidatorJoin(
-				hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
-				event.ValidatorId.Uint64(),
-				hmTypes.NewPubKey(pubkey[:]),
-				hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
-				uint64(vLog.Index),
-			)
-
-			// process staked
-			syncer.queueConnector.BroadcastToHeimdall(msg)
+		// signer pubkey is carried by staked event, so any validator can relay it
+		pubkey := hmTypes.NewPubKey(event.SignerPubkey)
+		if len(event.SignerPubkey) != len(pubkey) || !bytes.Equal(pubkey.Address().Bytes(), event.Signer.Bytes()) {
+			syncer.Logger.Error("Invalid signer pubkey in staked event", "validatorID", event.ValidatorId, "signer", event.Signer.Hex())
+			return
 		}
+
+		msg := stakingTypes.NewMsgValidatorJoin(
+			hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+			event.ValidatorId.Uint64(),
+			event.ActivationEpoch.Uint64(),
+			hmTypes.NewIntFromBigInt(event.Amount),
+			pubkey,
+			hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+			uint64(vLog.Index),
+			vLog.BlockNumber,
+		)
+
+		// process staked
+		syncer.queueConnector.BroadcastToHeimdall(msg)
 	}
 }
 
@@ -383,17 +416,17 @@ func (syncer *Syncer) processUnstakeInitEvent(eventName string, abiObject *abi.A
 		)
 
 		// msg validator exit
-		if isEventSender(syncer.cliCtx, event.ValidatorId.Uint64()) {
-			msg := stakingTypes.NewMsgValidatorExit(
-				hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
-				event.ValidatorId.Uint64(),
-				hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
-				uint64(vLog.Index),
-			)
-
-			// broadcast heimdall
-			syncer.queueConnector.BroadcastToHeimdall(msg)
-		}
+		msg := stakingTypes.NewMsgValidatorExit(
+			hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+			event.ValidatorId.Uint64(),
+			event.DeactivationEpoch.Uint64(),
+			hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+			uint64(vLog.Index),
+			vLog.BlockNumber,
+		)
+
+		// broadcast heimdall
+		syncer.queueConnector.BroadcastToHeimdall(msg)
 	}
 }
 
@@ -409,18 +442,18 @@ func (syncer *Syncer) processStakeUpdateEvent(eventName string, abiObject *abi.A
 			"newAmount", event.NewAmount,
 		)
 
-		// msg validator exit
-		if isEventSender(syncer.cliCtx, event.ValidatorId.Uint64()) {
-			msg := stakingTypes.NewMsgStakeUpdate(
-				hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
-				event.ValidatorId.Uint64(),
-				hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
-				uint64(vLog.Index),
-			)
-
-			// broadcast heimdall
-			syncer.queueConnector.BroadcastToHeimdall(msg)
-		}
+		// msg stake update
+		msg := stakingTypes.NewMsgStakeUpdate(
+			hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+			event.ValidatorId.Uint64(),
+			hmTypes.NewIntFromBigInt(event.NewAmount),
+			hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+			uint64(vLog.Index),
+			vLog.BlockNumber,
+		)
+
+		// broadcast heimdall
+		syncer.queueConnector.BroadcastToHeimdall(msg)
 	}
 }
 
@@ -437,20 +470,24 @@ func (syncer *Syncer) processSignerChangeEvent(eventName string, abiObject *abi.
 			"oldSigner", event.OldSigner.Hex(),
 		)
 
-		// signer change
-		if bytes.Compare(event.NewSigner.Bytes(), helper.GetAddress()) == 0 {
-			pubkey := helper.GetPubKey()
-			msg := stakingTypes.NewMsgSignerUpdate(
-				hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
-				event.ValidatorId.Uint64(),
-				hmTypes.NewPubKey(pubkey[:]),
-				hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
-				uint64(vLog.Index),
-			)
-
-			// process signer update
-			syncer.queueConnector.BroadcastToHeimdall(msg)
+		// new signer pubkey is carried by signer change event, so any validator can relay it
+		pubkey := hmTypes.NewPubKey(event.SignerPubkey)
+		if len(event.SignerPubkey) != len(pubkey) || !bytes.Equal(pubkey.Address().Bytes(), event.NewSigner.Bytes()) {
+			syncer.Logger.Error("Invalid signer pubkey in signer change event", "validatorID", event.ValidatorId, "newSigner", event.NewSigner.Hex())
+			return
 		}
+
+		msg := stakingTypes.NewMsgSignerUpdate(
+			hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+			event.ValidatorId.Uint64(),
+			pubkey,
+			hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+			uint64(vLog.Index),
+			vLog.BlockNumber,
+		)
+
+		// process signer update
+		syncer.queueConnector.BroadcastToHeimdall(msg)
 	}
 }
 
@@ -464,17 +501,22 @@ func (syncer *Syncer) processReStakedEvent(eventName string, abiObject *abi.ABI,
 			"event", eventName,
 			"validatorId", event.ValidatorId,
 			"amount", event.Amount,
+			"total", event.Total,
 		)
 
-		// // msg validator exit
-		// msg := staking.NewMsgValidatorExit(
-		// 	hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
-		// 	event.ValidatorId.Uint64(),
-		// 	vLog.TxHash,
-		// )
+		// msg validator restake
+		msg := stakingTypes.NewMsgValidatorReStake(
+			hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+			event.ValidatorId.Uint64(),
+			hmTypes.NewIntFromBigInt(event.Amount),
+			hmTypes.NewIntFromBigInt(event.Total),
+			hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+			uint64(vLog.Index),
+			vLog.BlockNumber,
+		)
 
-		// // broadcast heimdall
-		// syncer.queueConnector.BroadcastToHeimdall(msg)
+		// broadcast heimdall
+		syncer.queueConnector.BroadcastToHeimdall(msg)
 	}
 }
 
@@ -490,15 +532,43 @@ func (syncer *Syncer) processJailedEvent(eventName string, abiObject *abi.ABI, v
 			"exitEpoch", event.ExitEpoch,
 		)
 
-		// // msg validator exit
-		// msg := staking.NewMsgValidatorExit(
-		// 	hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
-		// 	event.ValidatorId.Uint64(),
-		// 	vLog.TxHash,
-		// )
+		// msg validator jailed
+		msg := stakingTypes.NewMsgValidatorJailed(
+			hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+			event.ValidatorId.Uint64(),
+			event.ExitEpoch.Uint64(),
+			hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+			uint64(vLog.Index),
+			vLog.BlockNumber,
+		)
+
+		// broadcast heimdall
+		syncer.queueConnector.BroadcastToHeimdall(msg)
+	}
+}
+
+func (syncer *Syncer) processUnJailedEvent(eventName string, abiObject *abi.ABI, vLog *types.Log) {
+	event := new(stakinginfo.StakinginfoUnJailed)
+	if err := helper.UnpackLog(abiObject, event, eventName, vLog); err != nil {
+		logEventParseError(syncer.Logger, eventName, err)
+	} else {
+		syncer.Logger.Debug(
+			"⬜ New event found",
+			"event", eventName,
+			"validatorID", event.ValidatorId,
+		)
+
+		// msg validator unjailed
+		msg := stakingTypes.NewMsgValidatorUnjailed(
+			hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+			event.ValidatorId.Uint64(),
+			hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+			uint64(vLog.Index),
+			vLog.BlockNumber,
+		)
 
-		// // broadcast heimdall
-		// syncer.queueConnector.BroadcastToHeimdall(msg)
+		// broadcast heimdall
+		syncer.queueConnector.BroadcastToHeimdall(msg)
 	}
 }
 
@@ -527,6 +597,68 @@ func (syncer *Syncer) processJailedEvent(eventName string, abiObject *abi.ABI, v
 // Process state synced event
 //
 
+func (syncer *Syncer) processShareMintedEvent(eventName string, abiObject *abi.ABI, vLog *types.Log) {
+	event := new(stakinginfo.StakinginfoShareMinted)
+	if err := helper.UnpackLog(abiObject, event, eventName, vLog); err != nil {
+		logEventParseError(syncer.Logger, eventName, err)
+	} else {
+		syncer.Logger.Debug(
+			"⬜ New event found",
+			"event", eventName,
+			"validatorID", event.ValidatorId,
+			"delegator", event.User.Hex(),
+			"amount", event.Amount,
+			"tokens", event.Tokens,
+		)
+
+		// msg delegator bond
+		msg := stakingTypes.NewMsgDelegatorBond(
+			hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+			event.ValidatorId.Uint64(),
+			hmTypes.BytesToHeimdallAddress(event.User.Bytes()),
+			hmTypes.NewIntFromBigInt(event.Amount),
+			hmTypes.NewIntFromBigInt(event.Tokens),
+			hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+			uint64(vLog.Index),
+			vLog.BlockNumber,
+		)
+
+		// broadcast heimdall
+		syncer.queueConnector.BroadcastToHeimdall(msg)
+	}
+}
+
+func (syncer *Syncer) processShareBurnedEvent(eventName string, abiObject *abi.ABI, vLog *types.Log) {
+	event := new(stakinginfo.StakinginfoShareBurned)
+	if err := helper.UnpackLog(abiObject, event, eventName, vLog); err != nil {
+		logEventParseError(syncer.Logger, eventName, err)
+	} else {
+		syncer.Logger.Debug(
+			"⬜ New event found",
+			"event", eventName,
+			"validatorID", event.ValidatorId,
+			"delegator", event.User.Hex(),
+			"amount", event.Amount,
+			"tokens", event.Tokens,
+		)
+
+		// msg delegator unbond
+		msg := stakingTypes.NewMsgDelegatorUnbond(
+			hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+			event.ValidatorId.Uint64(),
+			hmTypes.BytesToHeimdallAddress(event.User.Bytes()),
+			hmTypes.NewIntFromBigInt(event.Amount),
+			hmTypes.NewIntFromBigInt(event.Tokens),
+			hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+			uint64(vLog.Index),
+			vLog.BlockNumber,
+		)
+
+		// broadcast heimdall
+		syncer.queueConnector.BroadcastToHeimdall(msg)
+	}
+}
+
 func (syncer *Syncer) processStateSyncedEvent(eventName string, abiObject *abi.ABI, vLog *types.Log) {
 	event := new(statesender.StatesenderStateSynced)
 	if err := helper.UnpackLog(abiObject, event, eventName, vLog); err != nil {
@@ -545,6 +677,8 @@ func (syncer *Syncer) processStateSyncedEvent(eventName string, abiObject *abi.A
 			hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
 			uint64(vLog.Index),
 			event.Id.Uint64(),
+			hmTypes.BytesToHeimdallAddress(event.ContractAddress.Bytes()),
+			event.Data,
 		)
 
 		// broadcast to heimdall
@@ -566,8 +700,23 @@ func (syncer *Syncer) processTopupFeeEvent(eventName string, abiObject *abi.ABI,
 			"Fee", event.Fee,
 		)
 
-		// create msg checkpoint ack message
-		msg := bankTypes.NewMsgTopup(helper.GetFromAddress(syncer.cliCtx), event.ValidatorId.Uint64(), hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()), uint64(vLog.Index))
+		// get validator signer from mainchain
+		validator, err := syncer.contractConnector.GetValidatorInfo(hmTypes.NewValidatorID(event.ValidatorId.Uint64()))
+		if err != nil {
+			syncer.Logger.Error("Unable to fetch validator from rootchain", "error", err, "validatorId", event.ValidatorId)
+			return
+		}
+
+		// create msg topup message
+		msg := bankTypes.NewMsgTopup(
+			helper.GetFromAddress(syncer.cliCtx),
+			event.ValidatorId.Uint64(),
+			validator.Signer,
+			hmTypes.NewIntFromBigInt(event.Fee),
+			hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+			uint64(vLog.Index),
+			vLog.BlockNumber,
+		)
 		syncer.queueConnector.BroadcastToHeimdall(msg)
 	}
 }
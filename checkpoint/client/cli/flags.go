@@ -9,4 +9,11 @@ const (
 	FlagHeaderNumber       = "header"
 	FlagCheckpointTxHash   = "txhash"
 	FlagCheckpointLogIndex = "log-index"
+	FlagBorBlockNumber     = "bor-block"
+	FlagValidatorID        = "id"
+	FlagLeafVersion        = "leaf-version"
+	FlagExportFrom         = "from"
+	FlagExportTo           = "to"
+	FlagExportFormat       = "format"
+	FlagExportVerify       = "verify"
 )
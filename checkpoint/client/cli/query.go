@@ -1,8 +1,11 @@
 package cli
 
 import (
+	"encoding/csv"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -13,6 +16,8 @@ import (
 
 	"github.com/maticnetwork/heimdall/checkpoint/types"
 	hmClient "github.com/maticnetwork/heimdall/client"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -29,16 +34,50 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	// supply query command
 	supplyQueryCmd.AddCommand(
 		client.GetCommands(
+			GetQueryParams(cdc),
 			GetCheckpointBuffer(cdc),
 			GetLastNoACK(cdc),
 			GetHeaderFromIndex(cdc),
 			GetCheckpointCount(cdc),
+			GetBlockProof(cdc),
+			GetValidatorStats(cdc),
+			GetCheckpointExport(cdc),
 		)...,
 	)
 
 	return supplyQueryCmd
 }
 
+// GetQueryParams get checkpoint params
+func GetQueryParams(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "show the current checkpoint parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams), nil)
+			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
+				return errors.New("No checkpoint params found")
+			}
+
+			var params types.Params
+			if err := json.Unmarshal(res, &params); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(params)
+		},
+	}
+
+	return cmd
+}
+
 // GetCheckpointBuffer get checkpoint present in buffer
 func GetCheckpointBuffer(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -153,3 +192,246 @@ func GetCheckpointCount(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetBlockProof get merkle proof of bor block in acknowledged checkpoint
+func GetBlockProof(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proof",
+		Short: "get merkle proof of bor block in checkpoint",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			borBlockNumber := viper.GetUint64(FlagBorBlockNumber)
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryProofParams(borBlockNumber))
+			if err != nil {
+				return err
+			}
+
+			// fetch proof
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProof), queryParams)
+			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
+				return errors.New("No proof found")
+			}
+
+			var proof types.BlockProof
+			if err := json.Unmarshal(res, &proof); err != nil {
+				return err
+			}
+
+			// verify proof locally before printing
+			if !proof.Verify(proof.RootHash) {
+				return errors.New("Invalid proof received")
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	cmd.Flags().Uint64(FlagBorBlockNumber, 0, "--bor-block=<bor block number>")
+	cmd.MarkFlagRequired(FlagBorBlockNumber)
+
+	return cmd
+}
+
+// GetValidatorStats get checkpoint stats of validator
+func GetValidatorStats(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-stats",
+		Short: "get checkpoint proposer stats of validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			validatorID := viper.GetUint64(FlagValidatorID)
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorStatsParams(hmTypes.ValidatorID(validatorID)))
+			if err != nil {
+				return err
+			}
+
+			// fetch validator stats
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorStats), queryParams)
+			if err != nil {
+				return err
+			}
+
+			var stats types.ValidatorStats
+			if err := json.Unmarshal(res, &stats); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(stats)
+		},
+	}
+	cmd.Flags().Uint64(FlagValidatorID, 0, "--id=<validator ID here>")
+	cmd.MarkFlagRequired(FlagValidatorID)
+
+	return cmd
+}
+
+// GetCheckpointExport exports acknowledged checkpoints, optionally verified against root chain
+func GetCheckpointExport(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "export acknowledged checkpoints as json or csv",
+		Long: strings.TrimSpace(
+			`Export acknowledged checkpoints from --from to --to (checkpoint numbers, starting from 1).
+With --verify every checkpoint is compared with header block on root chain and mismatched fields are reported.
+
+Example:
+$ heimdallcli query checkpoint export --from 1 --to 100 --format csv --verify
+`,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// --from is checkpoint number here, not key of the signer
+			cliCtx := context.NewCLIContextWithFrom("").WithCodec(cdc)
+
+			format := viper.GetString(FlagExportFormat)
+			if format != exportFormatJSON && format != exportFormatCSV {
+				return fmt.Errorf("invalid format %v, expected %v or %v", format, exportFormatJSON, exportFormatCSV)
+			}
+
+			from := viper.GetUint64(FlagExportFrom)
+			if from == 0 {
+				return errors.New("checkpoint numbers start from 1")
+			}
+
+			// export till last acknowledged checkpoint by default
+			to := viper.GetUint64(FlagExportTo)
+			if to == 0 {
+				res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAckCount), nil)
+				if err != nil {
+					return err
+				}
+
+				if err := cliCtx.Codec.UnmarshalJSON(res, &to); err != nil {
+					return err
+				}
+			}
+
+			if from > to {
+				return fmt.Errorf("from %v is greater than to %v", from, to)
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams), nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := json.Unmarshal(res, &params); err != nil {
+				return err
+			}
+
+			var contractCaller helper.IContractCaller
+			if viper.GetBool(FlagExportVerify) {
+				caller, err := helper.NewContractCaller()
+				if err != nil {
+					return err
+				}
+				contractCaller = &caller
+			}
+
+			exports, err := exportCheckpoints(cliCtx, params, contractCaller, from, to)
+			if err != nil {
+				return err
+			}
+
+			if format == exportFormatCSV {
+				w := csv.NewWriter(cmd.OutOrStdout())
+				if err := w.Write(types.CheckpointExportHeader); err != nil {
+					return err
+				}
+
+				for _, export := range exports {
+					if err := w.Write(export.CSVRecord()); err != nil {
+						return err
+					}
+				}
+
+				w.Flush()
+				return w.Error()
+			}
+
+			out, err := json.MarshalIndent(exports, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), string(out))
+			return nil
+		},
+	}
+	cmd.Flags().Uint64(FlagExportFrom, 1, "--from=<first checkpoint number>")
+	cmd.Flags().Uint64(FlagExportTo, 0, "--to=<last checkpoint number>, last acknowledged checkpoint if empty")
+	cmd.Flags().String(FlagExportFormat, exportFormatJSON, "--format=json|csv")
+	cmd.Flags().Bool(FlagExportVerify, false, "verify checkpoints against root chain")
+
+	return cmd
+}
+
+// export formats
+const (
+	exportFormatJSON = "json"
+	exportFormatCSV  = "csv"
+
+	// max limit of checkpoint list query
+	exportPageLimit = 20
+)
+
+// exportCheckpoints pages through checkpoint list and verifies checkpoints if contract caller is provided
+func exportCheckpoints(cliCtx context.CLIContext, params types.Params, contractCaller helper.IContractCaller, from uint64, to uint64) ([]types.CheckpointExport, error) {
+	var exports []types.CheckpointExport
+
+	for page := (from-1)/exportPageLimit + 1; ; page++ {
+		queryParams, err := cliCtx.Codec.MarshalJSON(hmTypes.NewQueryPaginationParams(page, exportPageLimit))
+		if err != nil {
+			return nil, err
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointList), queryParams)
+		if err != nil {
+			return nil, err
+		}
+
+		var checkpoints []hmTypes.CheckpointBlockHeader
+		if err := json.Unmarshal(res, &checkpoints); err != nil {
+			return nil, err
+		}
+
+		for i, checkpoint := range checkpoints {
+			// checkpoints are stored in order at number * child block interval
+			number := (page-1)*exportPageLimit + uint64(i) + 1
+			if number < from || number > to {
+				continue
+			}
+
+			export := types.CheckpointExport{
+				Number:      number,
+				HeaderIndex: number * params.ChildBlockInterval,
+				Checkpoint:  checkpoint,
+			}
+
+			if contractCaller != nil {
+				mismatches, err := types.GetRootChainMismatches(contractCaller, export.HeaderIndex, checkpoint)
+				if err != nil {
+					return nil, err
+				}
+
+				export.Verified = len(mismatches) == 0
+				export.Mismatches = mismatches
+			}
+
+			exports = append(exports, export)
+		}
+
+		if len(checkpoints) < exportPageLimit || page*exportPageLimit >= to {
+			return exports, nil
+		}
+	}
+}
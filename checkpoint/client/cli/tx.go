@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"time"
@@ -90,6 +91,21 @@ func SendCheckpointTx(cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("account root hash cannot be empty")
 			}
 
+			// use current leaf version if not provided
+			leafVersion := viper.GetUint64(FlagLeafVersion)
+			if leafVersion == 0 {
+				res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams), nil)
+				if err != nil {
+					return err
+				}
+
+				var params types.Params
+				if err := json.Unmarshal(res, &params); err != nil {
+					return err
+				}
+				leafVersion = params.LeafVersion
+			}
+
 			msg := types.NewMsgCheckpointBlock(
 				proposer,
 				startBlock,
@@ -97,6 +113,7 @@ func SendCheckpointTx(cdc *codec.Codec) *cobra.Command {
 				hmTypes.HexToHeimdallHash(rootHashStr),
 				hmTypes.HexToHeimdallHash(accountRootHashStr),
 				uint64(time.Now().UTC().Unix()),
+				leafVersion,
 			)
 
 			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
@@ -107,6 +124,7 @@ func SendCheckpointTx(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(FlagEndBlock, "", "--end-block=<end-block-number>")
 	cmd.Flags().StringP(FlagRootHash, "r", "", "--root-hash=<root-hash>")
 	cmd.Flags().String(FlagAccountRootHash, "", "--account-root=<account-root>")
+	cmd.Flags().Uint64(FlagLeafVersion, 0, "--leaf-version=<leaf-version>, current leaf version if empty")
 	cmd.MarkFlagRequired(FlagStartBlock)
 	cmd.MarkFlagRequired(FlagEndBlock)
 	cmd.MarkFlagRequired(FlagRootHash)
@@ -146,8 +164,28 @@ func SendCheckpointACKTx(cdc *codec.Codec) *cobra.Command {
 
 			checkpointTxHash := hmTypes.BytesToHeimdallHash(common.FromHex(checkpointTxHashStr))
 
+			contractCallerObj, err := helper.NewContractCaller()
+			if err != nil {
+				return err
+			}
+
+			// fetch checkpoint submitted on rootchain contract
+			root, start, end, _, headerProposer, err := contractCallerObj.GetHeaderInfo(headerBlock)
+			if err != nil {
+				return err
+			}
+
 			// new checkpoint
-			msg := types.NewMsgCheckpointAck(proposer, headerBlock, checkpointTxHash, uint64(viper.GetInt64(FlagCheckpointLogIndex)))
+			msg := types.NewMsgCheckpointAck(
+				proposer,
+				headerBlock,
+				headerProposer,
+				start,
+				end,
+				hmTypes.BytesToHeimdallHash(root.Bytes()),
+				checkpointTxHash,
+				uint64(viper.GetInt64(FlagCheckpointLogIndex)),
+			)
 
 			// msg
 			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
@@ -15,13 +15,17 @@ import (
 	"github.com/maticnetwork/bor/common"
 	ethcmn "github.com/maticnetwork/bor/common"
 	"github.com/maticnetwork/heimdall/checkpoint/types"
-	"github.com/maticnetwork/heimdall/helper"
 	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	hmRest "github.com/maticnetwork/heimdall/types/rest"
 )
 
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc(
+		"/checkpoint/params",
+		paramsHandlerFn(cliCtx),
+	).Methods("GET")
+
 	r.HandleFunc(
 		"/checkpoint/buffer",
 		checkpointBufferHandlerFn(cliCtx),
@@ -40,6 +44,14 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		latestCheckpointHandlerFunc(cliCtx),
 	).Methods("GET")
 
+	r.HandleFunc("/checkpoint/validator-stats/{id}",
+		validatorStatsHandlerFn(cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc("/checkpoint/proof/{borBlockNumber}",
+		proofHandlerFn(cliCtx),
+	).Methods("GET")
+
 	r.HandleFunc("/checkpoint/{checkpointNumber}",
 		checkpointByNumberHandlerFunc(cliCtx),
 	).Methods("GET")
@@ -58,6 +70,24 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		checkpointListhandlerFn(cliCtx)).Methods("GET")
 }
 
+func paramsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams), nil)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func checkpointBufferHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
@@ -153,6 +183,7 @@ type HeaderBlockResult struct {
 	RootHash   common.Hash             `json:"rootHash"`
 	StartBlock uint64                  `json:"startBlock"`
 	EndBlock   uint64                  `json:"endBlock"`
+	Version    uint64                  `json:"version"`
 }
 
 func checkpointHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
@@ -178,8 +209,15 @@ func checkpointHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
+		// get checkpoint params
+		params, err := queryParams(cliCtx)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
 		// get headers
-		roothash, err := types.GetHeaders(uint64(start), uint64(end))
+		roothash, err := types.GetHeaders(uint64(start), uint64(end), params.AvgCheckpointLength, params.LeafVersion)
 		if err != nil {
 			RestLogger.Error("Unable to get header", "Start", start, "End", end, "Error", err)
 			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -207,6 +245,7 @@ func checkpointHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			StartBlock: uint64(start),
 			EndBlock:   uint64(end),
 			RootHash:   ethcmn.BytesToHash(roothash),
+			Version:    params.LeafVersion,
 		}
 
 		result, err := json.Marshal(checkpoint)
@@ -221,6 +260,77 @@ func checkpointHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+func proofHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get bor block number
+		borBlockNumber, ok := rest.ParseUint64OrReturnBadRequest(w, vars["borBlockNumber"])
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryProofParams(borBlockNumber))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProof), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// check content
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No proof found"); !ok {
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func validatorStatsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get validator id
+		validatorID, ok := rest.ParseUint64OrReturnBadRequest(w, vars["id"])
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorStatsParams(hmTypes.ValidatorID(validatorID)))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorStats), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func noackHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
@@ -394,11 +504,17 @@ func latestCheckpointHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
 		// Last checkpoint key
 		//
 
+		params, err := queryParams(cliCtx)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
 		RestLogger.Debug("ACK Count fetched", "ackCount", ackCount)
-		lastCheckpointKey := helper.GetConfig().ChildBlockInterval * ackCount
+		lastCheckpointKey := params.ChildBlockInterval * ackCount
 		RestLogger.Debug("Last checkpoint key generated",
 			"lastCheckpointKey", lastCheckpointKey,
-			"min", helper.GetConfig().ChildBlockInterval,
+			"min", params.ChildBlockInterval,
 		)
 
 		// get query params
@@ -442,11 +558,17 @@ func checkpointByNumberHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
+		params, err := queryParams(cliCtx)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
 		RestLogger.Debug("Get Checkpoint for ", "checkpointNumber", checkpointNumber)
-		checkpointKey := helper.GetConfig().ChildBlockInterval * checkpointNumber
+		checkpointKey := params.ChildBlockInterval * checkpointNumber
 		RestLogger.Debug("checkpoint key generated",
 			"checkpointKey", checkpointKey,
-			"min", helper.GetConfig().ChildBlockInterval,
+			"min", params.ChildBlockInterval,
 		)
 
 		// get query params
@@ -515,3 +637,14 @@ func checkpointListhandlerFn(
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+// queryParams fetches checkpoint params from chain state
+func queryParams(cliCtx context.CLIContext) (params types.Params, err error) {
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams), nil)
+	if err != nil {
+		return params, err
+	}
+
+	err = json.Unmarshal(res, &params)
+	return params, err
+}
@@ -33,6 +33,7 @@ type (
 		AccountRootHash hmTypes.HeimdallHash    `json:"accountRootHash"`
 		StartBlock      uint64                  `json:"startBlock"`
 		EndBlock        uint64                  `json:"endBlock"`
+		Version         uint64                  `json:"version"` // current leaf version is used if empty
 	}
 
 	// HeaderACKReq struct for sending ACK for a new headers
@@ -40,10 +41,14 @@ type (
 	HeaderACKReq struct {
 		BaseReq rest.BaseReq `json:"base_req"`
 
-		Proposer    hmTypes.HeimdallAddress `json:"proposer"`
-		HeaderBlock uint64                  `json:"headerBlock"`
-		TxHash      hmTypes.HeimdallHash    `json:"tx_hash"`
-		LogIndex    uint64                  `json:"log_index"`
+		Proposer       hmTypes.HeimdallAddress `json:"proposer"`
+		HeaderBlock    uint64                  `json:"headerBlock"`
+		HeaderProposer hmTypes.HeimdallAddress `json:"headerProposer"`
+		StartBlock     uint64                  `json:"startBlock"`
+		EndBlock       uint64                  `json:"endBlock"`
+		RootHash       hmTypes.HeimdallHash    `json:"rootHash"`
+		TxHash         hmTypes.HeimdallHash    `json:"tx_hash"`
+		LogIndex       uint64                  `json:"log_index"`
 	}
 
 	// HeaderNoACKReq struct for sending no-ack for a new headers
@@ -66,6 +71,16 @@ func newCheckpointHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
+		// use current leaf version if not provided
+		if req.Version == 0 {
+			params, err := queryParams(cliCtx)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+			req.Version = params.LeafVersion
+		}
+
 		// draft a message and send response
 		msg := types.NewMsgCheckpointBlock(
 			req.Proposer,
@@ -74,6 +89,7 @@ func newCheckpointHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			req.RootHash,
 			req.AccountRootHash,
 			uint64(time.Now().UTC().Unix()),
+			req.Version,
 		)
 
 		// send response
@@ -94,7 +110,16 @@ func newCheckpointACKHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		// draft a message and send response
-		msg := types.NewMsgCheckpointAck(req.Proposer, req.HeaderBlock, req.TxHash, req.LogIndex)
+		msg := types.NewMsgCheckpointAck(
+			req.Proposer,
+			req.HeaderBlock,
+			req.HeaderProposer,
+			req.StartBlock,
+			req.EndBlock,
+			req.RootHash,
+			req.TxHash,
+			req.LogIndex,
+		)
 
 		// send response
 		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
@@ -6,12 +6,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/maticnetwork/heimdall/checkpoint/types"
-	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+
 	// Set last no-ack
 	if data.LastNoACK > 0 {
 		keeper.SetLastNoAck(ctx, data.LastNoACK)
@@ -28,7 +29,7 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 
 		// load checkpoints to state
 		for i, header := range data.Headers {
-			checkpointHeaderIndex := helper.GetConfig().ChildBlockInterval * (uint64(i) + 1)
+			checkpointHeaderIndex := data.Params.ChildBlockInterval * (uint64(i) + 1)
 			keeper.AddCheckpoint(ctx, checkpointHeaderIndex, header)
 		}
 	}
@@ -40,15 +41,22 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 
 	// Set initial ack count
 	keeper.UpdateACKCountWithValue(ctx, data.AckCount)
+
+	// Set validator checkpoint stats
+	for _, stats := range data.ValidatorStats {
+		keeper.SetValidatorStats(ctx, stats)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	bufferedCheckpoint, _ := keeper.GetCheckpointFromBuffer(ctx)
 	return types.NewGenesisState(
+		keeper.GetParams(ctx),
 		bufferedCheckpoint,
 		keeper.GetLastNoAck(ctx),
 		keeper.GetACKCount(ctx),
 		hmTypes.SortHeaders(keeper.GetCheckpointHeaders(ctx)),
+		keeper.GetAllValidatorStats(ctx),
 	)
 }
@@ -2,7 +2,6 @@ package checkpoint
 
 import (
 	"bytes"
-	"strconv"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -20,9 +19,9 @@ func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
 
 		switch msg := msg.(type) {
 		case types.MsgCheckpoint:
-			return handleMsgCheckpoint(ctx, msg, k, contractCaller)
+			return handleMsgCheckpoint(ctx, msg, k)
 		case types.MsgCheckpointAck:
-			return handleMsgCheckpointAck(ctx, msg, k, contractCaller)
+			return handleMsgCheckpointAck(ctx, msg, k)
 		case types.MsgCheckpointNoAck:
 			return handleMsgCheckpointNoAck(ctx, msg, k)
 		default:
@@ -31,51 +30,60 @@ func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
 	}
 }
 
-// handleMsgCheckpoint Validates checkpoint transaction
-func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, contractCaller helper.IContractCaller) sdk.Result {
+// handleMsgCheckpoint Validates checkpoint transaction, root hash is validated by side tx handler
+func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper) sdk.Result {
 	k.Logger(ctx).Debug("Validating checkpoint data", "TxData", msg)
 
-	if msg.TimeStamp == 0 || msg.TimeStamp > uint64(time.Now().UTC().Unix()) {
-		k.Logger(ctx).Error("Checkpoint timestamp must be in near past", "CurrentTime", time.Now().UTC().Unix(), "CheckpointTime", msg.TimeStamp, "Condition", msg.TimeStamp >= uint64(time.Now().UTC().Unix()))
-		return common.ErrBadTimeStamp(k.Codespace()).Result()
+	if err := validateCheckpoint(ctx, msg, k); err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// validateCheckpoint checks checkpoint against current state
+func validateCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper) sdk.Error {
+	// block time is the only clock all validators agree on
+	blockTime := ctx.BlockTime()
+	if msg.TimeStamp == 0 || msg.TimeStamp > uint64(blockTime.Unix()) {
+		k.Logger(ctx).Error("Checkpoint timestamp must be in near past", "BlockTime", blockTime.Unix(), "CheckpointTime", msg.TimeStamp)
+		return common.ErrBadTimeStamp(k.Codespace())
 	}
 
 	checkpointBuffer, err := k.GetCheckpointFromBuffer(ctx)
 	if err == nil {
-		if msg.TimeStamp == 0 || checkpointBuffer.TimeStamp == 0 || ((msg.TimeStamp > checkpointBuffer.TimeStamp) && msg.TimeStamp-checkpointBuffer.TimeStamp >= uint64(helper.GetConfig().CheckpointBufferTime.Seconds())) {
-			k.Logger(ctx).Debug("Checkpoint has been timed out, flushing buffer", "CheckpointTimestamp", msg.TimeStamp, "PrevCheckpointTimestamp", checkpointBuffer.TimeStamp)
-			k.FlushCheckpointBuffer(ctx)
-		} else {
+		// calulates expiry time for buffered checkpoint
+		checkpointTime := time.Unix(int64(checkpointBuffer.TimeStamp), 0)
+		expiryTime := checkpointTime.Add(k.GetParams(ctx).CheckpointBufferTime)
+		// expired checkpoint is flushed by post handler once new checkpoint is voted
+		if checkpointBuffer.TimeStamp != 0 && blockTime.Before(expiryTime) {
 			// calulates remaining time for buffer to be flushed
-			checkpointTime := time.Unix(int64(checkpointBuffer.TimeStamp), 0)
-			expiryTime := checkpointTime.Add(helper.GetConfig().CheckpointBufferTime)
-			diff := expiryTime.Sub(time.Now().UTC()).Seconds()
+			diff := expiryTime.Sub(blockTime).Seconds()
 			k.Logger(ctx).Error("Checkpoint already exits in buffer", "Checkpoint", checkpointBuffer.String(), "Expires", expiryTime)
-			return common.ErrNoACK(k.Codespace(), diff).Result()
+			return common.ErrNoACK(k.Codespace(), diff)
 		}
 	}
 	// k.Logger(ctx).Debug("Received checkpoint from buffer", "Checkpoint", checkpointBuffer.String())
 
-	// validate checkpoint
-	validCheckpoint, err := types.ValidateCheckpoint(msg.StartBlock, msg.EndBlock, msg.RootHash)
-	if err != nil {
-		k.Logger(ctx).Error("Error validating checkpoint",
-			"Error", err,
-			"StartBlock", msg.StartBlock,
-			"EndBlock", msg.EndBlock)
-		return common.ErrBadBlockDetails(k.Codespace()).Result()
+	// make sure checkpoint doesn't exceed allowed length
+	params := k.GetParams(ctx)
+	if msg.Version != params.LeafVersion {
+		k.Logger(ctx).Error("Checkpoint leaf version doesn't match current leaf version",
+			"version", msg.Version,
+			"leafVersion", params.LeafVersion)
+		return common.ErrInvalidLeafVersion(k.Codespace(), params.LeafVersion)
 	}
 
-	if !validCheckpoint {
-		k.Logger(ctx).Error("RootHash is not valid",
+	if msg.EndBlock-msg.StartBlock+1 > params.MaxCheckpointLength {
+		k.Logger(ctx).Error("Checkpoint exceeds max checkpoint length",
 			"StartBlock", msg.StartBlock,
 			"EndBlock", msg.EndBlock,
-			"RootHash", msg.RootHash)
-		return common.ErrBadBlockDetails(k.Codespace()).Result()
+			"MaxCheckpointLength", params.MaxCheckpointLength)
+		return common.ErrBadBlockDetails(k.Codespace())
 	}
 
-	k.Logger(ctx).Debug("Valid Roothash in checkpoint", "StartBlock", msg.StartBlock, "EndBlock", msg.EndBlock)
-
 	// fetch last checkpoint from store
 	if lastCheckpoint, err := k.GetLastCheckpoint(ctx); err == nil {
 		// make sure new checkpoint is after tip
@@ -83,17 +91,17 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 			k.Logger(ctx).Error("Checkpoint already exists",
 				"currentTip", lastCheckpoint.EndBlock,
 				"startBlock", msg.StartBlock)
-			return common.ErrOldCheckpoint(k.Codespace()).Result()
+			return common.ErrOldCheckpoint(k.Codespace())
 		}
 		if lastCheckpoint.EndBlock+1 != msg.StartBlock {
 			k.Logger(ctx).Error("Checkpoint not in countinuity",
 				"currentTip", lastCheckpoint.EndBlock,
 				"startBlock", msg.StartBlock)
-			return common.ErrDisCountinuousCheckpoint(k.Codespace()).Result()
+			return common.ErrDisCountinuousCheckpoint(k.Codespace())
 		}
 	} else if err.Error() == common.ErrNoCheckpointFound(k.Codespace()).Error() && msg.StartBlock != 0 {
 		k.Logger(ctx).Error("First checkpoint to start from block 1", "Error", err)
-		return common.ErrBadBlockDetails(k.Codespace()).Result()
+		return common.ErrBadBlockDetails(k.Codespace())
 	}
 	k.Logger(ctx).Debug("Valid checkpoint tip")
 
@@ -107,7 +115,7 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 	if !bytes.Equal(accountRoot, msg.AccountRootHash.Bytes()) {
 		k.Logger(ctx).Error("AccountRootHash of current state", hmTypes.BytesToHeimdallHash(accountRoot).String(),
 			"doesn't match with AccountRootHash of msg", msg.AccountRootHash)
-		return common.ErrBadBlockDetails(k.Codespace()).Result()
+		return common.ErrBadBlockDetails(k.Codespace())
 	}
 
 	k.Logger(ctx).Debug("AccountRootHash matches")
@@ -117,144 +125,81 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 		k.Logger(ctx).Error("Invalid proposer in message",
 			"currentProposer", k.sk.GetValidatorSet(ctx).Proposer.Signer.String(),
 			"checkpointProposer", msg.Proposer.String())
-		return common.ErrBadProposerDetails(k.Codespace(), k.sk.GetValidatorSet(ctx).Proposer.Signer).Result()
+		return common.ErrBadProposerDetails(k.Codespace(), k.sk.GetValidatorSet(ctx).Proposer.Signer)
 	}
 	k.Logger(ctx).Debug("Valid proposer in checkpoint")
 
-	// add checkpoint to buffer
-	// Add AccountRootHash to CheckpointBuffer
-	k.SetCheckpointBuffer(ctx, hmTypes.CheckpointBlockHeader{
-		StartBlock:      msg.StartBlock,
-		EndBlock:        msg.EndBlock,
-		RootHash:        msg.RootHash,
-		AccountRootHash: msg.AccountRootHash,
-		Proposer:        msg.Proposer,
-		TimeStamp:       msg.TimeStamp,
-	})
-
-	checkpoint, _ := k.GetCheckpointFromBuffer(ctx)
-	k.Logger(ctx).Debug("Adding good checkpoint to buffer to await ACK", "checkpointStored", checkpoint.String())
-
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeCheckpoint,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer.String()),
-			sdk.NewAttribute(types.AttributeKeyStartBlock, strconv.FormatUint(uint64(msg.StartBlock), 10)),
-			sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(uint64(msg.EndBlock), 10)),
-		),
-	})
-
-	return sdk.Result{
-		Events: ctx.EventManager().Events(),
-	}
+	return nil
 }
 
-// handleMsgCheckpointAck Validates if checkpoint submitted on chain is valid
-func handleMsgCheckpointAck(ctx sdk.Context, msg types.MsgCheckpointAck, k Keeper, contractCaller helper.IContractCaller) sdk.Result {
+// handleMsgCheckpointAck Validates checkpoint ack against buffer, new header block event is validated by side tx handler
+func handleMsgCheckpointAck(ctx sdk.Context, msg types.MsgCheckpointAck, k Keeper) sdk.Result {
 	k.Logger(ctx).Debug("Validating Checkpoint ACK", "Tx", msg)
 
-	// make call to headerBlock with header number
-	root, start, end, createdAt, proposer, err := contractCaller.GetHeaderInfo(msg.HeaderBlock)
-	if err != nil {
-		k.Logger(ctx).Error("Unable to fetch header from rootchain contract", "Error", err, "headerBlockIndex", msg.HeaderBlock)
-		return common.ErrBadAck(k.Codespace()).Result()
+	if _, err := validateCheckpointAck(ctx, msg, k); err != nil {
+		return err.Result()
 	}
 
-	// check confirmation
-	latestBlock, err := contractCaller.GetMainChainBlock(nil)
-	if err != nil {
-		k.Logger(ctx).Error("Unable to connect to mainchain", "Error", err)
-		return common.ErrNoConn(k.Codespace()).Result()
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
 	}
+}
 
-	if latestBlock.Number.Uint64()-createdAt < helper.GetConfig().ConfirmationBlocks {
-		k.Logger(ctx).Error("Not enough confirmations", "latestBlock", latestBlock.Number.Uint64(), "txBlock", createdAt)
-		return common.ErrWaitForConfirmation(k.Codespace()).Result()
+// validateCheckpointAck checks checkpoint ack against checkpoint in buffer, returns checkpoint to be acknowledged
+func validateCheckpointAck(ctx sdk.Context, msg types.MsgCheckpointAck, k Keeper) (*hmTypes.CheckpointBlockHeader, sdk.Error) {
+	params := k.GetParams(ctx)
+	if msg.HeaderBlock > 0 && msg.HeaderBlock%params.ChildBlockInterval != 0 {
+		k.Logger(ctx).Error("Invalid header block", "headerBlockIndex", msg.HeaderBlock, "childBlockInterval", params.ChildBlockInterval)
+		return nil, common.ErrBadAck(k.Codespace())
 	}
 
-	k.Logger(ctx).Debug("HeaderBlock fetched",
-		"headerBlock", msg.HeaderBlock,
-		"start", start,
-		"end", end,
-		"roothash", root,
-		"proposer", proposer,
-		"createdAt", createdAt,
-		"latest", latestBlock.Number.Uint64(),
-	)
-
 	// get last checkpoint from buffer
 	headerBlock, err := k.GetCheckpointFromBuffer(ctx)
-	if err != nil {
+	if err != nil || headerBlock == nil {
 		k.Logger(ctx).Error("Unable to get checkpoint", "error", err)
-		return common.ErrBadAck(k.Codespace()).Result()
+		return nil, common.ErrBadAck(k.Codespace())
+	}
+
+	if msg.StartBlock != headerBlock.StartBlock || msg.EndBlock > headerBlock.EndBlock {
+		k.Logger(ctx).Error("Invalid start or end block",
+			"startExpected", headerBlock.StartBlock,
+			"startReceived", msg.StartBlock,
+			"endExpected", headerBlock.EndBlock,
+			"endReceived", msg.EndBlock)
+		return nil, common.ErrBadAck(k.Codespace())
 	}
-	if start != headerBlock.StartBlock {
-		k.Logger(ctx).Error("Invalid start block", "startExpected", headerBlock.StartBlock, "startReceived", start)
-		return common.ErrBadAck(k.Codespace()).Result()
-	} else if start == headerBlock.StartBlock && end == headerBlock.EndBlock && !bytes.Equal(root.Bytes(), headerBlock.RootHash.Bytes()) {
+
+	// same checkpoint as buffer, root and proposer must match
+	if msg.EndBlock == headerBlock.EndBlock &&
+		(!bytes.Equal(msg.RootHash.Bytes(), headerBlock.RootHash.Bytes()) ||
+			!bytes.Equal(msg.Proposer.Bytes(), headerBlock.Proposer.Bytes())) {
 		k.Logger(ctx).Error("Invalid ACK",
 			"startExpected", headerBlock.StartBlock,
-			"startReceived", start,
+			"startReceived", msg.StartBlock,
 			"endExpected", headerBlock.EndBlock,
-			"endReceived", end,
+			"endReceived", msg.EndBlock,
 			"rootExpected", headerBlock.RootHash.String(),
-			"rootRecieved", root.String())
-		return common.ErrBadAck(k.Codespace()).Result()
-	}
-	if headerBlock.EndBlock > end {
-		k.Logger(ctx).Info("Adjusting endBlock to one already submitted on chain", "OldEndBlock", headerBlock.EndBlock, "AdjustedEndBlock", end)
-		headerBlock.EndBlock = end
-		headerBlock.RootHash = hmTypes.HeimdallHash(root)
-		// TODO proposer also needs to be changed
+			"rootRecieved", msg.RootHash.String(),
+			"proposerExpected", headerBlock.Proposer.String(),
+			"proposerReceived", msg.Proposer.String())
+		return nil, common.ErrBadAck(k.Codespace())
 	}
 
-	// Add checkpoint to headerBlocks
-	k.AddCheckpoint(ctx, msg.HeaderBlock, *headerBlock)
-	k.Logger(ctx).Info("Checkpoint added to store", "headerBlock", headerBlock.String())
-
-	// flush buffer
-	k.FlushCheckpointBuffer(ctx)
-	k.Logger(ctx).Debug("Checkpoint buffer flushed after receiving checkpoint ack", "checkpoint", headerBlock)
-
-	// update ack count
-	k.UpdateACKCount(ctx)
-	k.Logger(ctx).Debug("Valid ack received", "CurrentACKCount", k.GetACKCount(ctx)-1, "UpdatedACKCount", k.GetACKCount(ctx))
-
-	// --- Update to new proposer
-
-	// increment accum
-	k.sk.IncrementAccum(ctx, 1)
-
-	//log new proposer
-	vs := k.sk.GetValidatorSet(ctx)
-	newProposer := vs.GetProposer()
-	k.Logger(ctx).Debug(
-		"New proposer selected",
-		"validator", newProposer.Signer.String(),
-		"signer", newProposer.Signer.String(),
-		"power", newProposer.VotingPower,
-	)
-
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeCheckpointAck,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(types.AttributeKeyHeaderIndex, strconv.FormatUint(uint64(msg.HeaderBlock), 10)),
-		),
-	})
-
-	return sdk.Result{
-		Events: ctx.EventManager().Events(),
-	}
+	return headerBlock, nil
 }
 
 // Validate checkpoint no-ack transaction
 func handleMsgCheckpointNoAck(ctx sdk.Context, msg types.MsgCheckpointNoAck, k Keeper) sdk.Result {
 	k.Logger(ctx).Debug("Validating checkpoint no-ack", "TxData", msg)
 	// current time
-	currentTime := time.Unix(int64(msg.TimeStamp), 0) // buffer time
-	bufferTime := helper.GetConfig().CheckpointBufferTime
+	currentTime := ctx.BlockTime()
+	if msg.TimeStamp > uint64(currentTime.Unix()) {
+		k.Logger(ctx).Error("No-ack timestamp must be in near past", "BlockTime", currentTime.Unix(), "NoAckTime", msg.TimeStamp)
+		return common.ErrBadTimeStamp(k.Codespace()).Result()
+	}
+
+	// buffer time
+	bufferTime := k.GetParams(ctx).CheckpointBufferTime
 
 	// fetch last checkpoint from store
 	// TODO figure out how to handle this error
@@ -280,10 +225,13 @@ func handleMsgCheckpointNoAck(ctx sdk.Context, msg types.MsgCheckpointNoAck, k K
 	k.SetLastNoAck(ctx, uint64(currentTime.Unix()))
 	k.Logger(ctx).Debug("Last No-ACK time set", "LastNoAck", k.GetLastNoAck(ctx))
 
+	// record no-ack for proposer being skipped
+	k.RecordValidatorStats(ctx, k.sk.GetValidatorSet(ctx).Proposer.Signer, types.StatsEventNoAck, hmTypes.CheckpointBlockHeader{})
+
 	// --- Update to new proposer
 
-	// increment accum
-	k.sk.IncrementAccum(ctx, 1)
+	// subscribers rotate proposer
+	k.AfterCheckpointNoACK(ctx)
 
 	//log new proposer
 	vs := k.sk.GetValidatorSet(ctx)
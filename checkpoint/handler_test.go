@@ -1,226 +1,52 @@
-package checkpoint
+package checkpoint_test
 
 import (
-	"bytes"
-	"encoding/hex"
-	"math/rand"
-	"os"
+	"errors"
+	"math/big"
 	"testing"
 	"time"
 
-	"github.com/cosmos/cosmos-sdk/codec"
-	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/params"
-	"github.com/cosmos/cosmos-sdk/x/params/subspace"
-	"github.com/maticnetwork/heimdall/bor"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maticnetwork/heimdall/checkpoint"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/contracts/rootchain"
 	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/helper/mocks"
 	"github.com/maticnetwork/heimdall/staking"
+	cmn "github.com/maticnetwork/heimdall/test"
 	"github.com/maticnetwork/heimdall/types"
-	"github.com/stretchr/testify/require"
-	"github.com/tendermint/tendermint/crypto/secp256k1"
-
-	// "testing"
-	// "bytes"
-	// "encoding/hex"
-	// "math/rand"
-	// "os"
-	// "time"
-	// "github.com/maticnetwork/heimdall/helper/mocks"
-	// "github.com/cosmos/cosmos-sdk/codec"
-	// "github.com/cosmos/cosmos-sdk/store"
-	// "github.com/cosmos/cosmos-sdk/x/params"
-	ethcmn "github.com/maticnetwork/bor/common"
-	// "github.com/stretchr/testify/require"
-	abci "github.com/tendermint/tendermint/abci/types"
-	// "github.com/tendermint/tendermint/crypto/secp256k1"
-	"github.com/tendermint/tendermint/libs/log"
-	dbm "github.com/tendermint/tm-db"
-
-	bankTypes "github.com/maticnetwork/heimdall/bank/types"
-	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
-	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
 )
 
-func MakeTestCodec() *codec.Codec {
-	cdc := codec.New()
-
-	codec.RegisterCrypto(cdc)
-	sdk.RegisterCodec(cdc)
-
-	bankTypes.RegisterCodec(cdc)
-
-	// custom types
-	bor.RegisterCodec(cdc)
-	RegisterCodec(cdc)
-	staking.RegisterCodec(cdc)
-
-	cdc.Seal()
-	return cdc
-}
-
-// init for test cases
-func CreateTestInput(t *testing.T, isCheckTx bool) (sdk.Context, staking.Keeper, Keeper) {
-	//t.Parallel()
-	helper.InitHeimdallConfig(os.ExpandEnv("$HOME/.heimdalld"))
-
-	db := dbm.NewMemDB()
-	ms := store.NewCommitMultiStore(db)
-
-	// TODO create more keys like borKey etc
-	keyCheckpoint := sdk.NewKVStoreKey("checkpoint")
-	keyStaking := sdk.NewKVStoreKey("staking")
-	keyMaster := sdk.NewKVStoreKey("master")
-	keyParams := sdk.NewKVStoreKey(subspace.StoreKey)
-	tKeyParams := sdk.NewTransientStoreKey(subspace.TStoreKey)
-
-	// mount all
-	ms.MountStoreWithDB(keyCheckpoint, sdk.StoreTypeIAVL, db)
-	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
-	ms.MountStoreWithDB(keyMaster, sdk.StoreTypeIAVL, db)
-	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
-	ms.MountStoreWithDB(tKeyParams, sdk.StoreTypeTransient, db)
-
-	err := ms.LoadLatestVersion()
-	require.Nil(t, err)
-
-	ctx := sdk.NewContext(ms, abci.Header{ChainID: "foochainid"}, isCheckTx, log.NewNopLogger())
-	cdc := MakeTestCodec()
-	//pulp := MakeTestPulp()
-	paramsKeeper := params.NewKeeper(cdc, keyParams, tKeyParams)
-
-	dummyStakingKeeper := staking.Keeper{}
-
-	checkpointKeeper := NewKeeper(
-		cdc,
-		dummyStakingKeeper,
-		keyCheckpoint,
-		paramsKeeper.Subspace(checkpointTypes.DefaultParamspace),
-		common.DefaultCodespace,
-	)
-
-	stakingKeeper := staking.NewKeeper(
-		cdc,
-		keyStaking,
-		paramsKeeper.Subspace(stakingTypes.DefaultParamspace),
-		common.DefaultCodespace,
-		checkpointKeeper,
-	)
-	checkpointKeeper.sk = stakingKeeper
-	return ctx, stakingKeeper, checkpointKeeper
-}
-
-// create random header block
-func GenRandCheckpointHeader(start int, headerSize int) (headerBlock types.CheckpointBlockHeader, err error) {
-	start = start
-	end := start + headerSize
-	roothash, err := GetHeaders(uint64(start), uint64(end))
-	if err != nil {
-		return headerBlock, err
-	}
-	proposer := ethcmn.Address{}
-	headerBlock = types.CreateBlock(uint64(start), uint64(end), types.HexToHeimdallHash(hex.EncodeToString(roothash)), types.HexToHeimdallHash(hex.EncodeToString(roothash)), types.HexToHeimdallAddress(proposer.String()), uint64(time.Now().Unix()))
-
-	return headerBlock, nil
-}
-
-// Generate random validators
-func GenRandomVal(count int, startBlock uint64, power int64, timeAlive uint64, randomise bool, startID uint64) (validators []types.Validator) {
-	for i := 0; i < count; i++ {
-		privKey1 := secp256k1.GenPrivKey()
-		pubkey := types.NewPubKey(privKey1.PubKey().Bytes())
-		if randomise {
-			startBlock := uint64(rand.Intn(10))
-			// todo find a way to genrate non zero random number
-			if startBlock == 0 {
-				startBlock = 1
-			}
-			power := uint64(rand.Intn(100))
-			if power == 0 {
-				power = 1
-			}
-		}
-
-		newVal := types.Validator{
-			ID:               types.NewValidatorID(startID + uint64(i)),
-			StartEpoch:       startBlock,
-			EndEpoch:         startBlock + timeAlive,
-			VotingPower:      power,
-			Signer:           types.HexToHeimdallAddress(pubkey.Address().String()),
-			PubKey:           pubkey,
-			ProposerPriority: 0,
-		}
-		validators = append(validators, newVal)
-	}
-	return
-}
-
-// Load Validator Set
-func LoadValidatorSet(count int, t *testing.T, keeper staking.Keeper, ctx sdk.Context, randomise bool, timeAlive int) types.ValidatorSet {
-	// create 4 validators
-	validators := GenRandomVal(4, 0, 10, uint64(timeAlive), randomise, 1)
-	var valSet types.ValidatorSet
-	// add validators to new Validator set and state
-	for _, validator := range validators {
-		err := keeper.AddValidator(ctx, validator)
-		require.Empty(t, err, "Unable to set validator, Error: %v", err)
-		// add validator to validator set
-		// valSet.Add(&validator)
-		valSet.UpdateWithChangeSet([]*types.Validator{&validator})
-	}
-
-	err := keeper.UpdateValidatorSetInStore(ctx, valSet)
-	require.Empty(t, err, "Unable to update validator set")
-
-	vals := keeper.GetAllValidators(ctx)
-	t.Log("Vals added to store", len(vals))
-	return valSet
-}
-
-// finds address in give validator slice
-func FindSigner(signer ethcmn.Address, vals []types.Validator) bool {
-	for _, val := range vals {
-		if bytes.Compare(signer.Bytes(), val.Signer.Bytes()) == 0 {
-			return true
-		}
-	}
-	return false
-}
-
-// print validators
-func PrintVals(t *testing.T, valset *types.ValidatorSet) {
-	t.Log("Printing validators")
-	for i, val := range valset.Validators {
-		t.Log("Validator ===> ", "Index", i, "ValidatorInfo", val.String())
-	}
-}
-
 // test handler for message
 func TestHandleMsgCheckpoint(t *testing.T) {
 	contractCallerObj := mocks.IContractCaller{}
 
 	// check valid checkpoint
 	t.Run("validCheckpoint", func(t *testing.T) {
-		ctx, sk, ck := CreateTestInput(t, false)
+		ctx, sk, ck := cmn.CreateTestInput(t, false)
+		ctx = ctx.WithBlockTime(time.Now().UTC())
 		// generate proposer for validator set
-		LoadValidatorSet(4, t, sk, ctx, false, 10)
+		cmn.LoadValidatorSet(4, t, sk, ctx, false, 10)
 		sk.IncrementAccum(ctx, 1)
-		header, err := GenRandCheckpointHeader(0, 10)
+		header, err := cmn.GenRandCheckpointHeader(0, 10)
 		require.Empty(t, err, "Unable to create random header block, Error:%v", err)
 		// make sure proposer has min ether
 		contractCallerObj.On("GetBalance", sk.GetValidatorSet(ctx).Proposer.Signer).Return(helper.MinBalance, nil)
-		SentValidCheckpoint(header, ck, sk, ctx, contractCallerObj, t)
+		SentValidCheckpoint(header, ck, sk, ctx, &contractCallerObj, t)
 	})
 
 	// check invalid proposer
 	t.Run("invalidProposer", func(t *testing.T) {
-		ctx, sk, ck := CreateTestInput(t, false)
+		ctx, sk, ck := cmn.CreateTestInput(t, false)
+		ctx = ctx.WithBlockTime(time.Now().UTC())
 		// generate proposer for validator set
-		LoadValidatorSet(4, t, sk, ctx, false, 10)
+		cmn.LoadValidatorSet(4, t, sk, ctx, false, 10)
 		sk.IncrementAccum(ctx, 1)
-		header, err := GenRandCheckpointHeader(0, 10)
+		header, err := cmn.GenRandCheckpointHeader(0, 10)
 		require.Empty(t, err, "Unable to create random header block, Error:%v", err)
 
 		// add wrong proposer to header
@@ -228,27 +54,28 @@ func TestHandleMsgCheckpoint(t *testing.T) {
 		// make sure proposer has min ether
 		contractCallerObj.On("GetBalance", header.Proposer).Return(helper.MinBalance, nil)
 		// create checkpoint msg
-		genesisValidatorRewards := sk.GetAllValidatorRewards(ctx)
-		genesisrewardRootHash, _ := GetRewardRootHash(genesisValidatorRewards)
-		header.RewardRootHash = types.BytesToHeimdallHash(genesisrewardRootHash)
+		accountRoot, _ := checkpointTypes.GetAccountRootHash(sk.GetAllDividendAccounts(ctx))
+		header.AccountRootHash = types.BytesToHeimdallHash(accountRoot)
 		// create checkpoint msg
-		msgCheckpoint := NewMsgCheckpointBlock(header.Proposer,
+		msgCheckpoint := checkpointTypes.NewMsgCheckpointBlock(header.Proposer,
 			header.StartBlock,
 			header.EndBlock,
 			header.RootHash,
-			header.RewardRootHash,
-			header.TimeStamp) // send checkpoint to handler
-		got := handleMsgCheckpoint(ctx, msgCheckpoint, ck, &contractCallerObj)
+			header.AccountRootHash,
+			header.TimeStamp,
+			checkpointTypes.DefaultLeafVersion) // send checkpoint to handler
+		got := checkpoint.NewHandler(ck, &contractCallerObj)(ctx, msgCheckpoint)
 		require.True(t, !got.IsOK(), "expected send-checkpoint to be not ok, got %v", got.IsOK())
 	})
 
 	t.Run("multipleCheckpoint", func(t *testing.T) {
 		t.Run("afterTimeout", func(t *testing.T) {
-			ctx, sk, ck := CreateTestInput(t, false)
+			ctx, sk, ck := cmn.CreateTestInput(t, false)
+			ctx = ctx.WithBlockTime(time.Now().UTC())
 			// generate proposer for validator set
-			LoadValidatorSet(4, t, sk, ctx, false, 10)
+			cmn.LoadValidatorSet(4, t, sk, ctx, false, 10)
 			sk.IncrementAccum(ctx, 1)
-			header, err := GenRandCheckpointHeader(0, 10)
+			header, err := cmn.GenRandCheckpointHeader(0, 10)
 			require.Empty(t, err, "Unable to create random header block, Error:%v", err)
 
 			// add current proposer to header
@@ -256,71 +83,464 @@ func TestHandleMsgCheckpoint(t *testing.T) {
 			// make sure proposer has min ether
 			contractCallerObj.On("GetBalance", header.Proposer).Return(helper.MinBalance, nil)
 			// create checkpoint 257 seconds prev to current time
-			header.TimeStamp = uint64(time.Now().Add(-(helper.CheckpointBufferTime + time.Second)).Unix())
-			t.Log("Sending checkpoint with timestamp", "Timestamp", header.TimeStamp, "Current", time.Now().UTC().Unix())
+			header.TimeStamp = uint64(ctx.BlockTime().Add(-(checkpointTypes.DefaultCheckpointBufferTime + time.Second)).Unix())
+			t.Log("Sending checkpoint with timestamp", "Timestamp", header.TimeStamp, "Current", ctx.BlockTime().Unix())
 			// send old checkpoint
-			SentValidCheckpoint(header, ck, sk, ctx, contractCallerObj, t)
+			SentValidCheckpoint(header, ck, sk, ctx, &contractCallerObj, t)
 
-			header, err = GenRandCheckpointHeader(0, 10)
+			header, err = cmn.GenRandCheckpointHeader(0, 10)
+			require.Empty(t, err, "Unable to create random header block, Error:%v", err)
 			header.Proposer = sk.GetValidatorSet(ctx).Proposer.Signer
 			// create new checkpoint with current time
-			header.TimeStamp = uint64(time.Now().Unix())
-			genesisValidatorRewards := sk.GetAllValidatorRewards(ctx)
-			genesisrewardRootHash, _ := GetRewardRootHash(genesisValidatorRewards)
-			header.RewardRootHash = types.BytesToHeimdallHash(genesisrewardRootHash)
-			msgCheckpoint := NewMsgCheckpointBlock(header.Proposer, header.StartBlock, header.EndBlock, header.RootHash, header.RewardRootHash, header.TimeStamp)
+			header.TimeStamp = uint64(ctx.BlockTime().Unix())
+			accountRoot, _ := checkpointTypes.GetAccountRootHash(sk.GetAllDividendAccounts(ctx))
+			header.AccountRootHash = types.BytesToHeimdallHash(accountRoot)
+			msgCheckpoint := checkpointTypes.NewMsgCheckpointBlock(header.Proposer, header.StartBlock, header.EndBlock, header.RootHash, header.AccountRootHash, header.TimeStamp, checkpointTypes.DefaultLeafVersion)
 			// send new checkpoint which should replace old one
-			got := handleMsgCheckpoint(ctx, msgCheckpoint, ck, &contractCallerObj)
+			got := checkpoint.NewHandler(ck, &contractCallerObj)(ctx, msgCheckpoint)
 			require.True(t, got.IsOK(), "expected send-checkpoint to be  ok, got %v", got)
 		})
 
 		t.Run("beforeTimeout", func(t *testing.T) {
-			ctx, sk, ck := CreateTestInput(t, false)
+			ctx, sk, ck := cmn.CreateTestInput(t, false)
+			ctx = ctx.WithBlockTime(time.Now().UTC())
 			// generate proposer for validator set
-			LoadValidatorSet(4, t, sk, ctx, false, 10)
+			cmn.LoadValidatorSet(4, t, sk, ctx, false, 10)
 			sk.IncrementAccum(ctx, 1)
-			header, err := GenRandCheckpointHeader(0, 10)
+			header, err := cmn.GenRandCheckpointHeader(0, 10)
 			require.Empty(t, err, "Unable to create random header block, Error:%v", err)
 
 			// add current proposer to header
 			header.Proposer = sk.GetValidatorSet(ctx).Proposer.Signer
 			// make sure proposer has min ether
 			contractCallerObj.On("GetBalance", header.Proposer).Return(helper.MinBalance, nil)
-			// add current proposer to header
-			header.Proposer = sk.GetValidatorSet(ctx).Proposer.Signer
 			// send old checkpoint
-			SentValidCheckpoint(header, ck, sk, ctx, contractCallerObj, t)
-			genesisValidatorRewards := sk.GetAllValidatorRewards(ctx)
-			genesisrewardRootHash, _ := GetRewardRootHash(genesisValidatorRewards)
-			header.RewardRootHash = types.BytesToHeimdallHash(genesisrewardRootHash)
+			SentValidCheckpoint(header, ck, sk, ctx, &contractCallerObj, t)
+			accountRoot, _ := checkpointTypes.GetAccountRootHash(sk.GetAllDividendAccounts(ctx))
+			header.AccountRootHash = types.BytesToHeimdallHash(accountRoot)
 			// create checkpoint msg
-			msgCheckpoint := NewMsgCheckpointBlock(header.Proposer, header.StartBlock, header.EndBlock, header.RootHash, header.RewardRootHash, uint64(time.Now().Unix()))
+			msgCheckpoint := checkpointTypes.NewMsgCheckpointBlock(header.Proposer, header.StartBlock, header.EndBlock, header.RootHash, header.AccountRootHash, uint64(ctx.BlockTime().Unix()), checkpointTypes.DefaultLeafVersion)
 
 			// send checkpoint to handler
-			got := handleMsgCheckpoint(ctx, msgCheckpoint, ck, &contractCallerObj)
+			got := checkpoint.NewHandler(ck, &contractCallerObj)(ctx, msgCheckpoint)
 			require.True(t, !got.IsOK(), "expected send-checkpoint to be not ok, got %v", got)
 		})
 	})
-
 }
 
-func SentValidCheckpoint(header types.CheckpointBlockHeader, ck Keeper, sk staking.Keeper, ctx sdk.Context, contractCallerObj mocks.IContractCaller, t *testing.T) {
+func SentValidCheckpoint(header types.CheckpointBlockHeader, ck checkpoint.Keeper, sk staking.Keeper, ctx sdk.Context, contractCallerObj *mocks.IContractCaller, t *testing.T) {
 	// add current proposer to header
 	header.Proposer = sk.GetValidatorSet(ctx).Proposer.Signer
-	genesisValidatorRewards := sk.GetAllValidatorRewards(ctx)
-	genesisrewardRootHash, _ := GetRewardRootHash(genesisValidatorRewards)
-	header.RewardRootHash = types.BytesToHeimdallHash(genesisrewardRootHash)
+	accountRoot, _ := checkpointTypes.GetAccountRootHash(sk.GetAllDividendAccounts(ctx))
+	header.AccountRootHash = types.BytesToHeimdallHash(accountRoot)
 	// create checkpoint msg
-	msgCheckpoint := NewMsgCheckpointBlock(header.Proposer,
+	msgCheckpoint := checkpointTypes.NewMsgCheckpointBlock(header.Proposer,
 		header.StartBlock,
 		header.EndBlock,
 		header.RootHash,
-		header.RewardRootHash,
-		header.TimeStamp)
+		header.AccountRootHash,
+		header.TimeStamp,
+		checkpointTypes.DefaultLeafVersion)
 	// send checkpoint to handler
-	got := handleMsgCheckpoint(ctx, msgCheckpoint, ck, &contractCallerObj)
+	got := checkpoint.NewHandler(ck, contractCallerObj)(ctx, msgCheckpoint)
 	require.True(t, got.IsOK(), "expected send-checkpoint to be ok, got %v", got)
+	// checkpoint is buffered once validators vote yes on it
+	got = checkpoint.NewPostTxHandler(ck)(ctx, msgCheckpoint, types.SideTxResultYes)
+	require.True(t, got.IsOK(), "expected post-checkpoint to be ok, got %v", got)
 	storedHeader, err := ck.GetCheckpointFromBuffer(ctx)
-	t.Log("Header added to buffer", storedHeader.String())
 	require.Empty(t, err, "Unable to set checkpoint from buffer, Error: %v", err)
+	t.Log("Header added to buffer", storedHeader.String())
+}
+
+func TestPostHandleMsgCheckpoint(t *testing.T) {
+	contractCallerObj := mocks.IContractCaller{}
+	ctx, sk, ck := cmn.CreateTestInput(t, false)
+	ctx = ctx.WithBlockTime(time.Now().UTC())
+	cmn.LoadValidatorSet(4, t, sk, ctx, false, 10)
+	sk.IncrementAccum(ctx, 1)
+	for _, account := range cmn.GenRandomDividendAccount(4, 1, true) {
+		require.Nil(t, sk.AddDividendAccount(ctx, account))
+	}
+
+	proposer := sk.GetValidatorSet(ctx).Proposer
+	rootHash := types.HexToHeimdallHash("0x2")
+	accountRoot, _ := checkpointTypes.GetAccountRootHash(sk.GetAllDividendAccounts(ctx))
+	msgCheckpoint := checkpointTypes.NewMsgCheckpointBlock(proposer.Signer, 0, 255, rootHash, types.BytesToHeimdallHash(accountRoot), uint64(ctx.BlockTime().Unix()), checkpointTypes.DefaultLeafVersion)
+
+	// checkpoint is not buffered by main handler
+	got := checkpoint.NewHandler(ck, &contractCallerObj)(ctx, msgCheckpoint)
+	require.True(t, got.IsOK(), "expected send-checkpoint to be ok, got %v", got)
+	_, err := ck.GetCheckpointFromBuffer(ctx)
+	require.NotNil(t, err, "checkpoint should not be buffered before validators vote")
+
+	// rejected checkpoint is dropped
+	postHandler := checkpoint.NewPostTxHandler(ck)
+	got = postHandler(ctx, msgCheckpoint, types.SideTxResultNo)
+	require.True(t, got.IsOK(), "expected post-checkpoint to be ok, got %v", got)
+	_, err = ck.GetCheckpointFromBuffer(ctx)
+	require.NotNil(t, err, "rejected checkpoint should not be buffered")
+
+	// approved checkpoint is buffered and recorded for its proposer
+	got = postHandler(ctx, msgCheckpoint, types.SideTxResultYes)
+	require.True(t, got.IsOK(), "expected post-checkpoint to be ok, got %v", got)
+	stored, err := ck.GetCheckpointFromBuffer(ctx)
+	require.Nil(t, err)
+	require.Equal(t, msgCheckpoint.EndBlock, stored.EndBlock)
+	require.Equal(t, uint64(1), ck.GetValidatorStats(ctx, proposer.ID).Proposed)
+}
+
+// replays checkpoint msg in a block with given time on a fresh node
+func replayCheckpoint(t *testing.T, blockTime time.Time, buffered *types.CheckpointBlockHeader, msg checkpointTypes.MsgCheckpoint) (sdk.Result, *types.CheckpointBlockHeader) {
+	contractCallerObj := mocks.IContractCaller{}
+	ctx, sk, ck := cmn.CreateTestInput(t, false)
+	ctx = ctx.WithBlockTime(blockTime)
+	cmn.LoadValidatorSet(4, t, sk, ctx, false, 10)
+	sk.IncrementAccum(ctx, 1)
+	if buffered != nil {
+		ck.SetCheckpointBuffer(ctx, *buffered)
+	}
+
+	got := checkpoint.NewHandler(ck, &contractCallerObj)(ctx, msg)
+	stored, _ := ck.GetCheckpointFromBuffer(ctx)
+	return got, stored
+}
+
+// replays no-ack msg in a block with given time on a fresh node
+func replayNoAck(t *testing.T, blockTime time.Time, lastCheckpointTime time.Time, msg checkpointTypes.MsgCheckpointNoAck) (sdk.Result, uint64) {
+	contractCallerObj := mocks.IContractCaller{}
+	ctx, sk, ck := cmn.CreateTestInput(t, false)
+	ctx = ctx.WithBlockTime(blockTime)
+	cmn.LoadValidatorSet(4, t, sk, ctx, false, 10)
+	sk.IncrementAccum(ctx, 1)
+	ck.AddCheckpoint(ctx, 0, types.CheckpointBlockHeader{TimeStamp: uint64(lastCheckpointTime.Unix())})
+
+	got := checkpoint.NewHandler(ck, &contractCallerObj)(ctx, msg)
+	return got, ck.GetLastNoAck(ctx)
+}
+
+// block times used below are far away from wall clock in both directions,
+// results must only depend on block time
+var replayBlockTimes = []time.Time{
+	time.Date(2001, time.January, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(2100, time.January, 1, 0, 0, 0, 0, time.UTC),
+}
+
+func TestCheckpointTimestampUsesBlockTime(t *testing.T) {
+	bufferTime := checkpointTypes.DefaultCheckpointBufferTime
+	proposer := types.HexToHeimdallAddress("0x1")
+	rootHash := types.HexToHeimdallHash("0x2")
+
+	for _, blockTime := range replayBlockTimes {
+		// checkpoint from future is rejected
+		msg := checkpointTypes.NewMsgCheckpointBlock(proposer, 0, 10, rootHash, rootHash, uint64(blockTime.Unix()+1), checkpointTypes.DefaultLeafVersion)
+		got, _ := replayCheckpoint(t, blockTime, nil, msg)
+		require.Equal(t, common.CodeBadTimeStamp, got.Code, "checkpoint after block time should be rejected, blockTime %v", blockTime)
+
+		// buffered checkpoint not expired yet
+		buffered := &types.CheckpointBlockHeader{StartBlock: 0, EndBlock: 10, TimeStamp: uint64(blockTime.Add(-bufferTime).Unix() + 1)}
+		msg = checkpointTypes.NewMsgCheckpointBlock(proposer, 0, 10, rootHash, rootHash, uint64(blockTime.Unix()), checkpointTypes.DefaultLeafVersion)
+		got, stored := replayCheckpoint(t, blockTime, buffered, msg)
+		require.Equal(t, common.CodeNoACK, got.Code, "buffered checkpoint should not expire before buffer time, blockTime %v", blockTime)
+		require.NotNil(t, stored, "buffered checkpoint should stay in buffer")
+
+		// buffered checkpoint expired at block time
+		buffered.TimeStamp = uint64(blockTime.Add(-bufferTime).Unix())
+		got, stored = replayCheckpoint(t, blockTime, buffered, msg)
+		require.NotEqual(t, common.CodeNoACK, got.Code, "buffered checkpoint should expire after buffer time, blockTime %v", blockTime)
+		require.NotNil(t, stored, "expired checkpoint should be flushed only after validators vote")
+	}
+}
+
+func TestNoAckReplayIsDeterministic(t *testing.T) {
+	bufferTime := checkpointTypes.DefaultCheckpointBufferTime
+	from := types.HexToHeimdallAddress("0x1")
+
+	for _, blockTime := range replayBlockTimes {
+		testData := []struct {
+			name               string
+			lastCheckpointTime time.Time
+			msgTime            time.Time
+			code               sdk.CodeType
+		}{
+			{"ongoing buffer period", blockTime.Add(-bufferTime + time.Second), blockTime, common.CodeInvalidNoACK},
+			{"timestamp after block time", blockTime.Add(-2 * bufferTime), blockTime.Add(time.Second), common.CodeBadTimeStamp},
+			{"valid no-ack", blockTime.Add(-2 * bufferTime), blockTime, sdk.CodeOK},
+		}
+
+		for _, item := range testData {
+			t.Run(item.name, func(t *testing.T) {
+				msg := checkpointTypes.NewMsgCheckpointNoAck(from, uint64(item.msgTime.Unix()))
+				require.Nil(t, msg.ValidateBasic(), "no-ack validation should not depend on wall clock")
+
+				// replay same block on two nodes
+				got1, lastNoAck1 := replayNoAck(t, blockTime, item.lastCheckpointTime, msg)
+				got2, lastNoAck2 := replayNoAck(t, blockTime, item.lastCheckpointTime, msg)
+				require.Equal(t, item.code, got1.Code, "unexpected result for block time %v", blockTime)
+				require.Equal(t, got1.Code, got2.Code, "replayed block should produce same result")
+				require.Equal(t, lastNoAck1, lastNoAck2, "replayed block should produce same last no-ack")
+
+				if item.code == sdk.CodeOK {
+					require.Equal(t, uint64(blockTime.Unix()), lastNoAck1, "last no-ack should be set to block time")
+				}
+			})
+		}
+	}
+}
+
+func TestHandlerUsesCheckpointParams(t *testing.T) {
+	contractCallerObj := mocks.IContractCaller{}
+	ctx, sk, ck := cmn.CreateTestInput(t, false)
+	ctx = ctx.WithBlockTime(time.Now().UTC())
+	cmn.LoadValidatorSet(4, t, sk, ctx, false, 10)
+	sk.IncrementAccum(ctx, 1)
+
+	params := checkpointTypes.DefaultParams()
+	params.ChildBlockInterval = 100
+	params.AvgCheckpointLength = 4
+	params.MaxCheckpointLength = 8
+	ck.SetParams(ctx, params)
+	require.Equal(t, params, ck.GetParams(ctx))
+
+	handler := checkpoint.NewHandler(ck, &contractCallerObj)
+	proposer := sk.GetValidatorSet(ctx).Proposer.Signer
+	rootHash := types.HexToHeimdallHash("0x2")
+
+	// checkpoint longer than max checkpoint length
+	msgCheckpoint := checkpointTypes.NewMsgCheckpointBlock(proposer, 0, params.MaxCheckpointLength, rootHash, rootHash, uint64(ctx.BlockTime().Unix()), checkpointTypes.DefaultLeafVersion)
+	got := handler(ctx, msgCheckpoint)
+	require.Equal(t, common.CodeInvalidBlockInput, got.Code, "checkpoint exceeding max length should be rejected")
+
+	// checkpoint built with leaf version other than current one
+	msgCheckpoint = checkpointTypes.NewMsgCheckpointBlock(proposer, 0, params.AvgCheckpointLength, rootHash, rootHash, uint64(ctx.BlockTime().Unix()), params.LeafVersion+1)
+	got = handler(ctx, msgCheckpoint)
+	require.Equal(t, common.CodeInvalidLeafVersion, got.Code, "checkpoint with other leaf version should be rejected")
+
+	// ack for header block not aligned with child block interval
+	msgAck := checkpointTypes.NewMsgCheckpointAck(proposer, params.ChildBlockInterval+1, proposer, 0, 10, rootHash, types.HexToHeimdallHash("0x3"), 0)
+	got = handler(ctx, msgAck)
+	require.Equal(t, common.CodeInvalidACK, got.Code, "ack with invalid header block should be rejected")
+
+	// last checkpoint is looked up with child block interval from params
+	header := types.CheckpointBlockHeader{StartBlock: 0, EndBlock: 10, RootHash: rootHash, Proposer: proposer}
+	ck.AddCheckpoint(ctx, params.ChildBlockInterval, header)
+	ck.UpdateACKCount(ctx)
+	lastCheckpoint, err := ck.GetLastCheckpoint(ctx)
+	require.Nil(t, err)
+	require.Equal(t, header.EndBlock, lastCheckpoint.EndBlock)
+}
+
+// ack test case, valid by default against checkpoint in buffer
+type ackTestCase struct {
+	headerBlock uint64
+	eventHeader uint64
+	start, end  uint64
+	root        types.HeimdallHash
+	proposer    types.HeimdallAddress
+	contractEnd uint64
+}
+
+// replays ack msg with mocked mainchain state, modify changes mainchain state from valid ack
+func replayAck(t *testing.T, modify func(tc *ackTestCase)) (types.SideTxResultType, sdk.Result, types.CheckpointBlockHeader, ackTestCase, checkpoint.Keeper, sdk.Context) {
+	ctx, sk, ck := cmn.CreateTestInput(t, false)
+	ctx = ctx.WithBlockTime(time.Now().UTC())
+	cmn.LoadValidatorSet(4, t, sk, ctx, false, 10)
+	sk.IncrementAccum(ctx, 1)
+
+	// buffered checkpoint
+	buffered := types.CheckpointBlockHeader{
+		StartBlock: 0,
+		EndBlock:   255,
+		RootHash:   types.HexToHeimdallHash("0xaa"),
+		Proposer:   sk.GetValidatorSet(ctx).Proposer.Signer,
+		TimeStamp:  uint64(ctx.BlockTime().Unix()),
+	}
+	ck.SetCheckpointBuffer(ctx, buffered)
+
+	tc := ackTestCase{
+		headerBlock: checkpointTypes.DefaultChildBlockInterval,
+		eventHeader: checkpointTypes.DefaultChildBlockInterval,
+		start:       buffered.StartBlock,
+		end:         buffered.EndBlock,
+		root:        buffered.RootHash,
+		proposer:    buffered.Proposer,
+		contractEnd: buffered.EndBlock,
+	}
+	if modify != nil {
+		modify(&tc)
+	}
+
+	txHash := types.HexToHeimdallHash("0x1234")
+	receipt := &ethTypes.Receipt{BlockNumber: big.NewInt(100)}
+
+	contractCallerObj := mocks.IContractCaller{}
+	contractCallerObj.On("GetConfirmedTxReceipt", txHash.EthHash(), checkpointTypes.DefaultConfirmationBlocks).Return(receipt, nil)
+	contractCallerObj.On("DecodeNewHeaderBlockEvent", receipt, uint64(1)).Return(&rootchain.RootchainNewHeaderBlock{
+		Proposer:      tc.proposer.EthAddress(),
+		HeaderBlockId: new(big.Int).SetUint64(tc.eventHeader),
+		Start:         new(big.Int).SetUint64(tc.start),
+		End:           new(big.Int).SetUint64(tc.end),
+		Root:          tc.root.EthHash(),
+	}, nil)
+	contractCallerObj.On("GetHeaderInfo", tc.headerBlock).Return(tc.root.EthHash(), tc.start, tc.contractEnd, uint64(100), tc.proposer, nil)
+
+	// bridge relays ack built from new header block event
+	msg := checkpointTypes.NewMsgCheckpointAck(tc.proposer, tc.headerBlock, tc.proposer, tc.start, tc.end, tc.root, txHash, 1)
+	got := checkpoint.NewHandler(ck, &contractCallerObj)(ctx, msg)
+	if !got.IsOK() {
+		return types.SideTxResultSkip, got, buffered, tc, ck, ctx
+	}
+
+	// checkpoint is acked once validators vote on new header block event
+	sideResult := checkpoint.NewSideTxHandler(ck, &contractCallerObj)(ctx, msg)
+	got = checkpoint.NewPostTxHandler(ck)(ctx, msg, sideResult)
+	return sideResult, got, buffered, tc, ck, ctx
+}
+
+func TestHandleMsgCheckpointAck(t *testing.T) {
+	headerBlock := checkpointTypes.DefaultChildBlockInterval
+
+	t.Run("validAck", func(t *testing.T) {
+		sideResult, got, buffered, _, ck, ctx := replayAck(t, nil)
+		require.Equal(t, types.SideTxResultYes, sideResult)
+		require.True(t, got.IsOK(), "expected ack to be ok, got %v", got)
+		require.Equal(t, uint64(1), ck.GetACKCount(ctx))
+
+		stats := ck.GetAllValidatorStats(ctx)
+		require.Len(t, stats, 1)
+		require.Equal(t, uint64(1), stats[0].Acknowledged)
+
+		stored, err := ck.GetCheckpointByIndex(ctx, headerBlock)
+		require.Nil(t, err)
+		require.Equal(t, buffered.EndBlock, stored.EndBlock)
+		require.Equal(t, buffered.Proposer, stored.Proposer)
+
+		_, err = ck.GetCheckpointFromBuffer(ctx)
+		require.NotNil(t, err, "buffer should be flushed after ack")
+	})
+
+	t.Run("adjustedEndBlock", func(t *testing.T) {
+		sideResult, got, buffered, tc, ck, ctx := replayAck(t, func(tc *ackTestCase) {
+			tc.end, tc.contractEnd = 127, 127
+			tc.root = types.HexToHeimdallHash("0xbb")
+			tc.proposer = types.HexToHeimdallAddress("0xdead")
+		})
+		require.Equal(t, types.SideTxResultYes, sideResult)
+		require.True(t, got.IsOK(), "expected ack to be ok, got %v", got)
+
+		stored, err := ck.GetCheckpointByIndex(ctx, headerBlock)
+		require.Nil(t, err)
+		require.Equal(t, uint64(127), stored.EndBlock)
+		require.Equal(t, tc.root, stored.RootHash)
+		require.Equal(t, tc.proposer, stored.Proposer, "stored checkpoint should have on-chain proposer")
+		require.NotEqual(t, buffered.Proposer, stored.Proposer)
+	})
+
+	testData := []struct {
+		name       string
+		modify     func(tc *ackTestCase)
+		code       sdk.CodeType
+		sideResult types.SideTxResultType
+	}{
+		{"headerBlockMismatch", func(tc *ackTestCase) { tc.eventHeader = 2 * headerBlock }, sdk.CodeOK, types.SideTxResultNo},
+		{"contractMismatch", func(tc *ackTestCase) { tc.contractEnd = 200 }, sdk.CodeOK, types.SideTxResultNo},
+		{"startMismatch", func(tc *ackTestCase) { tc.start = 1 }, common.CodeInvalidACK, types.SideTxResultSkip},
+		{"endAfterBuffer", func(tc *ackTestCase) { tc.end, tc.contractEnd = 300, 300 }, common.CodeInvalidACK, types.SideTxResultSkip},
+		{"rootMismatch", func(tc *ackTestCase) { tc.root = types.HexToHeimdallHash("0xcc") }, common.CodeInvalidACK, types.SideTxResultSkip},
+		{"proposerMismatch", func(tc *ackTestCase) { tc.proposer = types.HexToHeimdallAddress("0xdead") }, common.CodeInvalidACK, types.SideTxResultSkip},
+	}
+
+	for _, item := range testData {
+		t.Run(item.name, func(t *testing.T) {
+			sideResult, got, _, _, ck, ctx := replayAck(t, item.modify)
+			require.Equal(t, item.code, got.Code, "unexpected result %v", got)
+			require.Equal(t, item.sideResult, sideResult)
+			require.Equal(t, uint64(0), ck.GetACKCount(ctx))
+
+			_, err := ck.GetCheckpointFromBuffer(ctx)
+			require.Nil(t, err, "buffer should not be flushed on invalid ack")
+		})
+	}
+
+	t.Run("notConfirmed", func(t *testing.T) {
+		ctx, _, ck := cmn.CreateTestInput(t, false)
+		txHash := types.HexToHeimdallHash("0x1234")
+		contractCallerObj := mocks.IContractCaller{}
+		contractCallerObj.On("GetConfirmedTxReceipt", txHash.EthHash(), checkpointTypes.DefaultConfirmationBlocks).Return(nil, errors.New("not confirmed"))
+
+		msg := checkpointTypes.NewMsgCheckpointAck(types.HexToHeimdallAddress("0xdead"), headerBlock, types.HexToHeimdallAddress("0xdead"), 0, 255, types.HexToHeimdallHash("0xaa"), txHash, 1)
+		sideResult := checkpoint.NewSideTxHandler(ck, &contractCallerObj)(ctx, msg)
+		require.Equal(t, types.SideTxResultSkip, sideResult, "validators should skip vote until tx is confirmed")
+	})
+}
+
+func TestValidatorStats(t *testing.T) {
+	contractCallerObj := mocks.IContractCaller{}
+	ctx, sk, ck := cmn.CreateTestInput(t, false)
+	ctx = ctx.WithBlockTime(time.Now().UTC())
+	cmn.LoadValidatorSet(4, t, sk, ctx, false, 10)
+	sk.IncrementAccum(ctx, 1)
+	for _, account := range cmn.GenRandomDividendAccount(4, 1, true) {
+		require.Nil(t, sk.AddDividendAccount(ctx, account))
+	}
+	handler := checkpoint.NewHandler(ck, &contractCallerObj)
+	bufferTime := ck.GetParams(ctx).CheckpointBufferTime
+
+	proposer := sk.GetValidatorSet(ctx).Proposer
+	require.Equal(t, checkpointTypes.NewValidatorStats(proposer.ID), ck.GetValidatorStats(ctx, proposer.ID))
+
+	// expired checkpoint in buffer is recorded for its proposer
+	ck.SetCheckpointBuffer(ctx, types.CheckpointBlockHeader{
+		StartBlock: 0,
+		EndBlock:   255,
+		Proposer:   proposer.Signer,
+		TimeStamp:  uint64(ctx.BlockTime().Add(-bufferTime).Unix()),
+	})
+	accountRoot, _ := checkpointTypes.GetAccountRootHash(sk.GetAllDividendAccounts(ctx))
+	msgCheckpoint := checkpointTypes.NewMsgCheckpointBlock(proposer.Signer, 0, 255, types.HexToHeimdallHash("0x2"), types.BytesToHeimdallHash(accountRoot), uint64(ctx.BlockTime().Unix()), checkpointTypes.DefaultLeafVersion)
+	got := handler(ctx, msgCheckpoint)
+	require.True(t, got.IsOK(), "expected checkpoint to be ok, got %v", got)
+	require.Equal(t, checkpointTypes.NewValidatorStats(proposer.ID), ck.GetValidatorStats(ctx, proposer.ID), "expiry must not be recorded before vote")
+
+	got = checkpoint.NewPostTxHandler(ck)(ctx, msgCheckpoint, types.SideTxResultYes)
+	require.True(t, got.IsOK(), "expected voted checkpoint to be ok, got %v", got)
+
+	stats := ck.GetValidatorStats(ctx, proposer.ID)
+	require.Equal(t, uint64(1), stats.Expired)
+	require.Len(t, stats.History, 2)
+	require.Equal(t, checkpointTypes.StatsEventExpired, stats.History[0].Event)
+	require.Equal(t, uint64(255), stats.History[0].EndBlock)
+	require.Equal(t, checkpointTypes.StatsEventProposed, stats.History[1].Event)
+
+	// no-ack is recorded for skipped proposer
+	ck.AddCheckpoint(ctx, 0, types.CheckpointBlockHeader{TimeStamp: uint64(ctx.BlockTime().Add(-2 * bufferTime).Unix())})
+	ck.FlushCheckpointBuffer(ctx)
+	got = handler(ctx, checkpointTypes.NewMsgCheckpointNoAck(proposer.Signer, uint64(ctx.BlockTime().Unix())))
+	require.True(t, got.IsOK(), "expected no-ack to be ok, got %v", got)
+	require.NotEqual(t, proposer.Signer, sk.GetValidatorSet(ctx).Proposer.Signer, "proposer should rotate after no-ack")
+
+	stats = ck.GetValidatorStats(ctx, proposer.ID)
+	require.Equal(t, uint64(1), stats.NoAck)
+	require.Len(t, stats.History, 3)
+
+	// stats are exported in genesis
+	genesis := checkpoint.ExportGenesis(ctx, ck)
+	require.Equal(t, []checkpointTypes.ValidatorStats{stats}, genesis.ValidatorStats)
+
+	genesis = checkpointTypes.DefaultGenesisState()
+	genesis.ValidatorStats = []checkpointTypes.ValidatorStats{stats}
+	require.Nil(t, checkpointTypes.ValidateGenesis(genesis))
+	genesis.ValidatorStats = append(genesis.ValidatorStats, stats)
+	require.NotNil(t, checkpointTypes.ValidateGenesis(genesis), "duplicate validator stats should be rejected")
+}
+
+func TestValidatorStatsHistoryLimit(t *testing.T) {
+	stats := checkpointTypes.NewValidatorStats(1)
+	for i := 0; i < checkpointTypes.MaxValidatorStatsHistory+10; i++ {
+		require.Nil(t, stats.AddEntry(checkpointTypes.ValidatorStatsEntry{Event: checkpointTypes.StatsEventProposed, Height: int64(i)}))
+	}
+
+	require.Equal(t, uint64(checkpointTypes.MaxValidatorStatsHistory+10), stats.Proposed)
+	require.Len(t, stats.History, checkpointTypes.MaxValidatorStatsHistory)
+	require.Equal(t, int64(10), stats.History[0].Height, "oldest entries should be dropped")
+	require.NotNil(t, stats.AddEntry(checkpointTypes.ValidatorStatsEntry{Event: "unknown"}))
 }
@@ -0,0 +1,25 @@
+package checkpoint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Implements CheckpointHooks interface
+var _ types.CheckpointHooks = Keeper{}
+
+// AfterCheckpointACK - call hook if registered
+func (k Keeper) AfterCheckpointACK(ctx sdk.Context, headerBlock hmTypes.CheckpointBlockHeader) {
+	if k.hooks != nil {
+		k.hooks.AfterCheckpointACK(ctx, headerBlock)
+	}
+}
+
+// AfterCheckpointNoACK - call hook if registered
+func (k Keeper) AfterCheckpointNoACK(ctx sdk.Context) {
+	if k.hooks != nil {
+		k.hooks.AfterCheckpointNoACK(ctx)
+	}
+}
@@ -1,6 +1,7 @@
 package checkpoint
 
 import (
+	"encoding/binary"
 	"errors"
 	"strconv"
 
@@ -11,7 +12,6 @@ import (
 
 	"github.com/maticnetwork/heimdall/checkpoint/types"
 	cmn "github.com/maticnetwork/heimdall/common"
-	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/staking"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
@@ -23,6 +23,8 @@ var (
 	BufferCheckpointKey = []byte{0x12} // Key to store checkpoint in buffer
 	HeaderBlockKey      = []byte{0x13} // prefix key for when storing header after ACK
 	LastNoACKKey        = []byte{0x14} // key to store last no-ack
+	ValidatorStatsKey   = []byte{0x15} // prefix key to store checkpoint stats of validators
+	HeaderKeysMigrated  = []byte{0x16} // key to mark headers as migrated to big endian keys
 )
 
 // Keeper stores all related data
@@ -36,6 +38,8 @@ type Keeper struct {
 	codespace sdk.CodespaceType
 	// param space
 	paramSpace params.Subspace
+	// checkpoint ack hooks
+	hooks types.CheckpointHooks
 }
 
 // NewKeeper create new keeper
@@ -49,13 +53,22 @@ func NewKeeper(
 	keeper := Keeper{
 		cdc:        cdc,
 		storeKey:   storeKey,
-		paramSpace: paramSpace,
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
 		codespace:  codespace,
 		sk:         stakingKeeper,
 	}
 	return keeper
 }
 
+// SetHooks sets checkpoint ack hooks
+func (k *Keeper) SetHooks(ch types.CheckpointHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set checkpoint hooks twice")
+	}
+	k.hooks = ch
+	return k
+}
+
 // Codespace returns the codespace
 func (k Keeper) Codespace() sdk.CodespaceType {
 	return k.codespace
@@ -147,13 +160,41 @@ func (k *Keeper) GetCheckpointList(ctx sdk.Context, page uint64, limit uint64) (
 	return headers, nil
 }
 
+// GetCheckpointByBlockNumber returns header index and acknowledged checkpoint which contains bor block
+func (k *Keeper) GetCheckpointByBlockNumber(ctx sdk.Context, blockNumber uint64) (uint64, hmTypes.CheckpointBlockHeader, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	// recent checkpoints are checked first
+	iterator := sdk.KVStoreReversePrefixIterator(store, HeaderBlockKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var checkpointHeader hmTypes.CheckpointBlockHeader
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &checkpointHeader); err != nil {
+			return 0, checkpointHeader, err
+		}
+
+		// checkpoints are ordered, no older checkpoint can contain block
+		if checkpointHeader.EndBlock < blockNumber {
+			break
+		}
+
+		if checkpointHeader.StartBlock <= blockNumber {
+			headerIndex := binary.BigEndian.Uint64(iterator.Key()[len(HeaderBlockKey):])
+			return headerIndex, checkpointHeader, nil
+		}
+	}
+
+	return 0, hmTypes.CheckpointBlockHeader{}, cmn.ErrNoCheckpointFound(k.Codespace())
+}
+
 // GetLastCheckpoint gets last checkpoint, headerIndex = TotalACKs * ChildBlockInterval
 func (k *Keeper) GetLastCheckpoint(ctx sdk.Context) (hmTypes.CheckpointBlockHeader, error) {
 	store := ctx.KVStore(k.storeKey)
 	acksCount := k.GetACKCount(ctx)
 
 	// fetch last checkpoint key (NumberOfACKs * ChildBlockInterval)
-	lastCheckpointKey := helper.GetConfig().ChildBlockInterval * acksCount
+	lastCheckpointKey := k.GetParams(ctx).ChildBlockInterval * acksCount
 
 	// fetch checkpoint and unmarshall
 	var _checkpoint hmTypes.CheckpointBlockHeader
@@ -177,8 +218,12 @@ func (k *Keeper) GetLastCheckpoint(ctx sdk.Context) (hmTypes.CheckpointBlockHead
 
 // GetHeaderKey appends prefix to headerNumber
 func GetHeaderKey(headerNumber uint64) []byte {
-	headerNumberBytes := []byte(strconv.FormatUint(headerNumber, 10))
-	return append(HeaderBlockKey, headerNumberBytes...)
+	return append(HeaderBlockKey, sdk.Uint64ToBigEndian(headerNumber)...)
+}
+
+// MigrateHeaderKeys moves headers stored with decimal keys by older versions to big endian keys, once
+func (k *Keeper) MigrateHeaderKeys(ctx sdk.Context) int {
+	return hmTypes.MigrateLegacyKeysOnce(ctx.KVStore(k.storeKey), HeaderKeysMigrated, HeaderBlockKey, GetHeaderKey)
 }
 
 // HasStoreValue check if value exists in store or not
@@ -300,3 +345,83 @@ func (k Keeper) UpdateACKCount(ctx sdk.Context) {
 	// update
 	store.Set(ACKCountKey, ACKs)
 }
+
+//
+// Validator stats
+//
+
+// GetValidatorStatsKey appends prefix to validator id
+func GetValidatorStatsKey(validatorID hmTypes.ValidatorID) []byte {
+	return append(ValidatorStatsKey, sdk.Uint64ToBigEndian(validatorID.Uint64())...)
+}
+
+// SetValidatorStats stores checkpoint stats of validator
+func (k *Keeper) SetValidatorStats(ctx sdk.Context, stats types.ValidatorStats) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetValidatorStatsKey(stats.ValidatorID), k.cdc.MustMarshalBinaryBare(stats))
+}
+
+// GetValidatorStats returns checkpoint stats of validator, empty stats if nothing is recorded yet
+func (k *Keeper) GetValidatorStats(ctx sdk.Context, validatorID hmTypes.ValidatorID) types.ValidatorStats {
+	store := ctx.KVStore(k.storeKey)
+	key := GetValidatorStatsKey(validatorID)
+	if !store.Has(key) {
+		return types.NewValidatorStats(validatorID)
+	}
+
+	var stats types.ValidatorStats
+	k.cdc.MustUnmarshalBinaryBare(store.Get(key), &stats)
+	return stats
+}
+
+// GetAllValidatorStats returns checkpoint stats of all validators
+func (k *Keeper) GetAllValidatorStats(ctx sdk.Context) (result []types.ValidatorStats) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, ValidatorStatsKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.ValidatorStats
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &stats)
+		result = append(result, stats)
+	}
+	return
+}
+
+// RecordValidatorStats records checkpoint event for validator with given signer
+func (k *Keeper) RecordValidatorStats(ctx sdk.Context, signer hmTypes.HeimdallAddress, event string, checkpoint hmTypes.CheckpointBlockHeader) {
+	validator, err := k.sk.GetValidatorInfo(ctx, signer.Bytes())
+	if err != nil {
+		k.Logger(ctx).Error("Unable to find validator to record checkpoint stats", "signer", signer.String(), "event", event, "error", err)
+		return
+	}
+
+	stats := k.GetValidatorStats(ctx, validator.ID)
+	if err := stats.AddEntry(types.ValidatorStatsEntry{
+		Event:      event,
+		Height:     ctx.BlockHeight(),
+		TimeStamp:  uint64(ctx.BlockTime().Unix()),
+		StartBlock: checkpoint.StartBlock,
+		EndBlock:   checkpoint.EndBlock,
+	}); err != nil {
+		k.Logger(ctx).Error("Unable to record checkpoint stats", "validatorID", validator.ID, "error", err)
+		return
+	}
+
+	k.SetValidatorStats(ctx, stats)
+}
+
+//
+// Params
+//
+
+// SetParams sets the checkpoint module's parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetParams gets the checkpoint module's parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return
+}
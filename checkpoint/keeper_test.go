@@ -0,0 +1,66 @@
+package checkpoint_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	cmn "github.com/maticnetwork/heimdall/test"
+	"github.com/maticnetwork/heimdall/types"
+)
+
+// checkpoint list should be paginated in header number order
+func TestGetCheckpointListOrder(t *testing.T) {
+	ctx, _, ck := cmn.CreateTestInput(t, false)
+	childBlockInterval := checkpointTypes.DefaultChildBlockInterval
+
+	// 25 checkpoints, header numbers go from 10000 to 250000
+	count := uint64(25)
+	for i := uint64(1); i <= count; i++ {
+		err := ck.AddCheckpoint(ctx, i*childBlockInterval, types.CheckpointBlockHeader{
+			StartBlock: (i - 1) * 256,
+			EndBlock:   i*256 - 1,
+		})
+		require.Nil(t, err)
+	}
+
+	var all []types.CheckpointBlockHeader
+	for page := uint64(1); page <= 3; page++ {
+		headers, err := ck.GetCheckpointList(ctx, page, 10)
+		require.Nil(t, err)
+		all = append(all, headers...)
+	}
+
+	require.Len(t, all, int(count))
+	for i, header := range all {
+		require.Equal(t, uint64(i)*256, header.StartBlock, "checkpoint %d is out of order", i)
+	}
+}
+
+func TestGetCheckpointByBlockNumber(t *testing.T) {
+	ctx, _, ck := cmn.CreateTestInput(t, false)
+	childBlockInterval := checkpointTypes.DefaultChildBlockInterval
+
+	for i := uint64(1); i <= 12; i++ {
+		err := ck.AddCheckpoint(ctx, i*childBlockInterval, types.CheckpointBlockHeader{
+			StartBlock: (i - 1) * 256,
+			EndBlock:   i*256 - 1,
+		})
+		require.Nil(t, err)
+	}
+
+	headerIndex, header, err := ck.GetCheckpointByBlockNumber(ctx, 0)
+	require.Nil(t, err)
+	require.Equal(t, childBlockInterval, headerIndex)
+	require.Equal(t, uint64(0), header.StartBlock)
+
+	headerIndex, header, err = ck.GetCheckpointByBlockNumber(ctx, 2600)
+	require.Nil(t, err)
+	require.Equal(t, 11*childBlockInterval, headerIndex)
+	require.True(t, header.StartBlock <= 2600 && 2600 <= header.EndBlock)
+
+	// block not checkpointed yet
+	_, _, err = ck.GetCheckpointByBlockNumber(ctx, 12*256)
+	require.NotNil(t, err)
+}
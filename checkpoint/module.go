@@ -24,6 +24,7 @@ var (
 	_ module.AppModule            = AppModule{}
 	_ module.AppModuleBasic       = AppModuleBasic{}
 	_ hmTypes.HeimdallModuleBasic = AppModule{}
+	_ hmTypes.SideModule          = AppModule{}
 	// _ module.AppModuleSimulation = AppModule{}
 )
 
@@ -122,6 +123,16 @@ func (am AppModule) NewHandler() sdk.Handler {
 	return NewHandler(am.keeper, am.contractCaller)
 }
 
+// NewSideTxHandler returns side tx handler for the module.
+func (am AppModule) NewSideTxHandler() hmTypes.SideTxHandler {
+	return NewSideTxHandler(am.keeper, am.contractCaller)
+}
+
+// NewPostTxHandler returns post tx handler for the module.
+func (am AppModule) NewPostTxHandler() hmTypes.PostTxHandler {
+	return NewPostTxHandler(am.keeper)
+}
+
 // QuerierRoute returns the auth module's querier route name.
 func (AppModule) QuerierRoute() string {
 	return types.QuerierRoute
@@ -179,10 +190,10 @@ func verifyGenesis(state types.GenesisState) error {
 		return nil
 	}
 
-	if state.AckCount*helper.GetConfig().ChildBlockInterval != currentHeaderIndex {
+	if state.AckCount*state.Params.ChildBlockInterval != currentHeaderIndex {
 		fmt.Println("Header Count doesn't match",
 			"ExpectedHeader", currentHeaderIndex,
-			"HeaderIndexFound", state.AckCount*helper.GetConfig().ChildBlockInterval)
+			"HeaderIndexFound", state.AckCount*state.Params.ChildBlockInterval)
 		return nil
 	}
 
@@ -191,7 +202,7 @@ func verifyGenesis(state types.GenesisState) error {
 	// check all headers
 	for i, header := range state.Headers {
 		ackCount := uint64(i + 1)
-		root, start, end, _, _, err := contractCaller.GetHeaderInfo(ackCount * helper.GetConfig().ChildBlockInterval)
+		root, start, end, _, _, err := contractCaller.GetHeaderInfo(ackCount * state.Params.ChildBlockInterval)
 		if err != nil {
 			return err
 		}
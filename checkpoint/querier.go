@@ -1,6 +1,7 @@
 package checkpoint
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 
@@ -16,6 +17,8 @@ import (
 func NewQuerier(keeper Keeper) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
 		switch path[0] {
+		case types.QueryParams:
+			return handleQueryParams(ctx, req, keeper)
 		case types.QueryAckCount:
 			return handleQueryAckCount(ctx, req, keeper)
 		case types.QueryCheckpoint:
@@ -26,12 +29,24 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return handleQueryLastNoAck(ctx, req, keeper)
 		case types.QueryCheckpointList:
 			return handleQueryCheckpointList(ctx, req, keeper)
+		case types.QueryProof:
+			return handleQueryProof(ctx, req, keeper)
+		case types.QueryValidatorStats:
+			return handleQueryValidatorStats(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown auth query endpoint")
 		}
 	}
 }
 
+func handleQueryParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := json.Marshal(keeper.GetParams(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 func handleQueryAckCount(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	bz, err := json.Marshal(keeper.GetACKCount(ctx))
 	if err != nil {
@@ -103,3 +118,57 @@ func handleQueryCheckpointList(ctx sdk.Context, req abci.RequestQuery, keeper Ke
 	}
 	return bz, nil
 }
+
+func handleQueryProof(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryProofParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	headerIndex, checkpoint, err := keeper.GetCheckpointByBlockNumber(ctx, params.BorBlockNumber)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch checkpoint for block %v", params.BorBlockNumber), err.Error()))
+	}
+
+	leaf, proof, root, err := types.GetBlockProof(checkpoint.StartBlock, checkpoint.EndBlock, params.BorBlockNumber, keeper.GetParams(ctx).AvgCheckpointLength, checkpoint.Version)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not generate proof for block %v", params.BorBlockNumber), err.Error()))
+	}
+
+	if !bytes.Equal(root, checkpoint.RootHash.Bytes()) {
+		return nil, sdk.ErrInternal(fmt.Sprintf("root hash mismatch for checkpoint %v, bor chain doesn't match checkpoint", headerIndex))
+	}
+
+	res := types.BlockProof{
+		HeaderIndex:    headerIndex,
+		BorBlockNumber: params.BorBlockNumber,
+		StartBlock:     checkpoint.StartBlock,
+		EndBlock:       checkpoint.EndBlock,
+		LeafIndex:      params.BorBlockNumber - checkpoint.StartBlock,
+		Leaf:           hmTypes.BytesToHeimdallHash(leaf),
+		RootHash:       checkpoint.RootHash,
+		LeafVersion:    checkpoint.Version,
+	}
+	for _, sibling := range proof {
+		res.Proof = append(res.Proof, hmTypes.BytesToHeimdallHash(sibling))
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryValidatorStats(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorStatsParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	bz, err := json.Marshal(keeper.GetValidatorStats(ctx, params.ValidatorID))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
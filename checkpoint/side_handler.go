@@ -0,0 +1,257 @@
+package checkpoint
+
+import (
+	"bytes"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// NewSideTxHandler returns side tx handler for checkpoint module
+func NewSideTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxHandler {
+	return func(ctx sdk.Context, msg sdk.Msg) hmTypes.SideTxResultType {
+		switch msg := msg.(type) {
+		case types.MsgCheckpoint:
+			return sideHandleMsgCheckpoint(ctx, msg, k)
+		case types.MsgCheckpointAck:
+			return sideHandleMsgCheckpointAck(ctx, msg, k, contractCaller)
+		default:
+			return hmTypes.SideTxResultSkip
+		}
+	}
+}
+
+// NewPostTxHandler returns post tx handler for checkpoint module
+func NewPostTxHandler(k Keeper) hmTypes.PostTxHandler {
+	return func(ctx sdk.Context, msg sdk.Msg, result hmTypes.SideTxResultType) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgCheckpoint:
+			return postHandleMsgCheckpoint(ctx, msg, result, k)
+		case types.MsgCheckpointAck:
+			return postHandleMsgCheckpointAck(ctx, msg, result, k)
+		default:
+			return sdk.ErrTxDecode("Invalid message in checkpoint module").Result()
+		}
+	}
+}
+
+// sideHandleMsgCheckpoint validates root hash of checkpoint against bor chain
+func sideHandleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper) hmTypes.SideTxResultType {
+	params := k.GetParams(ctx)
+
+	// validate checkpoint
+	validCheckpoint, err := types.ValidateCheckpoint(msg.StartBlock, msg.EndBlock, msg.RootHash, params.AvgCheckpointLength, msg.Version)
+	if err != nil {
+		k.Logger(ctx).Error("Error validating checkpoint",
+			"Error", err,
+			"StartBlock", msg.StartBlock,
+			"EndBlock", msg.EndBlock)
+		return hmTypes.SideTxResultSkip
+	}
+
+	if !validCheckpoint {
+		k.Logger(ctx).Error("RootHash is not valid",
+			"StartBlock", msg.StartBlock,
+			"EndBlock", msg.EndBlock,
+			"RootHash", msg.RootHash)
+		return hmTypes.SideTxResultNo
+	}
+
+	k.Logger(ctx).Debug("Valid Roothash in checkpoint", "StartBlock", msg.StartBlock, "EndBlock", msg.EndBlock)
+	return hmTypes.SideTxResultYes
+}
+
+// postHandleMsgCheckpoint adds voted checkpoint to buffer
+func postHandleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, result hmTypes.SideTxResultType, k Keeper) sdk.Result {
+	if result != hmTypes.SideTxResultYes {
+		k.Logger(ctx).Debug("Skipping checkpoint rejected by validators", "StartBlock", msg.StartBlock, "EndBlock", msg.EndBlock)
+		return sdk.Result{}
+	}
+
+	// state might have changed while validators were voting
+	if err := validateCheckpoint(ctx, msg, k); err != nil {
+		return err.Result()
+	}
+
+	// checkpoint in buffer has expired, validation above rejects unexpired one
+	if checkpointBuffer, err := k.GetCheckpointFromBuffer(ctx); err == nil {
+		k.Logger(ctx).Debug("Checkpoint has been timed out, flushing buffer", "BlockTime", ctx.BlockTime().Unix(), "PrevCheckpointTimestamp", checkpointBuffer.TimeStamp)
+		k.FlushCheckpointBuffer(ctx)
+		k.RecordValidatorStats(ctx, checkpointBuffer.Proposer, types.StatsEventExpired, *checkpointBuffer)
+	}
+
+	// add checkpoint to buffer
+	// Add AccountRootHash to CheckpointBuffer
+	k.SetCheckpointBuffer(ctx, hmTypes.CheckpointBlockHeader{
+		StartBlock:      msg.StartBlock,
+		EndBlock:        msg.EndBlock,
+		RootHash:        msg.RootHash,
+		AccountRootHash: msg.AccountRootHash,
+		Proposer:        msg.Proposer,
+		TimeStamp:       msg.TimeStamp,
+		Version:         msg.Version,
+	})
+
+	checkpoint, _ := k.GetCheckpointFromBuffer(ctx)
+	k.Logger(ctx).Debug("Adding good checkpoint to buffer to await ACK", "checkpointStored", checkpoint.String())
+
+	// record proposed checkpoint
+	k.RecordValidatorStats(ctx, msg.Proposer, types.StatsEventProposed, hmTypes.CheckpointBlockHeader{
+		StartBlock: msg.StartBlock,
+		EndBlock:   msg.EndBlock,
+	})
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCheckpoint,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer.String()),
+			sdk.NewAttribute(types.AttributeKeyStartBlock, strconv.FormatUint(uint64(msg.StartBlock), 10)),
+			sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(uint64(msg.EndBlock), 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// sideHandleMsgCheckpointAck validates new header block event and header stored in rootchain contract against checkpoint ack
+func sideHandleMsgCheckpointAck(ctx sdk.Context, msg types.MsgCheckpointAck, k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxResultType {
+	params := k.GetParams(ctx)
+
+	// get confirmed tx receipt
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), params.ConfirmationBlocks)
+	if err != nil || receipt == nil {
+		k.Logger(ctx).Error("Unable to fetch confirmed tx receipt", "error", err, "txHash", msg.TxHash)
+		return hmTypes.SideTxResultSkip
+	}
+
+	// decode new header block event
+	eventLog, err := contractCaller.DecodeNewHeaderBlockEvent(receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Unable to decode new header block event", "error", err, "txHash", msg.TxHash, "logIndex", msg.LogIndex)
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.HeaderBlockId.Uint64() != msg.HeaderBlock ||
+		eventLog.Start.Uint64() != msg.StartBlock ||
+		eventLog.End.Uint64() != msg.EndBlock ||
+		!bytes.Equal(eventLog.Root[:], msg.RootHash.Bytes()) ||
+		!bytes.Equal(eventLog.Proposer.Bytes(), msg.Proposer.Bytes()) {
+		k.Logger(ctx).Error("Checkpoint ack doesn't match with new header block event",
+			"headerBlock", msg.HeaderBlock,
+			"headerBlockFromLog", eventLog.HeaderBlockId,
+			"start", msg.StartBlock,
+			"startFromLog", eventLog.Start,
+			"end", msg.EndBlock,
+			"endFromLog", eventLog.End,
+			"root", msg.RootHash,
+			"rootFromLog", hmTypes.BytesToHeimdallHash(eventLog.Root[:]),
+			"proposer", msg.Proposer,
+			"proposerFromLog", eventLog.Proposer.Hex())
+		return hmTypes.SideTxResultNo
+	}
+
+	// make call to headerBlock with header number
+	contractRoot, contractStart, contractEnd, _, contractProposer, err := contractCaller.GetHeaderInfo(msg.HeaderBlock)
+	if err != nil {
+		k.Logger(ctx).Error("Unable to fetch header from rootchain contract", "error", err, "headerBlockIndex", msg.HeaderBlock)
+		return hmTypes.SideTxResultSkip
+	}
+
+	// event log must match with contract storage
+	if contractStart != msg.StartBlock ||
+		contractEnd != msg.EndBlock ||
+		!bytes.Equal(contractRoot.Bytes(), msg.RootHash.Bytes()) ||
+		!bytes.Equal(contractProposer.Bytes(), msg.Proposer.Bytes()) {
+		k.Logger(ctx).Error("Checkpoint ack doesn't match with rootchain contract",
+			"headerBlock", msg.HeaderBlock,
+			"start", msg.StartBlock,
+			"contractStart", contractStart,
+			"end", msg.EndBlock,
+			"contractEnd", contractEnd,
+			"root", msg.RootHash,
+			"contractRoot", contractRoot,
+			"proposer", msg.Proposer,
+			"contractProposer", contractProposer)
+		return hmTypes.SideTxResultNo
+	}
+
+	k.Logger(ctx).Debug("Validated new header block event", "headerBlock", msg.HeaderBlock, "txHash", msg.TxHash)
+	return hmTypes.SideTxResultYes
+}
+
+// postHandleMsgCheckpointAck moves voted checkpoint from buffer to store and rotates proposer
+func postHandleMsgCheckpointAck(ctx sdk.Context, msg types.MsgCheckpointAck, result hmTypes.SideTxResultType, k Keeper) sdk.Result {
+	if result != hmTypes.SideTxResultYes {
+		k.Logger(ctx).Debug("Skipping checkpoint ack rejected by validators", "headerBlock", msg.HeaderBlock)
+		return sdk.Result{}
+	}
+
+	// state might have changed while validators were voting
+	headerBlock, err := validateCheckpointAck(ctx, msg, k)
+	if err != nil {
+		return err.Result()
+	}
+
+	if msg.EndBlock != headerBlock.EndBlock {
+		// checkpoint submitted on chain is shorter than buffer
+		k.Logger(ctx).Info("Adjusting endBlock to one already submitted on chain",
+			"OldEndBlock", headerBlock.EndBlock,
+			"AdjustedEndBlock", msg.EndBlock,
+			"OldProposer", headerBlock.Proposer.String(),
+			"AdjustedProposer", msg.Proposer.String())
+		headerBlock.EndBlock = msg.EndBlock
+		headerBlock.RootHash = msg.RootHash
+		headerBlock.Proposer = msg.Proposer
+	}
+
+	// Add checkpoint to headerBlocks
+	k.AddCheckpoint(ctx, msg.HeaderBlock, *headerBlock)
+	k.Logger(ctx).Info("Checkpoint added to store", "headerBlock", headerBlock.String())
+
+	// flush buffer
+	k.FlushCheckpointBuffer(ctx)
+	k.Logger(ctx).Debug("Checkpoint buffer flushed after receiving checkpoint ack", "checkpoint", headerBlock)
+
+	// record acknowledged checkpoint for on-chain proposer
+	k.RecordValidatorStats(ctx, headerBlock.Proposer, types.StatsEventAcknowledged, *headerBlock)
+
+	// update ack count
+	k.UpdateACKCount(ctx)
+	k.Logger(ctx).Debug("Valid ack received", "CurrentACKCount", k.GetACKCount(ctx)-1, "UpdatedACKCount", k.GetACKCount(ctx))
+
+	// --- Update to new proposer
+
+	// subscribers rotate proposer
+	k.AfterCheckpointACK(ctx, *headerBlock)
+
+	//log new proposer
+	vs := k.sk.GetValidatorSet(ctx)
+	newProposer := vs.GetProposer()
+	k.Logger(ctx).Debug(
+		"New proposer selected",
+		"validator", newProposer.Signer.String(),
+		"signer", newProposer.Signer.String(),
+		"power", newProposer.VotingPower,
+	)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCheckpointAck,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyHeaderIndex, strconv.FormatUint(uint64(msg.HeaderBlock), 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Checkpoint fields compared with root chain
+const (
+	MismatchStartBlock = "start"
+	MismatchEndBlock   = "end"
+	MismatchRootHash   = "root"
+	MismatchProposer   = "proposer"
+)
+
+// CheckpointExportHeader is header row of checkpoint csv export
+var CheckpointExportHeader = []string{
+	"number",
+	"header_index",
+	"start_block",
+	"end_block",
+	"root_hash",
+	"account_root_hash",
+	"proposer",
+	"timestamp",
+	"version",
+	"verified",
+	"mismatches",
+}
+
+// CheckpointExport represents acknowledged checkpoint in export, with result of verification against root chain
+type CheckpointExport struct {
+	Number      uint64                        `json:"number"`
+	HeaderIndex uint64                        `json:"header_index"`
+	Checkpoint  hmTypes.CheckpointBlockHeader `json:"checkpoint"`
+	Verified    bool                          `json:"verified"`
+	Mismatches  []string                      `json:"mismatches,omitempty"`
+}
+
+// CSVRecord returns export as csv row matching CheckpointExportHeader
+func (e CheckpointExport) CSVRecord() []string {
+	return []string{
+		strconv.FormatUint(e.Number, 10),
+		strconv.FormatUint(e.HeaderIndex, 10),
+		strconv.FormatUint(e.Checkpoint.StartBlock, 10),
+		strconv.FormatUint(e.Checkpoint.EndBlock, 10),
+		e.Checkpoint.RootHash.String(),
+		e.Checkpoint.AccountRootHash.String(),
+		e.Checkpoint.Proposer.String(),
+		strconv.FormatUint(e.Checkpoint.TimeStamp, 10),
+		strconv.FormatUint(e.Checkpoint.Version, 10),
+		strconv.FormatBool(e.Verified),
+		strings.Join(e.Mismatches, ";"),
+	}
+}
+
+// GetRootChainMismatches returns fields of acknowledged checkpoint which don't match header block on root chain
+func GetRootChainMismatches(contractCaller helper.IContractCaller, headerIndex uint64, checkpoint hmTypes.CheckpointBlockHeader) ([]string, error) {
+	root, start, end, _, proposer, err := contractCaller.GetHeaderInfo(headerIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	var mismatches []string
+	if checkpoint.StartBlock != start {
+		mismatches = append(mismatches, MismatchStartBlock)
+	}
+
+	if checkpoint.EndBlock != end {
+		mismatches = append(mismatches, MismatchEndBlock)
+	}
+
+	if !bytes.Equal(checkpoint.RootHash.Bytes(), root.Bytes()) {
+		mismatches = append(mismatches, MismatchRootHash)
+	}
+
+	if !bytes.Equal(checkpoint.Proposer.Bytes(), proposer.Bytes()) {
+		mismatches = append(mismatches, MismatchProposer)
+	}
+
+	return mismatches, nil
+}
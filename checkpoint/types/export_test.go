@@ -0,0 +1,37 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maticnetwork/heimdall/helper/mocks"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func TestGetRootChainMismatches(t *testing.T) {
+	checkpoint := hmTypes.CheckpointBlockHeader{
+		Proposer:   hmTypes.HexToHeimdallAddress("0x1"),
+		StartBlock: 0,
+		EndBlock:   255,
+		RootHash:   hmTypes.HexToHeimdallHash("0x2"),
+	}
+
+	contractCaller := &mocks.IContractCaller{}
+	contractCaller.On("GetHeaderInfo", uint64(10000)).Return(common.HexToHash("0x2"), uint64(0), uint64(255), uint64(0), checkpoint.Proposer, nil)
+	contractCaller.On("GetHeaderInfo", uint64(20000)).Return(common.HexToHash("0x3"), uint64(0), uint64(256), uint64(0), hmTypes.HexToHeimdallAddress("0x4"), nil)
+
+	mismatches, err := GetRootChainMismatches(contractCaller, 10000, checkpoint)
+	require.Nil(t, err)
+	require.Empty(t, mismatches)
+
+	mismatches, err = GetRootChainMismatches(contractCaller, 20000, checkpoint)
+	require.Nil(t, err)
+	require.Equal(t, []string{MismatchEndBlock, MismatchRootHash, MismatchProposer}, mismatches)
+
+	export := CheckpointExport{Number: 2, HeaderIndex: 20000, Checkpoint: checkpoint, Mismatches: mismatches}
+	record := export.CSVRecord()
+	require.Len(t, record, len(CheckpointExportHeader))
+	require.Equal(t, "end;root;proposer", record[len(record)-1])
+}
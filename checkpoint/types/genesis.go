@@ -3,47 +3,68 @@ package types
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
 // GenesisState is the checkpoint state that must be provided at genesis.
 type GenesisState struct {
+	Params             Params                          `json:"params" yaml:"params"`
 	BufferedCheckpoint *hmTypes.CheckpointBlockHeader  `json:"buffered_checkpoint" yaml:"buffered_checkpoint"`
 	LastNoACK          uint64                          `json:"last_no_ack" yaml:"last_no_ack"`
 	AckCount           uint64                          `json:"ack_count" yaml:"ack_count"`
 	Headers            []hmTypes.CheckpointBlockHeader `json:"headers" yaml:"headers"`
+	ValidatorStats     []ValidatorStats                `json:"validator_stats" yaml:"validator_stats"`
 }
 
 // NewGenesisState creates a new genesis state.
 func NewGenesisState(
+	params Params,
 	bufferedCheckpoint *hmTypes.CheckpointBlockHeader,
 	lastNoACK uint64,
 	ackCount uint64,
 	headers []hmTypes.CheckpointBlockHeader,
+	validatorStats []ValidatorStats,
 ) GenesisState {
 	return GenesisState{
+		Params:             params,
 		BufferedCheckpoint: bufferedCheckpoint,
 		LastNoACK:          lastNoACK,
 		AckCount:           ackCount,
 		Headers:            headers,
+		ValidatorStats:     validatorStats,
 	}
 }
 
 // DefaultGenesisState returns a default genesis state
 func DefaultGenesisState() GenesisState {
-	return GenesisState{}
+	return GenesisState{
+		Params: DefaultParams(),
+	}
 }
 
-// ValidateGenesis performs basic validation of bor genesis data returning an
+// ValidateGenesis performs basic validation of checkpoint genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
 	if len(data.Headers) != 0 {
 		if int(data.AckCount) != len(data.Headers) {
 			return errors.New("Incorrect state in state-dump , Please Check")
 		}
 	}
 
+	statsFound := make(map[hmTypes.ValidatorID]bool)
+	for _, stats := range data.ValidatorStats {
+		if statsFound[stats.ValidatorID] {
+			return fmt.Errorf("Duplicate checkpoint stats for validator %v", stats.ValidatorID)
+		}
+		statsFound[stats.ValidatorID] = true
+	}
+
 	return nil
 }
 
@@ -0,0 +1,190 @@
+package types
+
+import (
+	"encoding/binary"
+	"errors"
+	"sync"
+
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/rlp"
+	"github.com/maticnetwork/bor/rpc"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// HeaderCacheDir is directory name of bor header cache inside node data directory
+const HeaderCacheDir = "bor-header-cache"
+
+// headerRefetchWindow is number of headers refetched at once while walking down a reorg
+const headerRefetchWindow = 64
+
+// headerCacheKeyPrefix is prefix of bor header keys, so cache can share db with other data
+var headerCacheKeyPrefix = []byte("bor-header-")
+
+var (
+	headerCache     *HeaderCache
+	headerCacheLock sync.RWMutex
+)
+
+// HeaderCache persists bor block headers used to build checkpoint roots.
+// Cached headers are only trusted if they link to the current bor chain with parent hashes.
+type HeaderCache struct {
+	db *leveldb.DB
+}
+
+// NewHeaderCache creates header cache on top of existing db
+func NewHeaderCache(db *leveldb.DB) *HeaderCache {
+	return &HeaderCache{db: db}
+}
+
+// OpenHeaderCache opens header cache db at path
+func OpenHeaderCache(path string) (*HeaderCache, error) {
+	db, err := leveldb.OpenFile(path, nil)
+	if err != nil {
+		return nil, err
+	}
+	return NewHeaderCache(db), nil
+}
+
+// SetHeaderCache sets header cache used to fetch bor headers, nil disables caching
+func SetHeaderCache(cache *HeaderCache) {
+	headerCacheLock.Lock()
+	defer headerCacheLock.Unlock()
+	headerCache = cache
+}
+
+// getHeaderCache returns header cache, or nil if caching is disabled
+func getHeaderCache() *HeaderCache {
+	headerCacheLock.RLock()
+	defer headerCacheLock.RUnlock()
+	return headerCache
+}
+
+// GetHeader returns cached header by block number, or nil if it isn't cached
+func (c *HeaderCache) GetHeader(number uint64) *types.Header {
+	data, err := c.db.Get(getHeaderCacheKey(number), nil)
+	if err != nil {
+		return nil
+	}
+
+	var header types.Header
+	if err := rlp.DecodeBytes(data, &header); err != nil {
+		return nil
+	}
+	return &header
+}
+
+// SetHeaders stores headers in cache
+func (c *HeaderCache) SetHeaders(headers []*types.Header) error {
+	batch := new(leveldb.Batch)
+	for _, header := range headers {
+		data, err := rlp.EncodeToBytes(header)
+		if err != nil {
+			return err
+		}
+		batch.Put(getHeaderCacheKey(header.Number.Uint64()), data)
+	}
+	return c.db.Write(batch, nil)
+}
+
+// DeleteHeaders removes headers from start to end from cache
+func (c *HeaderCache) DeleteHeaders(start uint64, end uint64) error {
+	batch := new(leveldb.Batch)
+	iter := c.db.NewIterator(&util.Range{Start: getHeaderCacheKey(start), Limit: getHeaderCacheKey(end + 1)}, nil)
+	for iter.Next() {
+		batch.Delete(append([]byte{}, iter.Key()...))
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return err
+	}
+	return c.db.Write(batch, nil)
+}
+
+// getHeaders returns headers from start to end. Headers missing in cache are fetched from bor chain.
+// End header is always fetched, so reorged headers are detected by broken parent hash links.
+func (c *HeaderCache) getHeaders(rpcClient *rpc.Client, start uint64, end uint64, checkpointLength uint64) ([]*types.Header, error) {
+	headers := make([]*types.Header, end-start+1)
+	fresh := make([]bool, len(headers))
+
+	var missing []uint64
+	for i := range headers {
+		number := start + uint64(i)
+		if number != end {
+			headers[i] = c.GetHeader(number)
+		}
+
+		if headers[i] == nil {
+			missing = append(missing, number)
+		}
+	}
+
+	if err := c.fetchMissingHeaders(rpcClient, headers, fresh, start, missing, checkpointLength); err != nil {
+		return nil, err
+	}
+
+	// walk down from end and refetch cached headers which don't belong to current chain anymore
+	for fork := findHeaderFork(headers); fork >= 0; fork = findHeaderFork(headers) {
+		if fresh[fork] {
+			return nil, errors.New("bor chain reorganised while fetching headers")
+		}
+
+		from := 0
+		if fork >= headerRefetchWindow {
+			from = fork - headerRefetchWindow + 1
+		}
+
+		if err := c.DeleteHeaders(start+uint64(from), start+uint64(fork)); err != nil {
+			return nil, err
+		}
+
+		missing = missing[:0]
+		for i := from; i <= fork; i++ {
+			missing = append(missing, start+uint64(i))
+		}
+
+		if err := c.fetchMissingHeaders(rpcClient, headers, fresh, start, missing, checkpointLength); err != nil {
+			return nil, err
+		}
+	}
+
+	return headers, nil
+}
+
+// fetchMissingHeaders fetches headers by number from bor chain, puts them at their position and caches them
+func (c *HeaderCache) fetchMissingHeaders(rpcClient *rpc.Client, headers []*types.Header, fresh []bool, start uint64, missing []uint64, checkpointLength uint64) error {
+	if len(missing) == 0 {
+		return nil
+	}
+
+	fetched, err := fetchHeadersByNumber(rpcClient, missing, checkpointLength)
+	if err != nil {
+		return err
+	}
+
+	for _, header := range fetched {
+		index := header.Number.Uint64() - start
+		headers[index] = header
+		fresh[index] = true
+	}
+
+	return c.SetHeaders(fetched)
+}
+
+// findHeaderFork returns highest index of header which isn't parent of next header, or -1 if headers are linked
+func findHeaderFork(headers []*types.Header) int {
+	for i := len(headers) - 1; i > 0; i-- {
+		if headers[i].ParentHash != headers[i-1].Hash() {
+			return i - 1
+		}
+	}
+	return -1
+}
+
+// getHeaderCacheKey returns cache key for block number
+func getHeaderCacheKey(number uint64) []byte {
+	key := make([]byte, len(headerCacheKeyPrefix)+8)
+	copy(key, headerCacheKeyPrefix)
+	binary.BigEndian.PutUint64(key[len(headerCacheKeyPrefix):], number)
+	return key
+}
@@ -0,0 +1,142 @@
+package types
+
+import (
+	"math/big"
+	"sync/atomic"
+	"testing"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/crypto"
+	"github.com/maticnetwork/bor/rpc"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+)
+
+// testBorChain serves headers of in-memory bor chain over rpc
+type testBorChain struct {
+	headers []*types.Header
+	served  int64
+}
+
+func newTestBorChain(length int) *testBorChain {
+	chain := &testBorChain{}
+	chain.reorg(0, length, 0)
+	return chain
+}
+
+// reorg replaces headers from block number with new branch
+func (c *testBorChain) reorg(from int, length int, branch byte) {
+	c.headers = c.headers[:from]
+	for i := from; i < length; i++ {
+		header := &types.Header{
+			Number:      big.NewInt(int64(i)),
+			Time:        uint64(1581445457 + i),
+			Difficulty:  big.NewInt(1),
+			TxHash:      common.BytesToHash(crypto.Keccak256([]byte{byte(i), branch})),
+			ReceiptHash: common.BytesToHash(crypto.Keccak256([]byte{branch, byte(i)})),
+			Extra:       []byte{branch},
+		}
+		if i > 0 {
+			header.ParentHash = c.headers[i-1].Hash()
+		}
+		c.headers = append(c.headers, header)
+	}
+}
+
+// GetBlockByNumber implements eth_getBlockByNumber
+func (c *testBorChain) GetBlockByNumber(number rpc.BlockNumber, fullTx bool) (*types.Header, error) {
+	atomic.AddInt64(&c.served, 1)
+	if int(number) < 0 || int(number) >= len(c.headers) {
+		return nil, nil
+	}
+	return c.headers[number], nil
+}
+
+func (c *testBorChain) dial(t testing.TB) *rpc.Client {
+	server := rpc.NewServer()
+	require.Nil(t, server.RegisterName("eth", c))
+	return rpc.DialInProc(server)
+}
+
+func newTestHeaderCache(t testing.TB) *HeaderCache {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.Nil(t, err)
+	return NewHeaderCache(db)
+}
+
+func getTestRoot(t testing.TB, rpcClient *rpc.Client, start uint64, end uint64) []byte {
+	tree, err := getHeaderTree(rpcClient, start, end, DefaultAvgCheckpointLength, DefaultLeafVersion)
+	require.Nil(t, err)
+	return tree.Root().Hash
+}
+
+func TestHeaderCacheReorg(t *testing.T) {
+	chain := newTestBorChain(256)
+	rpcClient := chain.dial(t)
+	defer SetHeaderCache(nil)
+
+	SetHeaderCache(nil)
+	root := getTestRoot(t, rpcClient, 0, 255)
+
+	// first computation fills the cache
+	SetHeaderCache(newTestHeaderCache(t))
+	chain.served = 0
+	require.Equal(t, root, getTestRoot(t, rpcClient, 0, 255))
+	require.Equal(t, int64(256), chain.served)
+
+	// only end header is fetched with full cache
+	chain.served = 0
+	require.Equal(t, root, getTestRoot(t, rpcClient, 0, 255))
+	require.Equal(t, int64(1), chain.served)
+
+	// reorged headers are replaced
+	chain.reorg(240, 256, 1)
+	SetHeaderCache(nil)
+	reorgedRoot := getTestRoot(t, rpcClient, 0, 255)
+	require.NotEqual(t, root, reorgedRoot)
+
+	cache := newTestHeaderCache(t)
+	SetHeaderCache(cache)
+	getTestRoot(t, rpcClient, 0, 255)
+	chain.reorg(240, 256, 2)
+	SetHeaderCache(nil)
+	reorgedRoot = getTestRoot(t, rpcClient, 0, 255)
+
+	SetHeaderCache(cache)
+	chain.served = 0
+	require.Equal(t, reorgedRoot, getTestRoot(t, rpcClient, 0, 255))
+	require.True(t, chain.served < 256, "only headers near reorg should be refetched, fetched %v", chain.served)
+	require.Equal(t, chain.headers[250].Hash(), cache.GetHeader(250).Hash())
+
+	// range beyond chain tip
+	_, err := getHeaderTree(rpcClient, 0, 300, DefaultAvgCheckpointLength, DefaultLeafVersion)
+	require.NotNil(t, err)
+}
+
+// BenchmarkGetHeaderTree measures repeated root computation of max length checkpoint
+func BenchmarkGetHeaderTree(b *testing.B) {
+	chain := newTestBorChain(int(DefaultMaxCheckpointLength))
+	rpcClient := chain.dial(b)
+	defer SetHeaderCache(nil)
+
+	end := DefaultMaxCheckpointLength - 1
+
+	b.Run("without-cache", func(b *testing.B) {
+		SetHeaderCache(nil)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			getTestRoot(b, rpcClient, 0, end)
+		}
+	})
+
+	b.Run("with-cache", func(b *testing.B) {
+		SetHeaderCache(newTestHeaderCache(b))
+		getTestRoot(b, rpcClient, 0, end)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			getTestRoot(b, rpcClient, 0, end)
+		}
+	})
+}
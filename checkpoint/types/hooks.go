@@ -0,0 +1,35 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// CheckpointHooks event hooks for checkpoint acknowledgements, other modules subscribe to them instead of being called by checkpoint
+type CheckpointHooks interface {
+	AfterCheckpointACK(ctx sdk.Context, headerBlock hmTypes.CheckpointBlockHeader) // Must be called after ack count is updated for acknowledged checkpoint
+	AfterCheckpointNoACK(ctx sdk.Context)                                          // Must be called after no-ack is recorded
+}
+
+// MultiCheckpointHooks combines multiple checkpoint hooks, all hook functions are run in array sequence
+type MultiCheckpointHooks []CheckpointHooks
+
+// NewMultiCheckpointHooks creates new multi checkpoint hooks
+func NewMultiCheckpointHooks(hooks ...CheckpointHooks) MultiCheckpointHooks {
+	return hooks
+}
+
+// AfterCheckpointACK runs AfterCheckpointACK of all hooks
+func (h MultiCheckpointHooks) AfterCheckpointACK(ctx sdk.Context, headerBlock hmTypes.CheckpointBlockHeader) {
+	for i := range h {
+		h[i].AfterCheckpointACK(ctx, headerBlock)
+	}
+}
+
+// AfterCheckpointNoACK runs AfterCheckpointNoACK of all hooks
+func (h MultiCheckpointHooks) AfterCheckpointNoACK(ctx sdk.Context) {
+	for i := range h {
+		h[i].AfterCheckpointNoACK(ctx)
+	}
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/crypto"
+)
+
+// Leaf versions
+const (
+	// LeafVersionV1 hashes number, time, tx hash and receipt hash of bor block
+	LeafVersionV1 uint64 = 1
+
+	// DefaultLeafVersion is leaf version used for new checkpoints by default
+	DefaultLeafVersion = LeafVersionV1
+)
+
+// LeafEncoder returns leaf hash of bor block header used in checkpoint tree
+type LeafEncoder func(blockHeader *types.Header) [32]byte
+
+// leafEncoders maps leaf version with its encoding scheme
+var leafEncoders = map[uint64]LeafEncoder{
+	LeafVersionV1: GetBlockLeaf,
+}
+
+// RegisterLeafEncoder registers encoding scheme for leaf version.
+// New schemes must be registered before chain starts using them.
+func RegisterLeafEncoder(version uint64, encoder LeafEncoder) {
+	if version == 0 {
+		panic("leaf version 0 is reserved for checkpoints stored before versioning")
+	}
+
+	if _, ok := leafEncoders[version]; ok {
+		panic(fmt.Sprintf("leaf encoder for version %d already registered", version))
+	}
+
+	leafEncoders[version] = encoder
+}
+
+// GetLeafEncoder returns encoding scheme for leaf version.
+// Checkpoints stored before versioning have version 0 and use first scheme.
+func GetLeafEncoder(version uint64) (LeafEncoder, error) {
+	if version == 0 {
+		version = LeafVersionV1
+	}
+
+	encoder, ok := leafEncoders[version]
+	if !ok {
+		return nil, fmt.Errorf("unknown leaf version %d", version)
+	}
+
+	return encoder, nil
+}
+
+// IsValidLeafVersion returns true if encoding scheme is registered for leaf version
+func IsValidLeafVersion(version uint64) bool {
+	_, ok := leafEncoders[version]
+	return ok
+}
+
+// GetLeafVersions returns all registered leaf versions in ascending order
+func GetLeafVersions() []uint64 {
+	versions := make([]uint64, 0, len(leafEncoders))
+	for version := range leafEncoders {
+		versions = append(versions, version)
+	}
+
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+	return versions
+}
+
+// GetBlockLeaf returns leaf hash of bor block header for leaf version 1
+func GetBlockLeaf(blockHeader *types.Header) [32]byte {
+	header := crypto.Keccak256(appendBytes32(
+		blockHeader.Number.Bytes(),
+		new(big.Int).SetUint64(blockHeader.Time).Bytes(),
+		blockHeader.TxHash.Bytes(),
+		blockHeader.ReceiptHash.Bytes(),
+	))
+
+	var arr [32]byte
+	copy(arr[:], header)
+	return arr
+}
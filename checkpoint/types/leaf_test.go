@@ -0,0 +1,45 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeafEncoderRegistry(t *testing.T) {
+	header := &types.Header{
+		Number:      big.NewInt(10),
+		Time:        1581445457,
+		TxHash:      common.HexToHash("0x1"),
+		ReceiptHash: common.HexToHash("0x2"),
+	}
+
+	// checkpoints stored before versioning use first scheme
+	legacy, err := GetLeafEncoder(0)
+	require.Nil(t, err)
+	v1, err := GetLeafEncoder(LeafVersionV1)
+	require.Nil(t, err)
+	require.Equal(t, v1(header), legacy(header))
+	require.Equal(t, GetBlockLeaf(header), v1(header))
+
+	// unknown version
+	_, err = GetLeafEncoder(1000)
+	require.NotNil(t, err)
+	require.False(t, IsValidLeafVersion(1000))
+	require.False(t, IsValidLeafVersion(0))
+
+	// registered schemes can't be replaced
+	require.Panics(t, func() { RegisterLeafEncoder(LeafVersionV1, GetBlockLeaf) })
+	require.Panics(t, func() { RegisterLeafEncoder(0, GetBlockLeaf) })
+
+	// leaf version param must be registered
+	params := DefaultParams()
+	require.Nil(t, params.Validate())
+	params.LeafVersion = 1000
+	require.NotNil(t, params.Validate())
+
+	require.Contains(t, GetLeafVersions(), DefaultLeafVersion)
+}
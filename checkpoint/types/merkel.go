@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"math/big"
 
 	"github.com/cbergoon/merkletree"
@@ -20,14 +21,14 @@ import (
 )
 
 // ValidateCheckpoint - Validates if checkpoint rootHash matches or not
-func ValidateCheckpoint(start uint64, end uint64, rootHash hmTypes.HeimdallHash) (bool, error) {
+func ValidateCheckpoint(start uint64, end uint64, rootHash hmTypes.HeimdallHash, checkpointLength uint64, leafVersion uint64) (bool, error) {
 	// Check if blocks exist locally
 	if !CheckIfBlocksExist(end) {
 		return false, errors.New("blocks not found locally")
 	}
 
 	// Compare RootHash
-	root, err := GetHeaders(start, end)
+	root, err := GetHeaders(start, end, checkpointLength, leafVersion)
 	if err != nil {
 		return false, err
 	}
@@ -57,17 +58,106 @@ func CheckIfBlocksExist(end uint64) bool {
 	return true
 }
 
-func GetHeaders(start uint64, end uint64) ([]byte, error) {
-	rpcClient := helper.GetMaticRPCClient()
+// GetHeaders fetches headers from start to end in batches of checkpointLength and returns
+// merkle root of their leaves encoded with leafVersion
+func GetHeaders(start uint64, end uint64, checkpointLength uint64, leafVersion uint64) ([]byte, error) {
+	tree, err := getHeaderTree(helper.GetMaticRPCClient(), start, end, checkpointLength, leafVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return tree.Root().Hash, nil
+}
+
+// GetBlockProof returns leaf, merkle path and root for block inside checkpoint from start to end.
+// Path is ordered from leaf to root.
+func GetBlockProof(start uint64, end uint64, blockNumber uint64, checkpointLength uint64, leafVersion uint64) ([]byte, [][]byte, []byte, error) {
+	if blockNumber < start || blockNumber > end {
+		return nil, nil, nil, errors.New("block is not part of checkpoint")
+	}
+
+	tree, err := getHeaderTree(helper.GetMaticRPCClient(), start, end, checkpointLength, leafVersion)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	leaf, proof := getMerklePath(tree, blockNumber-start)
+	return leaf, proof, tree.Root().Hash, nil
+}
+
+// getMerklePath returns leaf at index and its sibling hashes from leaf to root
+func getMerklePath(tree *merkle.Tree, index uint64) ([]byte, [][]byte) {
+	// levels are ordered from root to leaves
+	leaf := tree.Levels[len(tree.Levels)-1][index].Hash
+
+	var proof [][]byte
+	for h := len(tree.Levels) - 1; h > 0; h-- {
+		proof = append(proof, tree.Levels[h][index^1].Hash)
+		index = index / 2
+	}
 
+	return leaf, proof
+}
+
+// VerifyBlockProof checks if leaf at index in the checkpoint tree leads to root with given merkle path
+func VerifyBlockProof(leaf []byte, index uint64, proof [][]byte, rootHash hmTypes.HeimdallHash) bool {
+	computedHash := leaf
+	for _, sibling := range proof {
+		if index%2 == 0 {
+			computedHash = crypto.Keccak256(computedHash, sibling)
+		} else {
+			computedHash = crypto.Keccak256(sibling, computedHash)
+		}
+		index = index / 2
+	}
+
+	return bytes.Equal(computedHash, rootHash.Bytes())
+}
+
+// getHeaderTree builds merkle tree of bor block headers from start to end
+func getHeaderTree(rpcClient *rpc.Client, start uint64, end uint64, checkpointLength uint64, leafVersion uint64) (*merkle.Tree, error) {
 	if start > end {
 		return nil, errors.New("start is greater than end")
 	}
 
-	batchElements := make([]rpc.BatchElem, end-start+1)
-	for i := range batchElements {
+	getLeaf, err := GetLeafEncoder(leafVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	blockHeaders, err := fetchHeaders(rpcClient, start, end, checkpointLength)
+	if err != nil {
+		return nil, err
+	}
+
+	// draft leaves and add into tree
+	headers := make([][32]byte, len(blockHeaders))
+	for i, blockHeader := range blockHeaders {
+		headers[i] = getLeaf(blockHeader)
+	}
+
+	return buildHeaderTree(headers)
+}
+
+// fetchHeaders returns bor headers from start to end, from header cache if it's enabled
+func fetchHeaders(rpcClient *rpc.Client, start uint64, end uint64, checkpointLength uint64) ([]*types.Header, error) {
+	if cache := getHeaderCache(); cache != nil {
+		return cache.getHeaders(rpcClient, start, end, checkpointLength)
+	}
+
+	numbers := make([]uint64, end-start+1)
+	for i := range numbers {
+		numbers[i] = start + uint64(i)
+	}
+	return fetchHeadersByNumber(rpcClient, numbers, checkpointLength)
+}
+
+// fetchHeadersByNumber fetches bor headers by block numbers in batches of checkpointLength
+func fetchHeadersByNumber(rpcClient *rpc.Client, numbers []uint64, checkpointLength uint64) ([]*types.Header, error) {
+	batchElements := make([]rpc.BatchElem, len(numbers))
+	for i, number := range numbers {
 		param := new(big.Int)
-		param.SetUint64(uint64(i) + start)
+		param.SetUint64(number)
 
 		batchElements[i] = rpc.BatchElem{
 			Method: "eth_getBlockByNumber",
@@ -77,40 +167,36 @@ func GetHeaders(start uint64, end uint64) ([]byte, error) {
 	}
 
 	// Batch call
-	err := fetchBatchElements(rpcClient, batchElements)
-	if err != nil {
+	if err := fetchBatchElements(rpcClient, batchElements, checkpointLength); err != nil {
 		return nil, err
 	}
 
-	// Fetch result and draft header and add into tree
-	expectedLength := nextPowerOfTwo(end - start + 1)
-	headers := make([][32]byte, expectedLength)
+	headers := make([]*types.Header, len(batchElements))
 	for i, batchElement := range batchElements {
 		if batchElement.Error != nil {
 			return nil, batchElement.Error
 		}
 
-		blockHeader := batchElement.Result.(*types.Header)
-		header := crypto.Keccak256(appendBytes32(
-			blockHeader.Number.Bytes(),
-			new(big.Int).SetUint64(blockHeader.Time).Bytes(),
-			blockHeader.TxHash.Bytes(),
-			blockHeader.ReceiptHash.Bytes(),
-		))
+		headers[i] = batchElement.Result.(*types.Header)
+		if headers[i].Number == nil || headers[i].Number.Uint64() != numbers[i] {
+			return nil, fmt.Errorf("block %v not found", numbers[i])
+		}
+	}
 
-		var arr [32]byte
-		copy(arr[:], header)
+	return headers, nil
+}
 
-		// set header
-		headers[i] = arr
-	}
+// buildHeaderTree builds merkle tree over leaves padded with empty leaves to next power of two
+func buildHeaderTree(leaves [][32]byte) (*merkle.Tree, error) {
+	headers := make([][32]byte, nextPowerOfTwo(uint64(len(leaves))))
+	copy(headers, leaves)
 
 	tree := merkle.NewTreeWithOpts(merkle.TreeOptions{EnableHashSorting: false, DisableHashLeaves: true})
 	if err := tree.Generate(convert(headers), sha3.NewLegacyKeccak256()); err != nil {
 		return nil, err
 	}
 
-	return tree.Root().Hash, nil
+	return &tree, nil
 }
 
 // GetAccountRootHash returns roothash of Validator Account State Tree
@@ -229,9 +315,15 @@ func nextPowerOfTwo(n uint64) uint64 {
 	return n
 }
 
+// MaxConcurrentBatchCalls is max number of batch calls made to bor chain at the same time
+const MaxConcurrentBatchCalls = 4
+
+// batchCallSlots limits concurrent batch calls across all header fetches of the process
+var batchCallSlots = make(chan struct{}, MaxConcurrentBatchCalls)
+
 // spins go-routines to fetch batch elements to allow creation of large merkle trees
-func fetchBatchElements(rpcClient *rpc.Client, elements []rpc.BatchElem) (err error) {
-	var batchLength = int(helper.GetConfig().AvgCheckpointLength)
+func fetchBatchElements(rpcClient *rpc.Client, elements []rpc.BatchElem, checkpointLength uint64) (err error) {
+	var batchLength = int(checkpointLength)
 	// group
 	var g errgroup.Group
 
@@ -247,6 +339,9 @@ func fetchBatchElements(rpcClient *rpc.Client, elements []rpc.BatchElem) (err er
 
 		// spawn go-routine
 		g.Go(func() error {
+			batchCallSlots <- struct{}{}
+			defer func() { <-batchCallSlots }()
+
 			// Batch call
 			err := rpcClient.BatchCall(newBatch)
 			return err
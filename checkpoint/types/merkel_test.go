@@ -0,0 +1,37 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/maticnetwork/bor/crypto"
+	"github.com/stretchr/testify/require"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func TestVerifyBlockProof(t *testing.T) {
+	for _, count := range []int{1, 2, 5, 16} {
+		leaves := make([][32]byte, count)
+		for i := range leaves {
+			copy(leaves[i][:], crypto.Keccak256([]byte{byte(i)}))
+		}
+
+		tree, err := buildHeaderTree(leaves)
+		require.Nil(t, err)
+		root := hmTypes.BytesToHeimdallHash(tree.Root().Hash)
+
+		for i := range leaves {
+			leaf, proof := getMerklePath(tree, uint64(i))
+			require.Equal(t, leaves[i][:], leaf)
+			require.True(t, VerifyBlockProof(leaf, uint64(i), proof, root), "proof should be valid for leaf %d of %d", i, count)
+
+			// tampered leaf
+			require.False(t, VerifyBlockProof(crypto.Keccak256(leaf), uint64(i), proof, root), "tampered leaf should be rejected")
+
+			// leaf at wrong position
+			if len(proof) > 0 {
+				require.False(t, VerifyBlockProof(leaf, uint64(i)^1, proof, root), "leaf at wrong index should be rejected")
+			}
+		}
+	}
+}
@@ -2,7 +2,6 @@ package types
 
 import (
 	"bytes"
-	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -25,6 +24,7 @@ type MsgCheckpoint struct {
 	RootHash        types.HeimdallHash    `json:"rootHash"`
 	AccountRootHash types.HeimdallHash    `json:"accountRootHash"`
 	TimeStamp       uint64                `json:"timestamp"`
+	Version         uint64                `json:"version"`
 }
 
 // NewMsgCheckpointBlock creates new checkpoint message using mentioned arguments
@@ -35,6 +35,7 @@ func NewMsgCheckpointBlock(
 	roothash types.HeimdallHash,
 	accountRootHash types.HeimdallHash,
 	timestamp uint64,
+	version uint64,
 ) MsgCheckpoint {
 	return MsgCheckpoint{
 		Proposer:        proposer,
@@ -43,6 +44,7 @@ func NewMsgCheckpointBlock(
 		RootHash:        roothash,
 		AccountRootHash: accountRootHash,
 		TimeStamp:       timestamp,
+		Version:         version,
 	}
 }
 
@@ -68,6 +70,11 @@ func (msg MsgCheckpoint) GetSignBytes() []byte {
 	return sdk.MustSortJSON(b)
 }
 
+// IsSideTxMsg marks checkpoint as side tx msg, root hash is validated by validators before checkpoint gets buffered
+func (msg MsgCheckpoint) IsSideTxMsg() bool {
+	return true
+}
+
 func (msg MsgCheckpoint) ValidateBasic() sdk.Error {
 	if bytes.Equal(msg.RootHash.Bytes(), helper.ZeroHash.Bytes()) {
 		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid rootHash %v", msg.RootHash.String())
@@ -81,6 +88,10 @@ func (msg MsgCheckpoint) ValidateBasic() sdk.Error {
 		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid startBlock %v or/and endBlock %v", msg.StartBlock, msg.EndBlock)
 	}
 
+	if !IsValidLeafVersion(msg.Version) {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid leaf version %v", msg.Version)
+	}
+
 	return nil
 }
 
@@ -94,14 +105,31 @@ var _ sdk.Msg = &MsgCheckpointAck{}
 type MsgCheckpointAck struct {
 	From        types.HeimdallAddress `json:"from"`
 	HeaderBlock uint64                `json:"headerBlock"`
+	Proposer    types.HeimdallAddress `json:"proposer"`
+	StartBlock  uint64                `json:"startBlock"`
+	EndBlock    uint64                `json:"endBlock"`
+	RootHash    types.HeimdallHash    `json:"rootHash"`
 	TxHash      types.HeimdallHash    `json:"tx_hash"`
 	LogIndex    uint64                `json:"log_index"`
 }
 
-func NewMsgCheckpointAck(from types.HeimdallAddress, headerBlock uint64, txHash types.HeimdallHash, logIndex uint64) MsgCheckpointAck {
+func NewMsgCheckpointAck(
+	from types.HeimdallAddress,
+	headerBlock uint64,
+	proposer types.HeimdallAddress,
+	startBlock uint64,
+	endBlock uint64,
+	rootHash types.HeimdallHash,
+	txHash types.HeimdallHash,
+	logIndex uint64,
+) MsgCheckpointAck {
 	return MsgCheckpointAck{
 		From:        from,
 		HeaderBlock: headerBlock,
+		Proposer:    proposer,
+		StartBlock:  startBlock,
+		EndBlock:    endBlock,
+		RootHash:    rootHash,
 		TxHash:      txHash,
 		LogIndex:    logIndex,
 	}
@@ -132,14 +160,26 @@ func (msg MsgCheckpointAck) ValidateBasic() sdk.Error {
 		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid from %v", msg.From.String())
 	}
 
-	childBlockInterval := helper.GetConfig().ChildBlockInterval
-	if msg.HeaderBlock > 0 && msg.HeaderBlock%childBlockInterval != 0 {
-		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid header block %d", msg.HeaderBlock)
+	if msg.TxHash.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid tx hash %v", msg.TxHash.String())
+	}
+
+	if msg.Proposer.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.Proposer.String())
+	}
+
+	if bytes.Equal(msg.RootHash.Bytes(), helper.ZeroHash.Bytes()) {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid rootHash %v", msg.RootHash.String())
 	}
 
 	return nil
 }
 
+// IsSideTxMsg marks checkpoint ack as side tx msg, new header block event is validated by validators before checkpoint gets acknowledged
+func (msg MsgCheckpointAck) IsSideTxMsg() bool {
+	return true
+}
+
 // GetTxHash Returns tx hash
 func (msg MsgCheckpointAck) GetTxHash() types.HeimdallHash {
 	return msg.TxHash
@@ -189,7 +229,7 @@ func (msg MsgCheckpointNoAck) GetSignBytes() []byte {
 }
 
 func (msg MsgCheckpointNoAck) ValidateBasic() sdk.Error {
-	if msg.TimeStamp == 0 || msg.TimeStamp > uint64(time.Now().UTC().Unix()) {
+	if msg.TimeStamp == 0 {
 		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid timestamp %d", msg.TimeStamp)
 	}
 
@@ -0,0 +1,151 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+)
+
+// Default parameter values
+const (
+	DefaultCheckpointBufferTime time.Duration = 1000 * time.Second // Time checkpoint is allowed to stay in buffer (1000 seconds ~ 17 mins)
+	DefaultNoACKWaitTime        time.Duration = 1800 * time.Second // Time ack service waits to clear buffer and elect new proposer (1800 seconds ~ 30 mins)
+	DefaultChildBlockInterval   uint64        = 10000              // difference between 2 indexes of header blocks
+	DefaultAvgCheckpointLength  uint64        = 256                // checkpoint number starts with 0, so length = defaultCheckpointLength -1
+	DefaultMaxCheckpointLength  uint64        = 1024               // max blocks in one checkpoint
+	DefaultConfirmationBlocks   uint64        = 6                  // number of mainchain blocks for confirmation
+)
+
+// Parameter keys
+var (
+	KeyCheckpointBufferTime = []byte("CheckpointBufferTime")
+	KeyNoACKWaitTime        = []byte("NoACKWaitTime")
+	KeyChildBlockInterval   = []byte("ChildBlockInterval")
+	KeyAvgCheckpointLength  = []byte("AvgCheckpointLength")
+	KeyMaxCheckpointLength  = []byte("MaxCheckpointLength")
+	KeyConfirmationBlocks   = []byte("ConfirmationBlocks")
+	KeyLeafVersion          = []byte("LeafVersion")
+)
+
+var _ subspace.ParamSet = &Params{}
+
+// Params defines the parameters for the checkpoint module.
+type Params struct {
+	CheckpointBufferTime time.Duration `json:"checkpoint_buffer_time" yaml:"checkpoint_buffer_time"`
+	NoACKWaitTime        time.Duration `json:"no_ack_wait_time" yaml:"no_ack_wait_time"`
+	ChildBlockInterval   uint64        `json:"child_chain_block_interval" yaml:"child_chain_block_interval"`
+	AvgCheckpointLength  uint64        `json:"avg_checkpoint_length" yaml:"avg_checkpoint_length"`
+	MaxCheckpointLength  uint64        `json:"max_checkpoint_length" yaml:"max_checkpoint_length"`
+	ConfirmationBlocks   uint64        `json:"confirmation_blocks" yaml:"confirmation_blocks"`
+	LeafVersion          uint64        `json:"leaf_version" yaml:"leaf_version"`
+}
+
+// NewParams creates a new Params object
+func NewParams(
+	checkpointBufferTime time.Duration,
+	noACKWaitTime time.Duration,
+	childBlockInterval uint64,
+	avgCheckpointLength uint64,
+	maxCheckpointLength uint64,
+	confirmationBlocks uint64,
+	leafVersion uint64,
+) Params {
+	return Params{
+		CheckpointBufferTime: checkpointBufferTime,
+		NoACKWaitTime:        noACKWaitTime,
+		ChildBlockInterval:   childBlockInterval,
+		AvgCheckpointLength:  avgCheckpointLength,
+		MaxCheckpointLength:  maxCheckpointLength,
+		ConfirmationBlocks:   confirmationBlocks,
+		LeafVersion:          leafVersion,
+	}
+}
+
+// ParamKeyTable for checkpoint module
+func ParamKeyTable() subspace.KeyTable {
+	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of checkpoint module's parameters.
+func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
+	return subspace.ParamSetPairs{
+		{Key: KeyCheckpointBufferTime, Value: &p.CheckpointBufferTime},
+		{Key: KeyNoACKWaitTime, Value: &p.NoACKWaitTime},
+		{Key: KeyChildBlockInterval, Value: &p.ChildBlockInterval},
+		{Key: KeyAvgCheckpointLength, Value: &p.AvgCheckpointLength},
+		{Key: KeyMaxCheckpointLength, Value: &p.MaxCheckpointLength},
+		{Key: KeyConfirmationBlocks, Value: &p.ConfirmationBlocks},
+		{Key: KeyLeafVersion, Value: &p.LeafVersion},
+	}
+}
+
+// Equal returns a boolean determining if two Params types are identical.
+func (p Params) Equal(p2 Params) bool {
+	bz1 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p)
+	bz2 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p2)
+	return bytes.Equal(bz1, bz2)
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return Params{
+		CheckpointBufferTime: DefaultCheckpointBufferTime,
+		NoACKWaitTime:        DefaultNoACKWaitTime,
+		ChildBlockInterval:   DefaultChildBlockInterval,
+		AvgCheckpointLength:  DefaultAvgCheckpointLength,
+		MaxCheckpointLength:  DefaultMaxCheckpointLength,
+		ConfirmationBlocks:   DefaultConfirmationBlocks,
+		LeafVersion:          DefaultLeafVersion,
+	}
+}
+
+// String implements the stringer interface.
+func (p Params) String() string {
+	var sb strings.Builder
+	sb.WriteString("Params: \n")
+	sb.WriteString(fmt.Sprintf("CheckpointBufferTime: %s\n", p.CheckpointBufferTime))
+	sb.WriteString(fmt.Sprintf("NoACKWaitTime: %s\n", p.NoACKWaitTime))
+	sb.WriteString(fmt.Sprintf("ChildBlockInterval: %d\n", p.ChildBlockInterval))
+	sb.WriteString(fmt.Sprintf("AvgCheckpointLength: %d\n", p.AvgCheckpointLength))
+	sb.WriteString(fmt.Sprintf("MaxCheckpointLength: %d\n", p.MaxCheckpointLength))
+	sb.WriteString(fmt.Sprintf("ConfirmationBlocks: %d\n", p.ConfirmationBlocks))
+	sb.WriteString(fmt.Sprintf("LeafVersion: %d\n", p.LeafVersion))
+	return sb.String()
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if p.CheckpointBufferTime <= 0 {
+		return fmt.Errorf("invalid checkpoint buffer time: %s", p.CheckpointBufferTime)
+	}
+
+	if p.NoACKWaitTime <= 0 {
+		return fmt.Errorf("invalid no-ack wait time: %s", p.NoACKWaitTime)
+	}
+
+	if p.ChildBlockInterval == 0 {
+		return fmt.Errorf("invalid child block interval: %d", p.ChildBlockInterval)
+	}
+
+	if p.AvgCheckpointLength == 0 {
+		return fmt.Errorf("invalid average checkpoint length: %d", p.AvgCheckpointLength)
+	}
+
+	if p.MaxCheckpointLength < p.AvgCheckpointLength {
+		return fmt.Errorf("max checkpoint length %d must not be less than average checkpoint length %d", p.MaxCheckpointLength, p.AvgCheckpointLength)
+	}
+
+	if p.ConfirmationBlocks == 0 {
+		return fmt.Errorf("invalid confirmation blocks: %d", p.ConfirmationBlocks)
+	}
+
+	if !IsValidLeafVersion(p.LeafVersion) {
+		return fmt.Errorf("invalid leaf version: %d", p.LeafVersion)
+	}
+
+	return nil
+}
@@ -1,12 +1,19 @@
 package types
 
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
 // query endpoints supported by the auth Querier
 const (
+	QueryParams           = "params"
 	QueryAckCount         = "ack-count"
 	QueryCheckpoint       = "checkpoint"
 	QueryCheckpointBuffer = "checkpoint-buffer"
 	QueryLastNoAck        = "last-no-ack"
 	QueryCheckpointList   = "checkpoint-list"
+	QueryProof            = "proof"
+	QueryValidatorStats   = "validator-stats"
 )
 
 // QueryCheckpointParams defines the params for querying accounts.
@@ -18,3 +25,45 @@ type QueryCheckpointParams struct {
 func NewQueryCheckpointParams(headerIndex uint64) QueryCheckpointParams {
 	return QueryCheckpointParams{HeaderIndex: headerIndex}
 }
+
+// QueryProofParams defines the params for querying block proof.
+type QueryProofParams struct {
+	BorBlockNumber uint64
+}
+
+// NewQueryProofParams creates a new instance of QueryProofParams.
+func NewQueryProofParams(borBlockNumber uint64) QueryProofParams {
+	return QueryProofParams{BorBlockNumber: borBlockNumber}
+}
+
+// QueryValidatorStatsParams defines the params for querying validator checkpoint stats.
+type QueryValidatorStatsParams struct {
+	ValidatorID hmTypes.ValidatorID
+}
+
+// NewQueryValidatorStatsParams creates a new instance of QueryValidatorStatsParams.
+func NewQueryValidatorStatsParams(validatorID hmTypes.ValidatorID) QueryValidatorStatsParams {
+	return QueryValidatorStatsParams{ValidatorID: validatorID}
+}
+
+// BlockProof represents merkle proof of bor block inclusion in an acknowledged checkpoint
+type BlockProof struct {
+	HeaderIndex    uint64                 `json:"header_index"`
+	BorBlockNumber uint64                 `json:"bor_block_number"`
+	StartBlock     uint64                 `json:"start_block"`
+	EndBlock       uint64                 `json:"end_block"`
+	LeafIndex      uint64                 `json:"leaf_index"`
+	Leaf           hmTypes.HeimdallHash   `json:"leaf"`
+	Proof          []hmTypes.HeimdallHash `json:"proof"`
+	RootHash       hmTypes.HeimdallHash   `json:"root_hash"`
+	LeafVersion    uint64                 `json:"leaf_version"`
+}
+
+// Verify checks block proof against checkpoint root hash
+func (p BlockProof) Verify(rootHash hmTypes.HeimdallHash) bool {
+	proof := make([][]byte, len(p.Proof))
+	for i, sibling := range p.Proof {
+		proof[i] = sibling.Bytes()
+	}
+	return VerifyBlockProof(p.Leaf.Bytes(), p.LeafIndex, proof, rootHash)
+}
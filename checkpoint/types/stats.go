@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// MaxValidatorStatsHistory is the number of recent checkpoint events kept per validator
+const MaxValidatorStatsHistory = 100
+
+// Validator checkpoint events
+const (
+	StatsEventProposed     = "proposed"
+	StatsEventAcknowledged = "acknowledged"
+	StatsEventExpired      = "expired"
+	StatsEventNoAck        = "no-ack"
+)
+
+// ValidatorStatsEntry represents checkpoint event of a validator
+type ValidatorStatsEntry struct {
+	Event      string `json:"event" yaml:"event"`
+	Height     int64  `json:"height" yaml:"height"`
+	TimeStamp  uint64 `json:"timestamp" yaml:"timestamp"`
+	StartBlock uint64 `json:"start_block" yaml:"start_block"`
+	EndBlock   uint64 `json:"end_block" yaml:"end_block"`
+}
+
+// ValidatorStats represents checkpoint performance of a validator as proposer
+type ValidatorStats struct {
+	ValidatorID  hmTypes.ValidatorID   `json:"validator_id" yaml:"validator_id"`
+	Proposed     uint64                `json:"proposed" yaml:"proposed"`
+	Acknowledged uint64                `json:"acknowledged" yaml:"acknowledged"`
+	Expired      uint64                `json:"expired" yaml:"expired"`
+	NoAck        uint64                `json:"no_ack" yaml:"no_ack"`
+	History      []ValidatorStatsEntry `json:"history" yaml:"history"`
+}
+
+// NewValidatorStats creates empty stats for validator
+func NewValidatorStats(validatorID hmTypes.ValidatorID) ValidatorStats {
+	return ValidatorStats{ValidatorID: validatorID}
+}
+
+// AddEntry updates counter for entry event and appends entry to history
+func (s *ValidatorStats) AddEntry(entry ValidatorStatsEntry) error {
+	switch entry.Event {
+	case StatsEventProposed:
+		s.Proposed++
+	case StatsEventAcknowledged:
+		s.Acknowledged++
+	case StatsEventExpired:
+		s.Expired++
+	case StatsEventNoAck:
+		s.NoAck++
+	default:
+		return fmt.Errorf("invalid validator stats event %v", entry.Event)
+	}
+
+	s.History = append(s.History, entry)
+	if len(s.History) > MaxValidatorStatsHistory {
+		s.History = s.History[len(s.History)-MaxValidatorStatsHistory:]
+	}
+
+	return nil
+}
+
+// String returns human readable stats
+func (s ValidatorStats) String() string {
+	return fmt.Sprintf("ValidatorStats{%v proposed:%v acknowledged:%v expired:%v no-ack:%v history:%v}",
+		s.ValidatorID,
+		s.Proposed,
+		s.Acknowledged,
+		s.Expired,
+		s.NoAck,
+		len(s.History))
+}
@@ -1,21 +1,24 @@
-package checkpoint
+package checkpoint_test
 
 import (
 	"os"
 	"testing"
 
 	"github.com/maticnetwork/bor/common"
+	"github.com/stretchr/testify/require"
+
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/types"
-	"github.com/stretchr/testify/require"
 )
 
 func TestFetchHeaders(t *testing.T) {
 	helper.InitHeimdallConfig(os.ExpandEnv("$HOME/.heimdalld"))
 	start := uint64(0)
 	end := uint64(300)
-	result, err := GetHeaders(start, end)
+	result, err := checkpointTypes.GetHeaders(start, end, checkpointTypes.DefaultAvgCheckpointLength, checkpointTypes.DefaultLeafVersion)
 	require.Empty(t, err, "Unable to fetch headers, Error:%v", err)
-	ok := ValidateCheckpoint(start, end, types.HeimdallHash(common.BytesToHash(result)))
+	ok, err := checkpointTypes.ValidateCheckpoint(start, end, types.HeimdallHash(common.BytesToHash(result)), checkpointTypes.DefaultAvgCheckpointLength, checkpointTypes.DefaultLeafVersion)
+	require.Empty(t, err, "Unable to validate checkpoint, Error:%v", err)
 	require.Equal(t, true, ok, "Root hash should match ")
 }
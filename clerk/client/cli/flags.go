@@ -5,4 +5,6 @@ const (
 	FlagTxHash          = "tx-hash"
 	FlagLogIndex        = "log-index"
 	FlagRecordID        = "id"
+	FlagContractAddress = "contract-address"
+	FlagData            = "data"
 )
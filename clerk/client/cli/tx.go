@@ -1,8 +1,10 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -77,12 +79,26 @@ func CreateNewStateRecord(cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("log index cannot be empty")
 			}
 
+			// contract address
+			contractAddressStr := viper.GetString(FlagContractAddress)
+			if contractAddressStr == "" {
+				return fmt.Errorf("contract address cannot be empty")
+			}
+
+			// data
+			data, err := hex.DecodeString(strings.TrimPrefix(viper.GetString(FlagData), "0x"))
+			if err != nil {
+				return fmt.Errorf("data should be hex encoded")
+			}
+
 			// create new state record
 			msg := clerkTypes.NewMsgEventRecord(
 				proposer,
 				types.HexToHeimdallHash(txHashStr),
 				logIndex,
 				recordID,
+				types.HexToHeimdallAddress(contractAddressStr),
+				data,
 			)
 
 			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
@@ -91,10 +107,13 @@ func CreateNewStateRecord(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(FlagTxHash, "", "--tx-hash=<tx-hash>")
 	cmd.Flags().String(FlagLogIndex, "", "--log-index=<log-index>")
 	cmd.Flags().String(FlagRecordID, "", "--id=<record-id>")
+	cmd.Flags().String(FlagContractAddress, "", "--contract-address=<contract-address>")
+	cmd.Flags().String(FlagData, "", "--data=<data>")
 	cmd.MarkFlagRequired(FlagProposerAddress)
 	cmd.MarkFlagRequired(FlagRecordID)
 	cmd.MarkFlagRequired(FlagTxHash)
 	cmd.MarkFlagRequired(FlagLogIndex)
+	cmd.MarkFlagRequired(FlagContractAddress)
 
 	return cmd
 }
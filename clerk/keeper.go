@@ -2,7 +2,6 @@ package clerk
 
 import (
 	"errors"
-	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,8 +14,14 @@ import (
 
 var (
 	StateRecordPrefixKey = []byte{0x11} // prefix key for when storing state
+	RecordKeysMigrated   = []byte{0x12} // key to mark event records as migrated to big endian keys
 )
 
+// ModuleCommunicator manages interaction of clerk with other modules
+type ModuleCommunicator interface {
+	GetConfirmationBlocks(ctx sdk.Context) uint64
+}
+
 // Keeper stores all related data
 type Keeper struct {
 	cdc *codec.Codec
@@ -26,6 +31,8 @@ type Keeper struct {
 	codespace sdk.CodespaceType
 	// param space
 	paramSpace params.Subspace
+	// module communicator
+	moduleCommunicator ModuleCommunicator
 }
 
 // NewKeeper create new keeper
@@ -34,12 +41,14 @@ func NewKeeper(
 	storeKey sdk.StoreKey,
 	paramSpace params.Subspace,
 	codespace sdk.CodespaceType,
+	moduleCommunicator ModuleCommunicator,
 ) Keeper {
 	keeper := Keeper{
-		cdc:        cdc,
-		storeKey:   storeKey,
-		paramSpace: paramSpace,
-		codespace:  codespace,
+		cdc:                cdc,
+		storeKey:           storeKey,
+		paramSpace:         paramSpace,
+		codespace:          codespace,
+		moduleCommunicator: moduleCommunicator,
 	}
 	return keeper
 }
@@ -49,6 +58,11 @@ func (k Keeper) Codespace() sdk.CodespaceType {
 	return k.codespace
 }
 
+// GetConfirmationBlocks returns number of mainchain blocks a state sync tx must be buried under
+func (k Keeper) GetConfirmationBlocks(ctx sdk.Context) uint64 {
+	return k.moduleCommunicator.GetConfirmationBlocks(ctx)
+}
+
 // Logger returns a module-specific logger
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", types.ModuleName)
@@ -151,8 +165,12 @@ func (k *Keeper) GetEventRecordList(ctx sdk.Context, page uint64, limit uint64)
 
 // GetEventRecordKey appends prefix to state id
 func GetEventRecordKey(stateID uint64) []byte {
-	stateIDBytes := []byte(strconv.FormatUint(stateID, 10))
-	return append(StateRecordPrefixKey, stateIDBytes...)
+	return append(StateRecordPrefixKey, sdk.Uint64ToBigEndian(stateID)...)
+}
+
+// MigrateEventRecordKeys moves event records stored with decimal keys by older versions to big endian keys, once
+func (k *Keeper) MigrateEventRecordKeys(ctx sdk.Context) int {
+	return hmTypes.MigrateLegacyKeysOnce(ctx.KVStore(k.storeKey), RecordKeysMigrated, StateRecordPrefixKey, GetEventRecordKey)
 }
 
 //
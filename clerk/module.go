@@ -22,6 +22,7 @@ var (
 	_ module.AppModule            = AppModule{}
 	_ module.AppModuleBasic       = AppModuleBasic{}
 	_ hmTypes.HeimdallModuleBasic = AppModule{}
+	_ hmTypes.SideModule          = AppModule{}
 	// _ module.AppModuleSimulation = AppModule{}
 )
 
@@ -115,6 +116,16 @@ func (am AppModule) NewHandler() sdk.Handler {
 	return NewHandler(am.keeper, am.contractCaller)
 }
 
+// NewSideTxHandler returns side tx handler for the module.
+func (am AppModule) NewSideTxHandler() hmTypes.SideTxHandler {
+	return NewSideTxHandler(am.keeper, am.contractCaller)
+}
+
+// NewPostTxHandler returns post tx handler for the module.
+func (am AppModule) NewPostTxHandler() hmTypes.PostTxHandler {
+	return NewPostTxHandler(am.keeper)
+}
+
 // QuerierRoute returns the auth module's querier route name.
 func (AppModule) QuerierRoute() string {
 	return types.QuerierRoute
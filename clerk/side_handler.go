@@ -0,0 +1,124 @@
+package clerk
+
+import (
+	"bytes"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/clerk/types"
+	"github.com/maticnetwork/heimdall/contracts/statesender"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// NewSideTxHandler returns side tx handler for clerk module
+func NewSideTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxHandler {
+	return func(ctx sdk.Context, msg sdk.Msg) hmTypes.SideTxResultType {
+		switch msg := msg.(type) {
+		case types.MsgEventRecord:
+			return sideHandleMsgEventRecord(ctx, msg, k, contractCaller)
+		default:
+			return hmTypes.SideTxResultSkip
+		}
+	}
+}
+
+// NewPostTxHandler returns post tx handler for clerk module
+func NewPostTxHandler(k Keeper) hmTypes.PostTxHandler {
+	return func(ctx sdk.Context, msg sdk.Msg, result hmTypes.SideTxResultType) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgEventRecord:
+			return postHandleMsgEventRecord(ctx, msg, result, k)
+		default:
+			return sdk.ErrTxDecode("Invalid message in clerk module").Result()
+		}
+	}
+}
+
+// sideHandleMsgEventRecord validates state synced event against mainchain
+func sideHandleMsgEventRecord(ctx sdk.Context, msg types.MsgEventRecord, k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxResultType {
+	// get confirmed tx receipt
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), k.GetConfirmationBlocks(ctx))
+	if receipt == nil || err != nil {
+		k.Logger(ctx).Error("Unable to fetch confirmed tx receipt", "error", err, "txHash", msg.TxHash)
+		return hmTypes.SideTxResultSkip
+	}
+
+	var parsedLog *statesender.StatesenderStateSynced
+	for _, log := range receipt.Logs {
+		if uint64(log.Index) == msg.LogIndex && len(log.Topics) == 3 {
+			p, err := contractCaller.EncodeStateSyncedEvent(log)
+			if err != nil {
+				break
+			}
+
+			if p != nil && msg.ID == p.Id.Uint64() {
+				parsedLog = p
+			}
+		}
+	}
+
+	if parsedLog == nil {
+		k.Logger(ctx).Error("Unable to find state synced event", "txHash", msg.TxHash, "logIndex", msg.LogIndex, "id", msg.ID)
+		return hmTypes.SideTxResultNo
+	}
+
+	if !bytes.Equal(parsedLog.ContractAddress.Bytes(), msg.ContractAddress.Bytes()) {
+		k.Logger(ctx).Error("Contract address in message doesn't match contract address in log", "msgContract", msg.ContractAddress, "contractFromLog", parsedLog.ContractAddress.Hex())
+		return hmTypes.SideTxResultNo
+	}
+
+	if !bytes.Equal(parsedLog.Data, msg.Data) {
+		k.Logger(ctx).Error("Data in message doesn't match data in log", "id", msg.ID)
+		return hmTypes.SideTxResultNo
+	}
+
+	return hmTypes.SideTxResultYes
+}
+
+// postHandleMsgEventRecord stores voted event record
+func postHandleMsgEventRecord(ctx sdk.Context, msg types.MsgEventRecord, result hmTypes.SideTxResultType, k Keeper) sdk.Result {
+	if result != hmTypes.SideTxResultYes {
+		k.Logger(ctx).Debug("Skipping event record rejected by validators", "id", msg.ID)
+		return sdk.Result{}
+	}
+
+	// state might have changed while validators were voting
+	if err := validateEventRecord(ctx, msg, k); err != nil {
+		return err.Result()
+	}
+
+	// create event record
+	record := types.NewEventRecord(
+		msg.TxHash,
+		msg.LogIndex,
+		msg.ID,
+		msg.ContractAddress,
+		msg.Data,
+	)
+
+	// save event into state
+	if err := k.SetEventRecord(ctx, record); err != nil {
+		k.Logger(ctx).Error("Unable to update event record", "error", err, "id", msg.ID)
+		return types.ErrEventUpdate(k.Codespace()).Result()
+	}
+
+	// add events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRecord,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyRecordID, strconv.FormatUint(msg.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecordContract, msg.ContractAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyRecordTxHash, msg.TxHash.String()),
+			sdk.NewAttribute(types.AttributeKeyRecordTxLogIndex, strconv.FormatUint(msg.LogIndex, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
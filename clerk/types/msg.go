@@ -8,10 +8,12 @@ import (
 
 // MsgEventRecord - state msg
 type MsgEventRecord struct {
-	From     types.HeimdallAddress `json:"from"`
-	TxHash   types.HeimdallHash    `json:"tx_hash"`
-	LogIndex uint64                `json:"log_index"`
-	ID       uint64                `json:"id"`
+	From            types.HeimdallAddress `json:"from"`
+	TxHash          types.HeimdallHash    `json:"tx_hash"`
+	LogIndex        uint64                `json:"log_index"`
+	ID              uint64                `json:"id"`
+	ContractAddress types.HeimdallAddress `json:"contract_address"`
+	Data            []byte                `json:"data"`
 }
 
 var _ sdk.Msg = MsgEventRecord{}
@@ -22,12 +24,16 @@ func NewMsgEventRecord(
 	txHash types.HeimdallHash,
 	logIndex uint64,
 	id uint64,
+	contractAddress types.HeimdallAddress,
+	data []byte,
 ) MsgEventRecord {
 	return MsgEventRecord{
-		From:     from,
-		TxHash:   txHash,
-		LogIndex: logIndex,
-		ID:       id,
+		From:            from,
+		TxHash:          txHash,
+		LogIndex:        logIndex,
+		ID:              id,
+		ContractAddress: contractAddress,
+		Data:            data,
 	}
 }
 
@@ -46,6 +52,10 @@ func (msg MsgEventRecord) ValidateBasic() sdk.Error {
 	if msg.TxHash.Empty() {
 		return sdk.ErrInvalidAddress("missing tx hash")
 	}
+
+	if msg.ContractAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing contract address")
+	}
 	return nil
 }
 
@@ -68,3 +78,8 @@ func (msg MsgEventRecord) GetTxHash() types.HeimdallHash {
 func (msg MsgEventRecord) GetLogIndex() uint64 {
 	return msg.LogIndex
 }
+
+// IsSideTxMsg marks event record as side tx msg, state synced event is validated by validators before record gets stored
+func (msg MsgEventRecord) IsSideTxMsg() bool {
+	return true
+}
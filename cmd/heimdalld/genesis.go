@@ -0,0 +1,219 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+	tmTypes "github.com/tendermint/tendermint/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	borTypes "github.com/maticnetwork/heimdall/bor/types"
+	"github.com/maticnetwork/heimdall/helper"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	flagDryRun    = "dry-run"
+	flagMaxGap    = "max-gap"
+	flagFromBlock = "from-block"
+)
+
+// genesisCmd groups commands which modify genesis file
+func genesisCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "genesis",
+		Short: "Genesis file subcommands",
+	}
+
+	cmd.AddCommand(importValidatorsCmd(ctx, cdc))
+	return cmd
+}
+
+// importValidatorsCmd builds staking genesis from validators on stake manager contract
+func importValidatorsCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-validators",
+		Short: "Import validators from StakeManager contract into existing genesis file",
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+			helper.InitHeimdallConfig("")
+
+			// Loading genesis doc
+			genesisFile := config.GenesisFile()
+			genDoc, err := tmTypes.GenesisDocFromFile(genesisFile)
+			if err != nil {
+				return err
+			}
+
+			// get genesis state
+			var appState app.GenesisState
+			if err := json.Unmarshal(genDoc.AppState, &appState); err != nil {
+				return err
+			}
+			stakingState := stakingTypes.GetGenesisStateFromAppState(appState)
+
+			contractCaller, err := helper.NewContractCaller()
+			if err != nil {
+				return err
+			}
+
+			validators, err := fetchValidators(&contractCaller, stakingState.Validators, viper.GetInt(flagMaxGap), viper.GetUint64(flagFromBlock))
+			if err != nil {
+				return err
+			}
+
+			if viper.GetBool(flagDryRun) {
+				printValidatorDiff(os.Stdout, stakingState.Validators, validators)
+				return nil
+			}
+
+			// only validators without deactivation epoch are part of current validator set
+			var currentValidators []*hmTypes.Validator
+			for _, validator := range validators {
+				if validator.EndEpoch == 0 {
+					currentValidators = append(currentValidators, validator)
+				}
+			}
+			validatorSet := hmTypes.NewValidatorSet(currentValidators)
+
+			// keep existing dividend accounts and create missing ones
+			dividendAccounts := stakingState.DividentAccounts
+			existingAccounts := make(map[hmTypes.DividendAccountID]bool)
+			for _, dividendAccount := range dividendAccounts {
+				existingAccounts[dividendAccount.ID] = true
+			}
+			for _, validator := range validators {
+				accountID := hmTypes.NewDividendAccountID(uint64(validator.ID))
+				if !existingAccounts[accountID] {
+					dividendAccounts = append(dividendAccounts, hmTypes.NewDividendAccount(accountID, ZeroIntString, ZeroIntString))
+				}
+			}
+
+			// staking state change
+			appState, err = stakingTypes.SetGenesisStateToAppState(appState, validators, *validatorSet, dividendAccounts)
+			if err != nil {
+				return err
+			}
+
+			// bor state change
+			appState, err = borTypes.SetGenesisStateToAppState(appState, *validatorSet)
+			if err != nil {
+				return err
+			}
+
+			// app state json
+			genDoc.AppState, err = json.Marshal(appState)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(os.Stderr, "Imported %v validators into %v\n", len(validators), genesisFile)
+			return genDoc.SaveAs(genesisFile)
+		},
+	}
+
+	cmd.Flags().String(cli.HomeFlag, helper.DefaultNodeHome, "node's home directory")
+	cmd.Flags().Bool(flagDryRun, false, "print changes to genesis validators without writing genesis file")
+	cmd.Flags().Int(flagMaxGap, 10, "number of consecutive empty validator ids after which import stops")
+	cmd.Flags().Uint64(flagFromBlock, 0, "main chain block to start searching validator pubkeys from")
+	return cmd
+}
+
+// fetchValidators enumerates validator ids on stake manager until maxGap consecutive ids are empty.
+// Pubkeys of existing genesis validators are reused if signer didn't change.
+func fetchValidators(contractCaller helper.IContractCaller, existing []*hmTypes.Validator, maxGap int, fromBlock uint64) ([]*hmTypes.Validator, error) {
+	existingPubKeys := make(map[hmTypes.HeimdallAddress]hmTypes.PubKey)
+	for _, validator := range existing {
+		existingPubKeys[validator.Signer] = validator.PubKey
+	}
+
+	var validators []*hmTypes.Validator
+	for id, gap := uint64(1), 0; gap < maxGap; id++ {
+		validator, err := contractCaller.GetValidatorInfo(hmTypes.NewValidatorID(id))
+		if err != nil {
+			return nil, err
+		}
+
+		// unstaked validator ids have empty signer
+		if validator.Signer.Empty() {
+			gap++
+			continue
+		}
+		gap = 0
+
+		pubkey, ok := existingPubKeys[validator.Signer]
+		if !ok {
+			pubkey, err = contractCaller.GetValidatorPubKey(validator.ID, validator.Signer.EthAddress(), fromBlock)
+			if err != nil {
+				return nil, fmt.Errorf("Unable to find pubkey for validator %v: %v", validator.ID, err)
+			}
+		}
+		validator.PubKey = pubkey
+
+		validators = append(validators, &validator)
+	}
+
+	return validators, nil
+}
+
+// printValidatorDiff prints added, removed and changed validators
+func printValidatorDiff(out io.Writer, oldValidators []*hmTypes.Validator, newValidators []*hmTypes.Validator) {
+	oldByID := make(map[hmTypes.ValidatorID]*hmTypes.Validator)
+	for _, validator := range oldValidators {
+		oldByID[validator.ID] = validator
+	}
+	newByID := make(map[hmTypes.ValidatorID]*hmTypes.Validator)
+	for _, validator := range newValidators {
+		newByID[validator.ID] = validator
+	}
+
+	var ids []hmTypes.ValidatorID
+	for id := range oldByID {
+		ids = append(ids, id)
+	}
+	for id := range newByID {
+		if _, ok := oldByID[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	changes := 0
+	for _, id := range ids {
+		oldValidator, hasOld := oldByID[id]
+		newValidator, hasNew := newByID[id]
+		switch {
+		case !hasOld:
+			fmt.Fprintf(out, "+ %v\n", formatGenesisValidator(newValidator))
+		case !hasNew:
+			fmt.Fprintf(out, "- %v\n", formatGenesisValidator(oldValidator))
+		case formatGenesisValidator(oldValidator) != formatGenesisValidator(newValidator):
+			fmt.Fprintf(out, "- %v\n+ %v\n", formatGenesisValidator(oldValidator), formatGenesisValidator(newValidator))
+		default:
+			continue
+		}
+		changes++
+	}
+
+	fmt.Fprintf(out, "%v validators changed, %v validators after import\n", changes, len(newValidators))
+}
+
+func formatGenesisValidator(validator *hmTypes.Validator) string {
+	return fmt.Sprintf("validator %v: signer %v power %v start epoch %v end epoch %v",
+		validator.ID,
+		validator.Signer.String(),
+		validator.VotingPower,
+		validator.StartEpoch,
+		validator.EndEpoch)
+}
@@ -33,6 +33,7 @@ import (
 
 	"github.com/maticnetwork/heimdall/app"
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/helper"
 	hmserver "github.com/maticnetwork/heimdall/server"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -104,6 +105,7 @@ func main() {
 	rootCmd.AddCommand(VerifyGenesis(ctx, cdc))
 	rootCmd.AddCommand(initCmd(ctx, cdc))
 	rootCmd.AddCommand(testnetCmd(ctx, cdc))
+	rootCmd.AddCommand(genesisCmd(ctx, cdc))
 
 	// prepare and add flags
 	executor := cli.PrepareBaseCmd(rootCmd, "HD", os.ExpandEnv("$HOME/.heimdalld"))
@@ -117,6 +119,15 @@ func main() {
 func newApp(logger log.Logger, db dbm.DB, storeTracer io.Writer) abci.Application {
 	// init heimdall config
 	helper.InitHeimdallConfig("")
+
+	// bor header cache used by side tx handlers to build checkpoint roots
+	headerCache, err := checkpointTypes.OpenHeaderCache(filepath.Join(viper.GetString(cli.HomeFlag), "data", checkpointTypes.HeaderCacheDir))
+	if err != nil {
+		logger.Error("Unable to open bor header cache", "error", err)
+	} else {
+		checkpointTypes.SetHeaderCache(headerCache)
+	}
+
 	// create new heimdall app
 	return app.NewHeimdallApp(logger, db, baseapp.SetPruning(store.NewPruningOptionsFromString(viper.GetString("pruning"))))
 }
@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/types"
 )
 
@@ -27,6 +26,7 @@ const (
 	CodeOldCheckpoint            CodeType = 1509
 	CodeDisCountinuousCheckpoint CodeType = 1510
 	CodeNoCheckpointBuffer       CodeType = 1511
+	CodeInvalidLeafVersion       CodeType = 1512
 
 	CodeOldValidator       CodeType = 2500
 	CodeNoValidator        CodeType = 2501
@@ -39,6 +39,8 @@ const (
 	CodeSignerUpdateError  CodeType = 2508
 	CodeNoConn             CodeType = 2509
 	CodeWaitFrConfirmation CodeType = 2510
+	CodeValNotJailed       CodeType = 2511
+	CodeNoDelegation       CodeType = 2512
 
 	CodeSpanNotCountinuous CodeType = 3501
 	CodeUnableToFreezeSet  CodeType = 3502
@@ -87,8 +89,8 @@ func ErrNoConn(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeNoConn, "Unable to connect to chain")
 }
 
-func ErrWaitForConfirmation(codespace sdk.CodespaceType) sdk.Error {
-	return newError(codespace, CodeWaitFrConfirmation, fmt.Sprintf("Please wait for %v confirmations before sending transaction", helper.GetConfig().ConfirmationBlocks))
+func ErrWaitForConfirmation(codespace sdk.CodespaceType, confirmations uint64) sdk.Error {
+	return newError(codespace, CodeWaitFrConfirmation, fmt.Sprintf("Please wait for %v confirmations before sending transaction", confirmations))
 }
 
 func ErrNoCheckpointFound(codespace sdk.CodespaceType) sdk.Error {
@@ -111,6 +113,10 @@ func ErrBadTimeStamp(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeBadTimeStamp, "Invalid time stamp. It must be in near past.")
 }
 
+func ErrInvalidLeafVersion(codespace sdk.CodespaceType, version uint64) sdk.Error {
+	return newError(codespace, CodeInvalidLeafVersion, fmt.Sprintf("Invalid leaf version, current leaf version is %v", version))
+}
+
 // ----------- Staking Errors
 
 func ErrOldValidator(codespace sdk.CodespaceType) sdk.Error {
@@ -157,6 +163,14 @@ func ErrValidatorAlreadyJoined(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeValAlreadyJoined, "Validator already joined")
 }
 
+func ErrValidatorNotJailed(codespace sdk.CodespaceType) sdk.Error {
+	return newError(codespace, CodeValNotJailed, "Validator is not jailed")
+}
+
+func ErrNoDelegation(codespace sdk.CodespaceType) sdk.Error {
+	return newError(codespace, CodeNoDelegation, "Delegation not found")
+}
+
 // Bor Errors --------------------------------
 
 func ErrSpanNotInCountinuity(codespace sdk.CodespaceType) sdk.Error {
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/maticnetwork/bor/accounts/abi"
+	"github.com/maticnetwork/bor/accounts/abi/bind"
 	"github.com/maticnetwork/bor/common"
 	ethTypes "github.com/maticnetwork/bor/core/types"
 	"github.com/maticnetwork/bor/ethclient"
@@ -26,6 +27,7 @@ import (
 type IContractCaller interface {
 	GetHeaderInfo(headerID uint64) (root common.Hash, start, end, createdAt uint64, proposer types.HeimdallAddress, err error)
 	GetValidatorInfo(valID types.ValidatorID) (validator types.Validator, err error)
+	GetValidatorPubKey(valID types.ValidatorID, signer common.Address, fromBlock uint64) (types.PubKey, error)
 	GetLastChildBlock() (uint64, error)
 	CurrentHeaderBlock() (uint64, error)
 	GetBalance(address common.Address) (*big.Int, error)
@@ -33,13 +35,20 @@ type IContractCaller interface {
 	GetCheckpointSign(txHash common.Hash) ([]byte, []byte, []byte, error)
 	GetMainChainBlock(*big.Int) (*ethTypes.Header, error)
 	GetMaticChainBlock(*big.Int) (*ethTypes.Header, error)
-	IsTxConfirmed(common.Hash) bool
-	GetConfirmedTxReceipt(common.Hash) (*ethTypes.Receipt, error)
+	IsTxConfirmed(common.Hash, uint64) bool
+	GetConfirmedTxReceipt(common.Hash, uint64) (*ethTypes.Receipt, error)
 	GetBlockNumberFromTxHash(common.Hash) (*big.Int, error)
 	DecodeValidatorTopupFeesEvent(*ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoTopUpFee, error)
+	DecodeValidatorJoinEvent(*ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoStaked, error)
 	DecodeValidatorStakeUpdateEvent(*ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoStakeUpdate, error)
+	DecodeValidatorExitEvent(*ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoUnstakeInit, error)
 	DecodeNewHeaderBlockEvent(*ethTypes.Receipt, uint64) (*rootchain.RootchainNewHeaderBlock, error)
 	DecodeSignerUpdateEvent(*ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoSignerChange, error)
+	DecodeValidatorJailedEvent(*ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoJailed, error)
+	DecodeValidatorUnJailedEvent(*ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoUnJailed, error)
+	DecodeValidatorReStakeEvent(*ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoReStaked, error)
+	DecodeShareMintedEvent(*ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoShareMinted, error)
+	DecodeShareBurnedEvent(*ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoShareBurned, error)
 	GetMainTxReceipt(common.Hash) (*ethTypes.Receipt, error)
 	GetMaticTxReceipt(common.Hash) (*ethTypes.Receipt, error)
 	ApproveTokens(*big.Int) error
@@ -242,6 +251,41 @@ func (c *ContractCaller) GetValidatorInfo(valID types.ValidatorID) (validator ty
 	return validator, nil
 }
 
+// GetValidatorPubKey finds pubkey of current validator signer from staked or signer change events
+func (c *ContractCaller) GetValidatorPubKey(valID types.ValidatorID, signer common.Address, fromBlock uint64) (types.PubKey, error) {
+	opts := &bind.FilterOpts{Start: fromBlock}
+	validatorIDs := []*big.Int{big.NewInt(int64(valID))}
+
+	// signer might have been updated after staking
+	signerChanges, err := c.StakingInfoInstance.FilterSignerChange(opts, validatorIDs, nil, []common.Address{signer})
+	if err != nil {
+		return types.ZeroPubKey, err
+	}
+	defer signerChanges.Close()
+
+	for signerChanges.Next() {
+		pubkey := types.NewPubKey(signerChanges.Event.SignerPubkey)
+		if pubkey.Address() == signer {
+			return pubkey, nil
+		}
+	}
+
+	stakes, err := c.StakingInfoInstance.FilterStaked(opts, []common.Address{signer}, validatorIDs, nil)
+	if err != nil {
+		return types.ZeroPubKey, err
+	}
+	defer stakes.Close()
+
+	for stakes.Next() {
+		pubkey := types.NewPubKey(stakes.Event.SignerPubkey)
+		if pubkey.Address() == signer {
+			return pubkey, nil
+		}
+	}
+
+	return types.ZeroPubKey, errors.New("No staked or signer change event found for validator signer")
+}
+
 // get main chain block header
 func (c *ContractCaller) GetMainChainBlock(blockNum *big.Int) (header *ethTypes.Header, err error) {
 	latestBlock, err := c.MainChainClient.HeaderByNumber(context.Background(), blockNum)
@@ -282,9 +326,9 @@ func (c *ContractCaller) GetBlockNumberFromTxHash(tx common.Hash) (*big.Int, err
 }
 
 // IsTxConfirmed is tx confirmed
-func (c *ContractCaller) IsTxConfirmed(tx common.Hash) bool {
+func (c *ContractCaller) IsTxConfirmed(tx common.Hash, requiredConfirmations uint64) bool {
 	// get main tx receipt
-	receipt, err := c.GetConfirmedTxReceipt(tx)
+	receipt, err := c.GetConfirmedTxReceipt(tx, requiredConfirmations)
 	if receipt == nil || err != nil {
 		return false
 	}
@@ -292,8 +336,8 @@ func (c *ContractCaller) IsTxConfirmed(tx common.Hash) bool {
 	return true
 }
 
-// GetConfirmedTxReceipt returns confirmed tx receipt
-func (c *ContractCaller) GetConfirmedTxReceipt(tx common.Hash) (*ethTypes.Receipt, error) {
+// GetConfirmedTxReceipt returns tx receipt buried under at least requiredConfirmations blocks
+func (c *ContractCaller) GetConfirmedTxReceipt(tx common.Hash, requiredConfirmations uint64) (*ethTypes.Receipt, error) {
 	// get main tx receipt
 	receipt, err := c.GetMainTxReceipt(tx)
 	if err != nil {
@@ -310,7 +354,7 @@ func (c *ContractCaller) GetConfirmedTxReceipt(tx common.Hash) (*ethTypes.Receip
 	Logger.Debug("Latest block on main chain obtained", "Block", latestBlk.Number.Uint64())
 
 	diff := latestBlk.Number.Uint64() - receipt.BlockNumber.Uint64()
-	if diff < GetConfig().ConfirmationBlocks {
+	if diff < requiredConfirmations {
 		return nil, errors.New("Not enough confirmations")
 	}
 
@@ -339,6 +383,28 @@ func (c *ContractCaller) DecodeValidatorTopupFeesEvent(receipt *ethTypes.Receipt
 	return event, nil
 }
 
+// DecodeValidatorJoinEvent represents validator staked event
+func (c *ContractCaller) DecodeValidatorJoinEvent(receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoStaked, error) {
+	event := new(stakinginfo.StakinginfoStaked)
+
+	found := false
+	for _, vLog := range receipt.Logs {
+		if uint64(vLog.Index) == logIndex {
+			found = true
+			if err := UnpackLog(&c.StakingInfoABI, event, "Staked", vLog); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	if !found {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
+
 // DecodeValidatorStakeUpdateEvent represents validator stake update event
 func (c *ContractCaller) DecodeValidatorStakeUpdateEvent(receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoStakeUpdate, error) {
 	event := new(stakinginfo.StakinginfoStakeUpdate)
@@ -361,6 +427,28 @@ func (c *ContractCaller) DecodeValidatorStakeUpdateEvent(receipt *ethTypes.Recei
 	return event, nil
 }
 
+// DecodeValidatorExitEvent represents validator unstake init event
+func (c *ContractCaller) DecodeValidatorExitEvent(receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoUnstakeInit, error) {
+	event := new(stakinginfo.StakinginfoUnstakeInit)
+
+	found := false
+	for _, vLog := range receipt.Logs {
+		if uint64(vLog.Index) == logIndex {
+			found = true
+			if err := UnpackLog(&c.StakingInfoABI, event, "UnstakeInit", vLog); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	if !found {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
+
 // DecodeNewHeaderBlockEvent represents new header block event
 func (c *ContractCaller) DecodeNewHeaderBlockEvent(receipt *ethTypes.Receipt, logIndex uint64) (*rootchain.RootchainNewHeaderBlock, error) {
 	event := new(rootchain.RootchainNewHeaderBlock)
@@ -405,6 +493,116 @@ func (c *ContractCaller) DecodeSignerUpdateEvent(receipt *ethTypes.Receipt, logI
 	return event, nil
 }
 
+// DecodeValidatorJailedEvent represents validator jailed event
+func (c *ContractCaller) DecodeValidatorJailedEvent(receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoJailed, error) {
+	event := new(stakinginfo.StakinginfoJailed)
+
+	found := false
+	for _, vLog := range receipt.Logs {
+		if uint64(vLog.Index) == logIndex {
+			found = true
+			if err := UnpackLog(&c.StakingInfoABI, event, "Jailed", vLog); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	if !found {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
+
+// DecodeValidatorUnJailedEvent represents validator unjailed event
+func (c *ContractCaller) DecodeValidatorUnJailedEvent(receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoUnJailed, error) {
+	event := new(stakinginfo.StakinginfoUnJailed)
+
+	found := false
+	for _, vLog := range receipt.Logs {
+		if uint64(vLog.Index) == logIndex {
+			found = true
+			if err := UnpackLog(&c.StakingInfoABI, event, "UnJailed", vLog); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	if !found {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
+
+// DecodeValidatorReStakeEvent represents validator restake event
+func (c *ContractCaller) DecodeValidatorReStakeEvent(receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoReStaked, error) {
+	event := new(stakinginfo.StakinginfoReStaked)
+
+	found := false
+	for _, vLog := range receipt.Logs {
+		if uint64(vLog.Index) == logIndex {
+			found = true
+			if err := UnpackLog(&c.StakingInfoABI, event, "ReStaked", vLog); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	if !found {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
+
+// DecodeShareMintedEvent represents delegator share minted event
+func (c *ContractCaller) DecodeShareMintedEvent(receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoShareMinted, error) {
+	event := new(stakinginfo.StakinginfoShareMinted)
+
+	found := false
+	for _, vLog := range receipt.Logs {
+		if uint64(vLog.Index) == logIndex {
+			found = true
+			if err := UnpackLog(&c.StakingInfoABI, event, "ShareMinted", vLog); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	if !found {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
+
+// DecodeShareBurnedEvent represents delegator share burned event
+func (c *ContractCaller) DecodeShareBurnedEvent(receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoShareBurned, error) {
+	event := new(stakinginfo.StakinginfoShareBurned)
+
+	found := false
+	for _, vLog := range receipt.Logs {
+		if uint64(vLog.Index) == logIndex {
+			found = true
+			if err := UnpackLog(&c.StakingInfoABI, event, "ShareBurned", vLog); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	if !found {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
+
 // CurrentAccountStateRoot get current account root from on chain
 func (c *ContractCaller) CurrentAccountStateRoot() ([32]byte, error) {
 	accountStateRoot, err := c.StakingInfoInstance.GetAccountStateRoot(nil)
@@ -58,19 +58,12 @@ const (
 	DefaultHeimdallServerURL = "http://0.0.0.0:1317"
 	DefaultTendermintNodeURL = "http://0.0.0.0:26657"
 
-	NoACKWaitTime        = 1800 * time.Second // Time ack service waits to clear buffer and elect new proposer (1800 seconds ~ 30 mins)
-	CheckpointBufferTime = 1000 * time.Second // Time checkpoint is allowed to stay in buffer (1000 seconds ~ 17 mins)
-
 	DefaultCheckpointerPollInterval = 5 * time.Minute
 	DefaultSyncerPollInterval       = 1 * time.Minute
 	DefaultNoACKPollInterval        = 1010 * time.Second
 	DefaultClerkPollingInterval     = 10 * time.Second
 	DefaultSpanPollingInterval      = 1 * time.Minute
-
-	DefaultCheckpointLength   = 256   // checkpoint number 	 with 0, so length = defaultCheckpointLength -1
-	MaxCheckpointLength       = 1024  // max blocks in one checkpoint
-	DefaultChildBlockInterval = 10000 // difference between 2 indexes of header blocks
-	ConfirmationBlocks        = 6
+	DefaultSideTxPollingInterval    = 10 * time.Second
 
 	DefaultBorChainID           = 15001
 	DefaultValidatorSetAddress  = "0000000000000000000000000000000000001000"
@@ -110,24 +103,13 @@ type Configuration struct {
 	StakeManagerAddress  string `mapstructure:"stake_manager_contract"`
 	MaticTokenAddress    string `mapstructure:"matic_token"`
 
-	ChildBlockInterval uint64 `mapstructure:"child_chain_block_interval"` // Difference between header index of 2 child blocks submitted on main chain
-
 	// config related to bridge
 	CheckpointerPollInterval time.Duration `mapstructure:"checkpoint_poll_interval"` // Poll interval for checkpointer service to send new checkpoints or missing ACK
 	SyncerPollInterval       time.Duration `mapstructure:"syncer_poll_interval"`     // Poll interval for syncher service to sync for changes on main chain
 	NoACKPollInterval        time.Duration `mapstructure:"noack_poll_interval"`      // Poll interval for ack service to send no-ack in case of no checkpoints
 	ClerkPollingInterval     time.Duration `mapstructure:"clerk_polling_interval"`
 	SpanPollingInterval      time.Duration `mapstructure:"span_polling_interval"`
-
-	// checkpoint length related options
-	AvgCheckpointLength uint64 `mapstructure:"avg_checkpoint_length"` // Average number of blocks checkpoint would contain
-	MaxCheckpointLength uint64 `mapstructure:"max_checkpoint_length"` // Maximium number of blocks checkpoint would contain
-
-	// wait time related options
-	NoACKWaitTime        time.Duration `mapstructure:"no_ack_wait_time"`       // Time ack service waits to clear buffer and elect new proposer
-	CheckpointBufferTime time.Duration `mapstructure:"checkpoint_buffer_time"` // Time checkpoint is allowed to stay in buffer
-
-	ConfirmationBlocks uint64 `mapstructure:"confirmation_blocks"` // Number of blocks for confirmation
+	SideTxPollingInterval    time.Duration `mapstructure:"side_tx_polling_interval"` // Poll interval for side tx service to vote on pending side txs
 }
 
 var conf Configuration
@@ -241,21 +223,12 @@ func GetDefaultHeimdallConfig() Configuration {
 		StateReceiverAddress: DefaultStateReceiverAddress,
 		ValidatorSetAddress:  DefaultValidatorSetAddress,
 
-		ChildBlockInterval: DefaultChildBlockInterval,
-
 		CheckpointerPollInterval: DefaultCheckpointerPollInterval,
 		SyncerPollInterval:       DefaultSyncerPollInterval,
 		NoACKPollInterval:        DefaultNoACKPollInterval,
 		ClerkPollingInterval:     DefaultClerkPollingInterval,
 		SpanPollingInterval:      DefaultSpanPollingInterval,
-
-		AvgCheckpointLength: DefaultCheckpointLength,
-		MaxCheckpointLength: MaxCheckpointLength,
-
-		NoACKWaitTime:        NoACKWaitTime,
-		CheckpointBufferTime: CheckpointBufferTime,
-
-		ConfirmationBlocks: ConfirmationBlocks,
+		SideTxPollingInterval:    DefaultSideTxPollingInterval,
 	}
 }
 
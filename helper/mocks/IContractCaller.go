@@ -12,7 +12,7 @@ import (
 
 	rootchain "github.com/maticnetwork/heimdall/contracts/rootchain"
 
-	stakemanager "github.com/maticnetwork/heimdall/contracts/stakemanager"
+	stakinginfo "github.com/maticnetwork/heimdall/contracts/stakinginfo"
 
 	statesender "github.com/maticnetwork/heimdall/contracts/statesender"
 
@@ -24,6 +24,20 @@ type IContractCaller struct {
 	mock.Mock
 }
 
+// ApproveTokens provides a mock function with given fields: _a0
+func (_m *IContractCaller) ApproveTokens(_a0 *big.Int) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*big.Int) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CurrentAccountStateRoot provides a mock function with given fields:
 func (_m *IContractCaller) CurrentAccountStateRoot() ([32]byte, error) {
 	ret := _m.Called()
@@ -123,16 +137,154 @@ func (_m *IContractCaller) DecodeNewHeaderBlockEvent(_a0 *types.Receipt, _a1 uin
 	return r0, r1
 }
 
+// DecodeShareBurnedEvent provides a mock function with given fields: _a0, _a1
+func (_m *IContractCaller) DecodeShareBurnedEvent(_a0 *types.Receipt, _a1 uint64) (*stakinginfo.StakinginfoShareBurned, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *stakinginfo.StakinginfoShareBurned
+	if rf, ok := ret.Get(0).(func(*types.Receipt, uint64) *stakinginfo.StakinginfoShareBurned); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoShareBurned)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DecodeShareMintedEvent provides a mock function with given fields: _a0, _a1
+func (_m *IContractCaller) DecodeShareMintedEvent(_a0 *types.Receipt, _a1 uint64) (*stakinginfo.StakinginfoShareMinted, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *stakinginfo.StakinginfoShareMinted
+	if rf, ok := ret.Get(0).(func(*types.Receipt, uint64) *stakinginfo.StakinginfoShareMinted); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoShareMinted)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DecodeSignerUpdateEvent provides a mock function with given fields: _a0, _a1
-func (_m *IContractCaller) DecodeSignerUpdateEvent(_a0 *types.Receipt, _a1 uint64) (*stakemanager.StakemanagerSignerChange, error) {
+func (_m *IContractCaller) DecodeSignerUpdateEvent(_a0 *types.Receipt, _a1 uint64) (*stakinginfo.StakinginfoSignerChange, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *stakinginfo.StakinginfoSignerChange
+	if rf, ok := ret.Get(0).(func(*types.Receipt, uint64) *stakinginfo.StakinginfoSignerChange); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoSignerChange)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DecodeValidatorJailedEvent provides a mock function with given fields: _a0, _a1
+func (_m *IContractCaller) DecodeValidatorJailedEvent(_a0 *types.Receipt, _a1 uint64) (*stakinginfo.StakinginfoJailed, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *stakinginfo.StakinginfoJailed
+	if rf, ok := ret.Get(0).(func(*types.Receipt, uint64) *stakinginfo.StakinginfoJailed); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoJailed)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DecodeValidatorExitEvent provides a mock function with given fields: _a0, _a1
+func (_m *IContractCaller) DecodeValidatorExitEvent(_a0 *types.Receipt, _a1 uint64) (*stakinginfo.StakinginfoUnstakeInit, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *stakinginfo.StakinginfoUnstakeInit
+	if rf, ok := ret.Get(0).(func(*types.Receipt, uint64) *stakinginfo.StakinginfoUnstakeInit); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoUnstakeInit)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DecodeValidatorJoinEvent provides a mock function with given fields: _a0, _a1
+func (_m *IContractCaller) DecodeValidatorJoinEvent(_a0 *types.Receipt, _a1 uint64) (*stakinginfo.StakinginfoStaked, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *stakinginfo.StakinginfoStaked
+	if rf, ok := ret.Get(0).(func(*types.Receipt, uint64) *stakinginfo.StakinginfoStaked); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoStaked)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DecodeValidatorReStakeEvent provides a mock function with given fields: _a0, _a1
+func (_m *IContractCaller) DecodeValidatorReStakeEvent(_a0 *types.Receipt, _a1 uint64) (*stakinginfo.StakinginfoReStaked, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *stakemanager.StakemanagerSignerChange
-	if rf, ok := ret.Get(0).(func(*types.Receipt, uint64) *stakemanager.StakemanagerSignerChange); ok {
+	var r0 *stakinginfo.StakinginfoReStaked
+	if rf, ok := ret.Get(0).(func(*types.Receipt, uint64) *stakinginfo.StakinginfoReStaked); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*stakemanager.StakemanagerSignerChange)
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoReStaked)
 		}
 	}
 
@@ -147,15 +299,15 @@ func (_m *IContractCaller) DecodeSignerUpdateEvent(_a0 *types.Receipt, _a1 uint6
 }
 
 // DecodeValidatorStakeUpdateEvent provides a mock function with given fields: _a0, _a1
-func (_m *IContractCaller) DecodeValidatorStakeUpdateEvent(_a0 *types.Receipt, _a1 uint64) (*stakemanager.StakemanagerStakeUpdate, error) {
+func (_m *IContractCaller) DecodeValidatorStakeUpdateEvent(_a0 *types.Receipt, _a1 uint64) (*stakinginfo.StakinginfoStakeUpdate, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *stakemanager.StakemanagerStakeUpdate
-	if rf, ok := ret.Get(0).(func(*types.Receipt, uint64) *stakemanager.StakemanagerStakeUpdate); ok {
+	var r0 *stakinginfo.StakinginfoStakeUpdate
+	if rf, ok := ret.Get(0).(func(*types.Receipt, uint64) *stakinginfo.StakinginfoStakeUpdate); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*stakemanager.StakemanagerStakeUpdate)
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoStakeUpdate)
 		}
 	}
 
@@ -170,15 +322,38 @@ func (_m *IContractCaller) DecodeValidatorStakeUpdateEvent(_a0 *types.Receipt, _
 }
 
 // DecodeValidatorTopupFeesEvent provides a mock function with given fields: _a0, _a1
-func (_m *IContractCaller) DecodeValidatorTopupFeesEvent(_a0 *types.Receipt, _a1 uint64) (*stakemanager.StakemanagerTopUpFee, error) {
+func (_m *IContractCaller) DecodeValidatorTopupFeesEvent(_a0 *types.Receipt, _a1 uint64) (*stakinginfo.StakinginfoTopUpFee, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *stakinginfo.StakinginfoTopUpFee
+	if rf, ok := ret.Get(0).(func(*types.Receipt, uint64) *stakinginfo.StakinginfoTopUpFee); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoTopUpFee)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DecodeValidatorUnJailedEvent provides a mock function with given fields: _a0, _a1
+func (_m *IContractCaller) DecodeValidatorUnJailedEvent(_a0 *types.Receipt, _a1 uint64) (*stakinginfo.StakinginfoUnJailed, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *stakemanager.StakemanagerTopUpFee
-	if rf, ok := ret.Get(0).(func(*types.Receipt, uint64) *stakemanager.StakemanagerTopUpFee); ok {
+	var r0 *stakinginfo.StakinginfoUnJailed
+	if rf, ok := ret.Get(0).(func(*types.Receipt, uint64) *stakinginfo.StakinginfoUnJailed); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*stakemanager.StakemanagerTopUpFee)
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoUnJailed)
 		}
 	}
 
@@ -302,13 +477,13 @@ func (_m *IContractCaller) GetCheckpointSign(txHash common.Hash) ([]byte, []byte
 	return r0, r1, r2, r3
 }
 
-// GetConfirmedTxReceipt provides a mock function with given fields: _a0
-func (_m *IContractCaller) GetConfirmedTxReceipt(_a0 common.Hash) (*types.Receipt, error) {
-	ret := _m.Called(_a0)
+// GetConfirmedTxReceipt provides a mock function with given fields: _a0, _a1
+func (_m *IContractCaller) GetConfirmedTxReceipt(_a0 common.Hash, _a1 uint64) (*types.Receipt, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 *types.Receipt
-	if rf, ok := ret.Get(0).(func(common.Hash) *types.Receipt); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(common.Hash, uint64) *types.Receipt); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*types.Receipt)
@@ -316,8 +491,8 @@ func (_m *IContractCaller) GetConfirmedTxReceipt(_a0 common.Hash) (*types.Receip
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(common.Hash) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(common.Hash, uint64) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -553,13 +728,36 @@ func (_m *IContractCaller) GetValidatorInfo(valID heimdalltypes.ValidatorID) (he
 	return r0, r1
 }
 
-// IsTxConfirmed provides a mock function with given fields: _a0
-func (_m *IContractCaller) IsTxConfirmed(_a0 common.Hash) bool {
-	ret := _m.Called(_a0)
+// GetValidatorPubKey provides a mock function with given fields: valID, signer, fromBlock
+func (_m *IContractCaller) GetValidatorPubKey(valID heimdalltypes.ValidatorID, signer common.Address, fromBlock uint64) (heimdalltypes.PubKey, error) {
+	ret := _m.Called(valID, signer, fromBlock)
+
+	var r0 heimdalltypes.PubKey
+	if rf, ok := ret.Get(0).(func(heimdalltypes.ValidatorID, common.Address, uint64) heimdalltypes.PubKey); ok {
+		r0 = rf(valID, signer, fromBlock)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(heimdalltypes.PubKey)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(heimdalltypes.ValidatorID, common.Address, uint64) error); ok {
+		r1 = rf(valID, signer, fromBlock)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IsTxConfirmed provides a mock function with given fields: _a0, _a1
+func (_m *IContractCaller) IsTxConfirmed(_a0 common.Hash, _a1 uint64) bool {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 bool
-	if rf, ok := ret.Get(0).(func(common.Hash) bool); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(common.Hash, uint64) bool); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
@@ -571,3 +769,17 @@ func (_m *IContractCaller) IsTxConfirmed(_a0 common.Hash) bool {
 func (_m *IContractCaller) SendCheckpoint(voteSignBytes []byte, sigs []byte, txData []byte) {
 	_m.Called(voteSignBytes, sigs, txData)
 }
+
+// StakeFor provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *IContractCaller) StakeFor(_a0 common.Address, _a1 *big.Int, _a2 *big.Int, _a3 bool) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(common.Address, *big.Int, *big.Int, bool) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -53,7 +53,6 @@ validator_set_contract = "{{ .ValidatorSetAddress }}"
 
 
 ##### Intervals #####
-child_chain_block_interval = "{{ .ChildBlockInterval }}" 
 
 ## Bridge Poll Intervals 
 checkpoint_poll_interval = "{{ .CheckpointerPollInterval }}" 
@@ -61,20 +60,7 @@ syncer_poll_interval = "{{ .SyncerPollInterval }}"
 noack_poll_interval = "{{ .NoACKPollInterval }}"
 clerk_polling_interval = "{{ .ClerkPollingInterval }}" 
 span_polling_interval = "{{ .SpanPollingInterval }}" 
-
-
-##### Checkpoint Length Config #####
-avg_checkpoint_length = "{{ .AvgCheckpointLength }}"
-max_checkpoint_length = "{{ .MaxCheckpointLength }}"
-
-##### Timeout Config #####
-
-no_ack_wait_time = "{{ .NoACKWaitTime }}"
-checkpoint_buffer_time = "{{ .CheckpointBufferTime }}"
-
-##### Transaction Confirmations  #####
-
-confirmation_blocks = "{{ .ConfirmationBlocks }}"
+side_tx_polling_interval = "{{ .SideTxPollingInterval }}"
 
 `
 
@@ -83,11 +83,20 @@ func Paginate(numObjs, page, limit, defLimit int) (start, end int) {
 }
 
 // GetUpdatedValidators updates validators in validator set
+// Only top maxValidators eligible validators by power are kept in set, 0 means no limit
 func GetUpdatedValidators(
 	currentSet *hmTypes.ValidatorSet,
 	validators []*hmTypes.Validator,
 	ackCount uint64,
+	maxValidators uint64,
+	isEligible func(*hmTypes.Validator) bool,
 ) []*hmTypes.Validator {
+	// validators which should be part of validator set
+	selected := make(map[hmTypes.HeimdallAddress]bool)
+	for _, validator := range SelectCurrentValidators(validators, ackCount, maxValidators, isEligible) {
+		selected[validator.Signer] = true
+	}
+
 	updates := make([]*hmTypes.Validator, 0)
 	for _, v := range validators {
 		// create copy of validator
@@ -95,11 +104,11 @@ func GetUpdatedValidators(
 
 		address := validator.Signer.Bytes()
 		_, val := currentSet.GetByAddress(address)
-		if val != nil && !validator.IsCurrentValidator(ackCount) {
+		if val != nil && !selected[validator.Signer] {
 			// remove validator
 			validator.VotingPower = 0
 			updates = append(updates, validator)
-		} else if val == nil && validator.IsCurrentValidator(ackCount) {
+		} else if val == nil && selected[validator.Signer] {
 			// add validator
 			updates = append(updates, validator)
 		} else if val != nil && validator.VotingPower != val.VotingPower {
@@ -110,6 +119,42 @@ func GetUpdatedValidators(
 	return updates
 }
 
+// SelectCurrentValidators returns top maxValidators validators by power which are current validators at ack count.
+// Validators rejected by isEligible stay standby
+func SelectCurrentValidators(validators []*hmTypes.Validator, ackCount uint64, maxValidators uint64, isEligible func(*hmTypes.Validator) bool) []*hmTypes.Validator {
+	eligible := make([]*hmTypes.Validator, 0, len(validators))
+	for _, validator := range validators {
+		if validator.IsCurrentValidator(ackCount) && isEligible(validator) {
+			eligible = append(eligible, validator)
+		}
+	}
+
+	return RankValidators(eligible, maxValidators)
+}
+
+// RankValidators sorts validators by power, ties are broken by validator ID and signer address.
+// Only top maxValidators validators are returned, 0 means no limit
+func RankValidators(validators []*hmTypes.Validator, maxValidators uint64) []*hmTypes.Validator {
+	ranked := make([]*hmTypes.Validator, len(validators))
+	copy(ranked, validators)
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].VotingPower != ranked[j].VotingPower {
+			return ranked[i].VotingPower > ranked[j].VotingPower
+		}
+		if ranked[i].ID != ranked[j].ID {
+			return ranked[i].ID < ranked[j].ID
+		}
+		return bytes.Compare(ranked[i].Signer.Bytes(), ranked[j].Signer.Bytes()) < 0
+	})
+
+	if maxValidators > 0 && uint64(len(ranked)) > maxValidators {
+		ranked = ranked[:maxValidators]
+	}
+
+	return ranked
+}
+
 // GetPkObjects from crypto priv key
 func GetPkObjects(privKey crypto.PrivKey) (secp256k1.PrivKeySecp256k1, secp256k1.PubKeySecp256k1) {
 	var privObject secp256k1.PrivKeySecp256k1
@@ -645,14 +690,25 @@ func GetReceiptLogData(log *ethTypes.Log) []byte {
 	return append(result, log.Data...)
 }
 
-// GetPowerFromAmount returns power from amount -- note that this will polute amount object
+// DefaultPowerReduction is stake amount backing one unit of voting power, 1 token with 18 decimals
+var DefaultPowerReduction = big.NewInt(0).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+// GetPowerFromAmount returns power from amount with default power reduction
 func GetPowerFromAmount(amount *big.Int) (*big.Int, error) {
-	decimals18 := big.NewInt(10).Exp(big.NewInt(10), big.NewInt(18), nil)
-	if amount.Uint64() < decimals18.Uint64() {
+	return GetPowerFromAmountWithReduction(amount, DefaultPowerReduction)
+}
+
+// GetPowerFromAmountWithReduction returns power from amount, amount must back at least one unit of power
+func GetPowerFromAmountWithReduction(amount *big.Int, powerReduction *big.Int) (*big.Int, error) {
+	if powerReduction.Sign() <= 0 {
+		return nil, errors.New("power reduction must be positive")
+	}
+
+	if amount.Cmp(powerReduction) < 0 {
 		return nil, errors.New("amount must be more than 1 token")
 	}
 
-	return amount.Div(amount, decimals18), nil
+	return big.NewInt(0).Div(amount, powerReduction), nil
 }
 
 // GetAmountFromString converts string to its big Int
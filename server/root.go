@@ -3,6 +3,7 @@ package server
 import (
 	"net/http"
 	"os"
+	"path/filepath"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/lcd"
@@ -12,9 +13,11 @@ import (
 	"github.com/rakyll/statik/fs"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	tmCli "github.com/tendermint/tendermint/libs/cli"
 	tmLog "github.com/tendermint/tendermint/libs/log"
 
 	"github.com/maticnetwork/heimdall/app"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	tx "github.com/maticnetwork/heimdall/client/tx"
 	"github.com/maticnetwork/heimdall/helper"
 
@@ -32,10 +35,19 @@ func ServeCommands(cdc *codec.Codec, registerRoutesFn func(*lcd.RestServer)) *co
 		RunE: func(cmd *cobra.Command, args []string) error {
 			helper.InitHeimdallConfig("")
 
+			logger := tmLog.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "rest-server")
+
+			// bor header cache for checkpoint root queries, separate from node's cache as db can't be shared between processes
+			headerCache, err := checkpointTypes.OpenHeaderCache(filepath.Join(viper.GetString(tmCli.HomeFlag), "data", "rest-"+checkpointTypes.HeaderCacheDir))
+			if err != nil {
+				logger.Error("Unable to open bor header cache", "error", err)
+			} else {
+				checkpointTypes.SetHeaderCache(headerCache)
+			}
+
 			rs := lcd.NewRestServer(cdc)
 			registerRoutesFn(rs)
-			logger := tmLog.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "rest-server")
-			err := rs.Start(
+			err = rs.Start(
 				viper.GetString(client.FlagListenAddr),
 				viper.GetInt(client.FlagMaxOpenConnections),
 				0,
@@ -0,0 +1,7 @@
+package cli
+
+const (
+	FlagValidatorAddress = "validator"
+	FlagTxHash           = "tx-hash"
+	FlagResult           = "result"
+)
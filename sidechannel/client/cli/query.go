@@ -0,0 +1,154 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	hmClient "github.com/maticnetwork/heimdall/client"
+	"github.com/maticnetwork/heimdall/sidechannel/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	// Group sidechannel queries under a subcommand
+	queryCmds := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the sidechannel module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       hmClient.ValidateCmd,
+	}
+
+	// sidechannel query command
+	queryCmds.AddCommand(
+		client.GetCommands(
+			GetQueryParams(cdc),
+			GetPendingSideTxs(cdc),
+			GetSideTxVotes(cdc),
+			GetSideTxResult(cdc),
+		)...,
+	)
+
+	return queryCmds
+}
+
+// GetQueryParams implements the params query command.
+func GetQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "show the current sidechannel parameters information",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := cdc.UnmarshalJSON(bz, &params); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+// GetPendingSideTxs shows side txs waiting for votes
+func GetPendingSideTxs(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pending-txs",
+		Args:  cobra.NoArgs,
+		Short: "show side txs waiting for validator votes",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPendingSideTxs), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+
+// GetSideTxVotes shows votes on side tx
+func GetSideTxVotes(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "votes",
+		Short: "show validator votes on side tx",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := querySideTx(cliCtx, types.QuerySideTxVotes, viper.GetString(FlagTxHash))
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagTxHash, "", "--tx-hash=<tx-hash>")
+	cmd.MarkFlagRequired(FlagTxHash)
+	return cmd
+}
+
+// GetSideTxResult shows vote of queried node on side tx
+func GetSideTxResult(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "side-tx-result",
+		Short: "check side tx against external chains of queried node",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := querySideTx(cliCtx, types.QuerySideTxResult, viper.GetString(FlagTxHash))
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagTxHash, "", "--tx-hash=<tx-hash>")
+	cmd.MarkFlagRequired(FlagTxHash)
+	return cmd
+}
+
+// querySideTx queries side tx endpoint with tx hash
+func querySideTx(cliCtx context.CLIContext, endpoint string, txHashStr string) ([]byte, error) {
+	if txHashStr == "" {
+		return nil, errors.New("tx hash cannot be empty")
+	}
+
+	// get query params
+	queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQuerySideTxParams(hmTypes.HexToHeimdallHash(txHashStr)))
+	if err != nil {
+		return nil, err
+	}
+
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, endpoint), queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res) == 0 {
+		return nil, errors.New("Side tx not found")
+	}
+
+	return res, nil
+}
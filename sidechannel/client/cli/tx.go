@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	hmClient "github.com/maticnetwork/heimdall/client"
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/sidechannel/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Sidechannel transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       hmClient.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		client.PostCommands(
+			SendSideTxVote(cdc),
+		)...,
+	)
+	return txCmd
+}
+
+// SendSideTxVote sends vote on pending side tx
+func SendSideTxVote(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote",
+		Short: "vote on side tx, result is checked by queried node unless given",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// get validator
+			validator := hmTypes.HexToHeimdallAddress(viper.GetString(FlagValidatorAddress))
+			if validator.Empty() {
+				validator = helper.GetFromAddress(cliCtx)
+			}
+
+			txHashStr := viper.GetString(FlagTxHash)
+
+			var result hmTypes.SideTxResultType
+			if resultStr := viper.GetString(FlagResult); resultStr != "" {
+				r, err := hmTypes.SideTxResultFromString(resultStr)
+				if err != nil {
+					return err
+				}
+				result = r
+			} else {
+				res, err := querySideTx(cliCtx, types.QuerySideTxResult, txHashStr)
+				if err != nil {
+					return err
+				}
+
+				if err := json.Unmarshal(res, &result); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgSideTxVote(
+				validator,
+				hmTypes.HexToHeimdallHash(txHashStr),
+				result,
+			)
+
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagValidatorAddress, "", "--validator=<validator-address>")
+	cmd.Flags().String(FlagTxHash, "", "--tx-hash=<tx-hash>")
+	cmd.Flags().String(FlagResult, "", "--result=<yes|no|skip>")
+	cmd.MarkFlagRequired(FlagTxHash)
+	return cmd
+}
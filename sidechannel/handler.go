@@ -0,0 +1,140 @@
+package sidechannel
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/maticnetwork/heimdall/auth"
+	"github.com/maticnetwork/heimdall/sidechannel/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// NewHandler creates new handler for handling messages for sidechannel module
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgSideTxVote:
+			return handleMsgSideTxVote(ctx, msg, k)
+		default:
+			return sdk.ErrTxDecode("Invalid message in sidechannel module").Result()
+		}
+	}
+}
+
+// NewSideTxMsgHandler wraps module handler, side tx msgs accepted by module handler are held until validators vote on them.
+// Module handler must only do checks which don't depend on external chains, state changes happen in post tx handler.
+func NewSideTxMsgHandler(k Keeper, handler sdk.Handler) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		result := handler(ctx, msg)
+		if !result.IsOK() {
+			return result
+		}
+
+		if _, ok := msg.(hmTypes.SideTxMsg); !ok || !k.router.HasRoute(msg.Route()) {
+			return result
+		}
+
+		// side tx is identified by hash of tx which carries it
+		txHash := hmTypes.BytesToHeimdallHash(tmhash.Sum(ctx.TxBytes()))
+		payer, fee := auth.GetCollectedFee(ctx)
+		if err := k.SetPendingSideTx(ctx, types.NewPendingSideTx(txHash, ctx.BlockHeight(), msg, payer, fee)); err != nil {
+			k.Logger(ctx).Error("Unable to store pending side tx", "error", err, "txHash", txHash)
+			return types.ErrSideTxAlreadyExists(k.Codespace()).Result()
+		}
+		k.Logger(ctx).Debug("Side tx waiting for votes", "txHash", txHash, "route", msg.Route(), "type", msg.Type())
+
+		result.Events = append(result.Events, sdk.NewEvent(
+			types.EventTypeSideTx,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyTxHash, txHash.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgType, msg.Type()),
+		))
+
+		return result
+	}
+}
+
+// handleMsgSideTxVote records vote of validator and applies side tx once voting is finished
+func handleMsgSideTxVote(ctx sdk.Context, msg types.MsgSideTxVote, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("Handling side tx vote", "txHash", msg.TxHash, "validator", msg.Validator, "result", msg.Result)
+
+	tx, err := k.GetPendingSideTx(ctx, msg.TxHash)
+	if err != nil || tx == nil {
+		k.Logger(ctx).Error("Unable to fetch pending side tx", "error", err, "txHash", msg.TxHash)
+		return types.ErrNoPendingSideTx(k.Codespace()).Result()
+	}
+
+	// votes are cast on committed state, so they can't be in the same block as side tx
+	if ctx.BlockHeight() <= tx.Height {
+		return types.ErrEarlySideTxVote(k.Codespace()).Result()
+	}
+
+	// only validators in current set can vote
+	validatorSet := k.sk.GetValidatorSet(ctx)
+	if !validatorSet.HasAddress(msg.Validator.Bytes()) {
+		k.Logger(ctx).Error("Vote from validator not in current set", "validator", msg.Validator)
+		return types.ErrNotValidator(k.Codespace()).Result()
+	}
+
+	if k.HasSideTxVote(ctx, msg.TxHash, msg.Validator) {
+		return types.ErrDuplicateSideTxVote(k.Codespace()).Result()
+	}
+
+	// store vote
+	if err := k.SetSideTxVote(ctx, types.NewSideTxVote(msg.TxHash, msg.Validator, msg.Result, ctx.BlockHeight())); err != nil {
+		return sdk.ErrInternal(err.Error()).Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSideTxVote,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyTxHash, msg.TxHash.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.Validator.String()),
+			sdk.NewAttribute(types.AttributeKeyResult, msg.Result.String()),
+		),
+	})
+
+	// apply side tx if voting is finished
+	tally := k.TallySideTxVotes(ctx, msg.TxHash)
+	k.Logger(ctx).Debug("Side tx tally", "txHash", msg.TxHash, "yes", tally.Yes, "no", tally.No, "total", tally.Total)
+	if result := tally.Result(); result != hmTypes.SideTxResultSkip {
+		ctx.EventManager().EmitEvents(k.ApplySideTx(ctx, *tx, result))
+	}
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// EndBlocker drops side txs which didn't get enough votes in voting period
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	votingPeriod := k.GetParams(ctx).VotingPeriod
+
+	var expired []types.PendingSideTx
+	k.IteratePendingSideTxsAndApplyFn(ctx, func(tx types.PendingSideTx) error {
+		if ctx.BlockHeight()-tx.Height >= votingPeriod {
+			expired = append(expired, tx)
+		}
+		return nil
+	})
+
+	for _, tx := range expired {
+		k.DeletePendingSideTx(ctx, tx.TxHash)
+		k.Logger(ctx).Info("Side tx expired without enough votes", "txHash", tx.TxHash, "height", tx.Height)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSideTxExpired,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyTxHash, tx.TxHash.String()),
+				sdk.NewAttribute(types.AttributeKeyMsgType, tx.Msg.Type()),
+				sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(tx.Height, 10)),
+			),
+		)
+	}
+}
@@ -0,0 +1,240 @@
+package sidechannel_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/maticnetwork/heimdall/auth"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/sidechannel"
+	sidechannelTypes "github.com/maticnetwork/heimdall/sidechannel/types"
+	"github.com/maticnetwork/heimdall/staking"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	cmn "github.com/maticnetwork/heimdall/test"
+	"github.com/maticnetwork/heimdall/types"
+)
+
+// zeroModuleCommunicator always returns zero ack count
+type zeroModuleCommunicator struct{}
+
+func (zeroModuleCommunicator) GetACKCount(ctx sdk.Context) uint64 { return 0 }
+
+func (zeroModuleCommunicator) GetConfirmationBlocks(ctx sdk.Context) uint64 { return 0 }
+
+func (zeroModuleCommunicator) RefundRelayerFee(ctx sdk.Context) (types.HeimdallAddress, sdk.Error) {
+	return types.HeimdallAddress{}, nil
+}
+
+// postTxCall records call to post tx handler
+type postTxCall struct {
+	msg    sdk.Msg
+	result types.SideTxResultType
+	payer  types.HeimdallAddress
+	fee    types.Coins
+}
+
+func createTestInput(t *testing.T, sideResult types.SideTxResultType) (sdk.Context, staking.Keeper, sidechannel.Keeper, *[]postTxCall) {
+	helper.InitHeimdallConfig(os.ExpandEnv("$HOME/.heimdalld"))
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+
+	keyStaking := sdk.NewKVStoreKey(stakingTypes.StoreKey)
+	keySidechannel := sdk.NewKVStoreKey(sidechannelTypes.StoreKey)
+	keyParams := sdk.NewKVStoreKey(subspace.StoreKey)
+	tKeyParams := sdk.NewTransientStoreKey(subspace.TStoreKey)
+
+	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keySidechannel, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tKeyParams, sdk.StoreTypeTransient, db)
+	require.Nil(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "foochainid", Height: 1}, false, log.NewNopLogger())
+
+	cdc := codec.New()
+	codec.RegisterCrypto(cdc)
+	sdk.RegisterCodec(cdc)
+	checkpointTypes.RegisterCodec(cdc)
+	sidechannelTypes.RegisterCodec(cdc)
+	cdc.Seal()
+
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tKeyParams, common.DefaultCodespace)
+	stakingKeeper := staking.NewKeeper(
+		cdc,
+		keyStaking,
+		paramsKeeper.Subspace(stakingTypes.DefaultParamspace),
+		common.DefaultCodespace,
+		zeroModuleCommunicator{},
+	)
+
+	// checkpoint route with fixed side tx result
+	calls := &[]postTxCall{}
+	router := sidechannelTypes.NewRouter()
+	router.AddRoute(
+		checkpointTypes.RouterKey,
+		func(ctx sdk.Context, msg sdk.Msg) types.SideTxResultType {
+			return sideResult
+		},
+		func(ctx sdk.Context, msg sdk.Msg, result types.SideTxResultType) sdk.Result {
+			payer, fee := auth.GetCollectedFee(ctx)
+			*calls = append(*calls, postTxCall{msg, result, payer, fee})
+			return sdk.Result{}
+		},
+	)
+
+	keeper := sidechannel.NewKeeper(
+		cdc,
+		keySidechannel,
+		paramsKeeper.Subspace(sidechannelTypes.DefaultParamspace),
+		sidechannelTypes.DefaultCodespace,
+		stakingKeeper,
+		router,
+	)
+	keeper.SetParams(ctx, sidechannelTypes.DefaultParams())
+
+	cmn.LoadValidatorSet(4, t, stakingKeeper, ctx, false, 10)
+	return ctx, stakingKeeper, keeper, calls
+}
+
+// submitSideTx delivers side tx msg through wrapped module handler
+func submitSideTx(t *testing.T, ctx sdk.Context, keeper sidechannel.Keeper, msg sdk.Msg) types.HeimdallHash {
+	txBytes := []byte("side tx")
+	handler := sidechannel.NewSideTxMsgHandler(keeper, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		return sdk.Result{}
+	})
+
+	got := handler(ctx.WithTxBytes(txBytes), msg)
+	require.True(t, got.IsOK(), "expected side tx to be ok, got %v", got)
+	return types.BytesToHeimdallHash(tmhash.Sum(txBytes))
+}
+
+func TestSideTxVoting(t *testing.T) {
+	ctx, sk, keeper, calls := createTestInput(t, types.SideTxResultYes)
+	validators := sk.GetValidatorSet(ctx).Validators
+	handler := sidechannel.NewHandler(keeper)
+
+	msg := checkpointTypes.NewMsgCheckpointBlock(validators[0].Signer, 0, 255, types.HexToHeimdallHash("0x1"), types.HexToHeimdallHash("0x2"), 1, checkpointTypes.DefaultLeafVersion)
+	txHash := submitSideTx(t, ctx, keeper, msg)
+	require.True(t, keeper.HasPendingSideTx(ctx, txHash), "side tx should wait for votes")
+
+	// same side tx can't be submitted twice
+	got := sidechannel.NewSideTxMsgHandler(keeper, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		return sdk.Result{}
+	})(ctx.WithTxBytes([]byte("side tx")), msg)
+	require.Equal(t, sdk.CodeType(sidechannelTypes.CodeSideTxAlreadyExists), got.Code)
+
+	// votes are only accepted after side tx block
+	got = handler(ctx, sidechannelTypes.NewMsgSideTxVote(validators[0].Signer, txHash, types.SideTxResultYes))
+	require.Equal(t, sdk.CodeType(sidechannelTypes.CodeEarlySideTxVote), got.Code)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// only validators can vote
+	got = handler(ctx, sidechannelTypes.NewMsgSideTxVote(types.HexToHeimdallAddress("0x1"), txHash, types.SideTxResultYes))
+	require.Equal(t, sdk.CodeType(sidechannelTypes.CodeNotValidator), got.Code)
+
+	// unknown side tx
+	got = handler(ctx, sidechannelTypes.NewMsgSideTxVote(validators[0].Signer, types.HexToHeimdallHash("0x1"), types.SideTxResultYes))
+	require.Equal(t, sdk.CodeType(sidechannelTypes.CodeNoPendingSideTx), got.Code)
+
+	// 2/3 of voting power is not reached with two votes
+	for _, validator := range validators[:2] {
+		got = handler(ctx, sidechannelTypes.NewMsgSideTxVote(validator.Signer, txHash, types.SideTxResultYes))
+		require.True(t, got.IsOK(), "expected vote to be ok, got %v", got)
+	}
+	require.Empty(t, *calls, "side tx should not be applied before 2/3+ votes")
+
+	got = handler(ctx, sidechannelTypes.NewMsgSideTxVote(validators[0].Signer, txHash, types.SideTxResultYes))
+	require.Equal(t, sdk.CodeType(sidechannelTypes.CodeDuplicateSideTxVote), got.Code)
+
+	// third vote applies side tx
+	got = handler(ctx, sidechannelTypes.NewMsgSideTxVote(validators[2].Signer, txHash, types.SideTxResultYes))
+	require.True(t, got.IsOK(), "expected vote to be ok, got %v", got)
+	require.Len(t, *calls, 1)
+	require.Equal(t, types.SideTxResultYes, (*calls)[0].result)
+	require.Equal(t, msg, (*calls)[0].msg)
+	require.False(t, keeper.HasPendingSideTx(ctx, txHash), "applied side tx should be removed")
+	require.Empty(t, keeper.GetSideTxVotes(ctx, txHash), "votes of applied side tx should be removed")
+
+	// late vote is rejected
+	got = handler(ctx, sidechannelTypes.NewMsgSideTxVote(validators[3].Signer, txHash, types.SideTxResultYes))
+	require.Equal(t, sdk.CodeType(sidechannelTypes.CodeNoPendingSideTx), got.Code)
+}
+
+func TestSideTxFeeRefundable(t *testing.T) {
+	ctx, sk, keeper, calls := createTestInput(t, types.SideTxResultYes)
+	validators := sk.GetValidatorSet(ctx).Validators
+	handler := sidechannel.NewHandler(keeper)
+
+	// fee collected from relayer of side tx is recorded with side tx
+	relayer := validators[0].Signer
+	fee := types.Coins{types.Coin{Denom: "matic", Amount: types.NewInt(10)}}
+	msg := checkpointTypes.NewMsgCheckpointBlock(relayer, 0, 255, types.HexToHeimdallHash("0x1"), types.HexToHeimdallHash("0x2"), 1, checkpointTypes.DefaultLeafVersion)
+	txHash := submitSideTx(t, auth.WithCollectedFee(ctx, relayer, fee), keeper, msg)
+	tx, err := keeper.GetPendingSideTx(ctx, txHash)
+	require.Nil(t, err)
+	require.Equal(t, relayer, tx.Payer)
+	require.Equal(t, fee, tx.Fee)
+
+	// post tx handler sees fee of side tx, not fee of vote which applies it
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	for _, validator := range validators[1:4] {
+		voteFee := types.Coins{types.Coin{Denom: "matic", Amount: types.NewInt(1)}}
+		got := handler(auth.WithCollectedFee(ctx, validator.Signer, voteFee), sidechannelTypes.NewMsgSideTxVote(validator.Signer, txHash, types.SideTxResultYes))
+		require.True(t, got.IsOK(), "expected vote to be ok, got %v", got)
+	}
+	require.Len(t, *calls, 1)
+	require.Equal(t, relayer, (*calls)[0].payer)
+	require.Equal(t, fee, (*calls)[0].fee)
+}
+
+func TestSideTxRejected(t *testing.T) {
+	ctx, sk, keeper, calls := createTestInput(t, types.SideTxResultNo)
+	validators := sk.GetValidatorSet(ctx).Validators
+	handler := sidechannel.NewHandler(keeper)
+
+	msg := checkpointTypes.NewMsgCheckpointBlock(validators[0].Signer, 0, 255, types.HexToHeimdallHash("0x1"), types.HexToHeimdallHash("0x2"), 1, checkpointTypes.DefaultLeafVersion)
+	txHash := submitSideTx(t, ctx, keeper, msg)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// validators which can't verify side tx don't vote
+	require.NotNil(t, sidechannelTypes.NewMsgSideTxVote(validators[1].Signer, txHash, types.SideTxResultSkip).ValidateBasic())
+
+	// 2/3+ can't be reached once more than 1/3 votes no
+	handler(ctx, sidechannelTypes.NewMsgSideTxVote(validators[0].Signer, txHash, types.SideTxResultNo))
+	require.Empty(t, *calls)
+	handler(ctx, sidechannelTypes.NewMsgSideTxVote(validators[1].Signer, txHash, types.SideTxResultNo))
+	require.Len(t, *calls, 1)
+	require.Equal(t, types.SideTxResultNo, (*calls)[0].result)
+	require.False(t, keeper.HasPendingSideTx(ctx, txHash))
+}
+
+func TestSideTxExpiry(t *testing.T) {
+	ctx, sk, keeper, calls := createTestInput(t, types.SideTxResultYes)
+	validators := sk.GetValidatorSet(ctx).Validators
+	votingPeriod := keeper.GetParams(ctx).VotingPeriod
+
+	msg := checkpointTypes.NewMsgCheckpointBlock(validators[0].Signer, 0, 255, types.HexToHeimdallHash("0x1"), types.HexToHeimdallHash("0x2"), 1, checkpointTypes.DefaultLeafVersion)
+	txHash := submitSideTx(t, ctx, keeper, msg)
+
+	sidechannel.EndBlocker(ctx.WithBlockHeight(ctx.BlockHeight()+votingPeriod-1), keeper)
+	require.True(t, keeper.HasPendingSideTx(ctx, txHash), "side tx should wait for votes in voting period")
+
+	sidechannel.EndBlocker(ctx.WithBlockHeight(ctx.BlockHeight()+votingPeriod), keeper)
+	require.False(t, keeper.HasPendingSideTx(ctx, txHash), "side tx should expire after voting period")
+	require.Empty(t, *calls, "expired side tx should not be applied")
+}
@@ -0,0 +1,288 @@
+package sidechannel
+
+import (
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/auth"
+	"github.com/maticnetwork/heimdall/sidechannel/types"
+	"github.com/maticnetwork/heimdall/staking"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+var (
+	PendingSideTxKey = []byte{0x11} // prefix key to store side txs waiting for votes
+	SideTxVoteKey    = []byte{0x12} // prefix key to store votes on pending side txs
+)
+
+// Keeper stores all related data
+type Keeper struct {
+	cdc *codec.Codec
+	// staking keeper
+	sk staking.Keeper
+	// side tx router
+	router types.Router
+	// The (unexposed) keys used to access the stores from the Context.
+	storeKey sdk.StoreKey
+	// codespace
+	codespace sdk.CodespaceType
+	// param space
+	paramSpace params.Subspace
+}
+
+// NewKeeper create new keeper
+func NewKeeper(
+	cdc *codec.Codec,
+	storeKey sdk.StoreKey,
+	paramSpace params.Subspace,
+	codespace sdk.CodespaceType,
+	stakingKeeper staking.Keeper,
+	router types.Router,
+) Keeper {
+	// It is vital to seal the side tx router here as to not allow
+	// further handlers to be registered after keeper is created
+	router.Seal()
+
+	keeper := Keeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
+		codespace:  codespace,
+		sk:         stakingKeeper,
+		router:     router,
+	}
+	return keeper
+}
+
+// Codespace returns the codespace
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// Logger returns a module-specific logger
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+// Router returns side tx router
+func (k Keeper) Router() types.Router {
+	return k.router
+}
+
+// GetPendingSideTxKey returns key for pending side tx
+func GetPendingSideTxKey(txHash hmTypes.HeimdallHash) []byte {
+	return append(PendingSideTxKey, txHash.Bytes()...)
+}
+
+// GetSideTxVotesKey returns prefix key for votes on side tx
+func GetSideTxVotesKey(txHash hmTypes.HeimdallHash) []byte {
+	return append(SideTxVoteKey, txHash.Bytes()...)
+}
+
+// GetSideTxVoteKey returns key for vote of validator on side tx
+func GetSideTxVoteKey(txHash hmTypes.HeimdallHash, validator hmTypes.HeimdallAddress) []byte {
+	return append(GetSideTxVotesKey(txHash), validator.Bytes()...)
+}
+
+// SetPendingSideTx stores side tx to wait for votes
+func (k *Keeper) SetPendingSideTx(ctx sdk.Context, tx types.PendingSideTx) error {
+	store := ctx.KVStore(k.storeKey)
+	key := GetPendingSideTxKey(tx.TxHash)
+
+	if store.Has(key) {
+		return errors.New("Side tx already exists")
+	}
+
+	out, err := k.cdc.MarshalBinaryBare(tx)
+	if err != nil {
+		k.Logger(ctx).Error("Error marshalling pending side tx", "error", err)
+		return err
+	}
+
+	store.Set(key, out)
+	return nil
+}
+
+// HasPendingSideTx checks if side tx is waiting for votes
+func (k *Keeper) HasPendingSideTx(ctx sdk.Context, txHash hmTypes.HeimdallHash) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetPendingSideTxKey(txHash))
+}
+
+// GetPendingSideTx returns side tx waiting for votes
+func (k *Keeper) GetPendingSideTx(ctx sdk.Context, txHash hmTypes.HeimdallHash) (*types.PendingSideTx, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := GetPendingSideTxKey(txHash)
+
+	if !store.Has(key) {
+		return nil, errors.New("No pending side tx found")
+	}
+
+	var tx types.PendingSideTx
+	if err := k.cdc.UnmarshalBinaryBare(store.Get(key), &tx); err != nil {
+		return nil, err
+	}
+
+	return &tx, nil
+}
+
+// DeletePendingSideTx removes side tx and all votes on it
+func (k *Keeper) DeletePendingSideTx(ctx sdk.Context, txHash hmTypes.HeimdallHash) {
+	store := ctx.KVStore(k.storeKey)
+
+	// collect vote keys first, store can't be modified while iterating
+	var voteKeys [][]byte
+	iterator := sdk.KVStorePrefixIterator(store, GetSideTxVotesKey(txHash))
+	for ; iterator.Valid(); iterator.Next() {
+		voteKeys = append(voteKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range voteKeys {
+		store.Delete(key)
+	}
+
+	store.Delete(GetPendingSideTxKey(txHash))
+}
+
+// GetPendingSideTxs returns all side txs waiting for votes
+func (k *Keeper) GetPendingSideTxs(ctx sdk.Context) (txs []types.PendingSideTx) {
+	k.IteratePendingSideTxsAndApplyFn(ctx, func(tx types.PendingSideTx) error {
+		txs = append(txs, tx)
+		return nil
+	})
+	return
+}
+
+// IteratePendingSideTxsAndApplyFn iterates pending side txs and apply the given function
+func (k *Keeper) IteratePendingSideTxsAndApplyFn(ctx sdk.Context, f func(tx types.PendingSideTx) error) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, PendingSideTxKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var tx types.PendingSideTx
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &tx); err != nil {
+			k.Logger(ctx).Error("Error unmarshalling pending side tx", "error", err)
+			continue
+		}
+
+		if err := f(tx); err != nil {
+			return
+		}
+	}
+}
+
+// SetSideTxVote stores vote of validator on side tx
+func (k *Keeper) SetSideTxVote(ctx sdk.Context, vote types.SideTxVote) error {
+	store := ctx.KVStore(k.storeKey)
+
+	out, err := k.cdc.MarshalBinaryBare(vote)
+	if err != nil {
+		k.Logger(ctx).Error("Error marshalling side tx vote", "error", err)
+		return err
+	}
+
+	store.Set(GetSideTxVoteKey(vote.TxHash, vote.Validator), out)
+	return nil
+}
+
+// HasSideTxVote checks if validator already voted on side tx
+func (k *Keeper) HasSideTxVote(ctx sdk.Context, txHash hmTypes.HeimdallHash, validator hmTypes.HeimdallAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetSideTxVoteKey(txHash, validator))
+}
+
+// GetSideTxVotes returns all votes on side tx
+func (k *Keeper) GetSideTxVotes(ctx sdk.Context, txHash hmTypes.HeimdallHash) (votes []types.SideTxVote) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, GetSideTxVotesKey(txHash))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.SideTxVote
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &vote); err != nil {
+			k.Logger(ctx).Error("Error unmarshalling side tx vote", "error", err)
+			continue
+		}
+		votes = append(votes, vote)
+	}
+	return
+}
+
+// TallySideTxVotes sums voting power of votes on side tx.
+// Voting power is taken from current validator set, votes from validators who left the set are ignored.
+func (k *Keeper) TallySideTxVotes(ctx sdk.Context, txHash hmTypes.HeimdallHash) (tally types.SideTxTally) {
+	validatorSet := k.sk.GetValidatorSet(ctx)
+	tally.Total = validatorSet.TotalVotingPower()
+
+	for _, vote := range k.GetSideTxVotes(ctx, txHash) {
+		_, validator := validatorSet.GetByAddress(vote.Validator.Bytes())
+		if validator == nil {
+			continue
+		}
+
+		switch vote.Result {
+		case hmTypes.SideTxResultYes:
+			tally.Yes += validator.VotingPower
+		case hmTypes.SideTxResultNo:
+			tally.No += validator.VotingPower
+		}
+	}
+
+	return
+}
+
+// ApplySideTx runs post tx handler of side tx msg with final result and removes side tx.
+// Post tx handler failure doesn't revert the vote, side tx is dropped either way.
+func (k *Keeper) ApplySideTx(ctx sdk.Context, tx types.PendingSideTx, result hmTypes.SideTxResultType) sdk.Events {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyTxHash, tx.TxHash.String()),
+		sdk.NewAttribute(types.AttributeKeyMsgType, tx.Msg.Type()),
+		sdk.NewAttribute(types.AttributeKeyResult, result.String()),
+	}
+
+	var events sdk.Events
+	if k.router.HasRoute(tx.Msg.Route()) {
+		// run post tx handler on cached context, so failed handler leaves no changes
+		cacheCtx, writeCache := ctx.CacheContext()
+		// fee of side tx, not of current vote tx, is refundable by post tx handler
+		cacheCtx = auth.WithCollectedFee(cacheCtx, tx.Payer, tx.Fee)
+		postResult := k.router.GetPostTxHandler(tx.Msg.Route())(cacheCtx, tx.Msg, result)
+		if postResult.IsOK() {
+			writeCache()
+			events = postResult.Events
+		} else {
+			k.Logger(ctx).Error("Post tx handler failed", "txHash", tx.TxHash, "result", result, "log", postResult.Log)
+			attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyPostError, postResult.Log))
+		}
+	} else {
+		k.Logger(ctx).Error("No post tx handler found", "txHash", tx.TxHash, "route", tx.Msg.Route())
+	}
+
+	k.DeletePendingSideTx(ctx, tx.TxHash)
+	k.Logger(ctx).Info("Side tx voting finished", "txHash", tx.TxHash, "result", result)
+
+	return append(events, sdk.NewEvent(types.EventTypeSideTxResult, attributes...))
+}
+
+// -----------------------------------------------------------------------------
+// Params
+
+// SetParams sets the sidechannel module's parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetParams gets the sidechannel module's parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return
+}
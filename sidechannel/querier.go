@@ -0,0 +1,137 @@
+package sidechannel
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/sidechannel/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// NewQuerier creates a querier for sidechannel REST endpoints
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryParams:
+			return handleQueryParams(ctx, req, keeper)
+		case types.QueryPendingSideTxs:
+			return handleQueryPendingSideTxs(ctx, req, keeper)
+		case types.QueryPendingSideTx:
+			return handleQueryPendingSideTx(ctx, req, keeper)
+		case types.QuerySideTxVotes:
+			return handleQuerySideTxVotes(ctx, req, keeper)
+		case types.QuerySideTxResult:
+			return handleQuerySideTxResult(ctx, req, keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown sidechannel query endpoint")
+		}
+	}
+}
+
+func handleQueryParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := json.Marshal(keeper.GetParams(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryPendingSideTxs(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	txs := keeper.GetPendingSideTxs(ctx)
+	if txs == nil {
+		txs = make([]types.PendingSideTx, 0)
+	}
+
+	// msgs are interfaces, amino json keeps their types
+	bz, err := keeper.cdc.MarshalJSON(txs)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryPendingSideTx(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	tx, sdkErr := getPendingSideTx(ctx, req, keeper)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	bz, err := keeper.cdc.MarshalJSON(tx)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQuerySideTxVotes(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QuerySideTxParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	votes := keeper.GetSideTxVotes(ctx, params.TxHash)
+	if votes == nil {
+		votes = make([]types.SideTxVote, 0)
+	}
+
+	bz, err := json.Marshal(types.SideTxVotes{
+		Votes: votes,
+		Tally: keeper.TallySideTxVotes(ctx, params.TxHash),
+	})
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+// handleQuerySideTxResult runs side tx handler against external chains of queried node.
+// Result is the vote node's validator should cast on the side tx.
+func handleQuerySideTxResult(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	tx, sdkErr := getPendingSideTx(ctx, req, keeper)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	result := runSideTxHandler(ctx, keeper, *tx)
+
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func getPendingSideTx(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (*types.PendingSideTx, sdk.Error) {
+	var params types.QuerySideTxParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	tx, err := keeper.GetPendingSideTx(ctx, params.TxHash)
+	if err != nil || tx == nil {
+		return nil, types.ErrNoPendingSideTx(keeper.Codespace())
+	}
+
+	return tx, nil
+}
+
+// runSideTxHandler runs side tx handler of side tx msg, panics are treated as skip vote
+func runSideTxHandler(ctx sdk.Context, keeper Keeper, tx types.PendingSideTx) (result hmTypes.SideTxResultType) {
+	if !keeper.router.HasRoute(tx.Msg.Route()) {
+		return hmTypes.SideTxResultSkip
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			keeper.Logger(ctx).Error("Side tx handler panicked", "txHash", tx.TxHash, "error", r)
+			result = hmTypes.SideTxResultSkip
+		}
+	}()
+
+	// side tx handler must never change state
+	cacheCtx, _ := ctx.CacheContext()
+	return keeper.router.GetSideTxHandler(tx.Msg.Route())(cacheCtx, tx.Msg)
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Sidechannel errors reserve 5500 ~ 5599.
+const (
+	CodeNoPendingSideTx     sdk.CodeType = 5500
+	CodeInvalidSideTxResult              = 5501
+	CodeNotValidator                     = 5502
+	CodeDuplicateSideTxVote              = 5503
+	CodeSideTxAlreadyExists              = 5504
+	CodeEarlySideTxVote                  = 5505
+)
+
+// ErrNoPendingSideTx represents missing pending side tx error
+func ErrNoPendingSideTx(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNoPendingSideTx, "No pending side tx found")
+}
+
+// ErrInvalidSideTxResult represents invalid vote error
+func ErrInvalidSideTxResult(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidSideTxResult, "Invalid side tx result")
+}
+
+// ErrNotValidator represents vote from non-validator error
+func ErrNotValidator(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNotValidator, "Voter is not in current validator set")
+}
+
+// ErrDuplicateSideTxVote represents duplicate vote error
+func ErrDuplicateSideTxVote(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeDuplicateSideTxVote, "Validator already voted on side tx")
+}
+
+// ErrSideTxAlreadyExists represents duplicate side tx error
+func ErrSideTxAlreadyExists(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeSideTxAlreadyExists, "Side tx already pending, only one side tx msg allowed per tx")
+}
+
+// ErrEarlySideTxVote represents vote in same block as side tx error
+func ErrEarlySideTxVote(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeEarlySideTxVote, "Side tx can only be voted in later blocks")
+}
@@ -0,0 +1,18 @@
+package types
+
+// sidechannel module event types
+var (
+	EventTypeSideTx        = "side-tx"
+	EventTypeSideTxVote    = "side-tx-vote"
+	EventTypeSideTxResult  = "side-tx-result"
+	EventTypeSideTxExpired = "side-tx-expired"
+
+	AttributeKeyTxHash    = "tx-hash"
+	AttributeKeyMsgType   = "msg-type"
+	AttributeKeyValidator = "validator"
+	AttributeKeyResult    = "result"
+	AttributeKeyPostError = "post-error"
+	AttributeKeyHeight    = "height"
+
+	AttributeValueCategory = ModuleName
+)
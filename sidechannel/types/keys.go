@@ -0,0 +1,25 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "sidechannel"
+
+	// StoreKey is the store key string for sidechannel
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for sidechannel
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for sidechannel
+	QuerierRoute = ModuleName
+
+	// DefaultParamspace default name for parameter store
+	DefaultParamspace = ModuleName
+
+	// DefaultCodespace default code space
+	DefaultCodespace sdk.CodespaceType = ModuleName
+)
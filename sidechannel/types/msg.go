@@ -0,0 +1,76 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+//
+// Side tx vote
+//
+
+var _ sdk.Msg = &MsgSideTxVote{}
+
+// MsgSideTxVote represents vote of a validator on pending side tx
+type MsgSideTxVote struct {
+	Validator hmTypes.HeimdallAddress  `json:"validator"`
+	TxHash    hmTypes.HeimdallHash     `json:"tx_hash"`
+	Result    hmTypes.SideTxResultType `json:"result"`
+}
+
+// NewMsgSideTxVote creates new side tx vote
+func NewMsgSideTxVote(
+	validator hmTypes.HeimdallAddress,
+	txHash hmTypes.HeimdallHash,
+	result hmTypes.SideTxResultType,
+) MsgSideTxVote {
+	return MsgSideTxVote{
+		Validator: validator,
+		TxHash:    txHash,
+		Result:    result,
+	}
+}
+
+// Type returns message type
+func (msg MsgSideTxVote) Type() string {
+	return "side-tx-vote"
+}
+
+// Route returns route for message
+func (msg MsgSideTxVote) Route() string {
+	return RouterKey
+}
+
+// GetSigners returns address of the signer
+func (msg MsgSideTxVote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.Validator)}
+}
+
+// GetSignBytes returns sign bytes for side tx vote
+func (msg MsgSideTxVote) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic validates the message and returns error
+func (msg MsgSideTxVote) ValidateBasic() sdk.Error {
+	if msg.Validator.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator %v", msg.Validator.String())
+	}
+
+	if msg.TxHash.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid tx hash %v", msg.TxHash.String())
+	}
+
+	// validators which can't verify side tx don't vote and retry later
+	if !msg.Result.IsValid() || msg.Result == hmTypes.SideTxResultSkip {
+		return ErrInvalidSideTxResult(hmCommon.DefaultCodespace)
+	}
+
+	return nil
+}
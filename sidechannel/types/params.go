@@ -0,0 +1,77 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+)
+
+// Default parameter values
+const (
+	DefaultVotingPeriod int64 = 100 // number of blocks side tx waits for votes before it gets dropped
+)
+
+// Parameter keys
+var (
+	KeyVotingPeriod = []byte("VotingPeriod")
+)
+
+var _ subspace.ParamSet = &Params{}
+
+// Params defines the parameters for the sidechannel module.
+type Params struct {
+	VotingPeriod int64 `json:"voting_period" yaml:"voting_period"`
+}
+
+// NewParams creates a new Params object
+func NewParams(votingPeriod int64) Params {
+	return Params{
+		VotingPeriod: votingPeriod,
+	}
+}
+
+// ParamKeyTable for sidechannel module
+func ParamKeyTable() subspace.KeyTable {
+	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of sidechannel module's parameters.
+func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
+	return subspace.ParamSetPairs{
+		{Key: KeyVotingPeriod, Value: &p.VotingPeriod},
+	}
+}
+
+// Equal returns a boolean determining if two Params types are identical.
+func (p Params) Equal(p2 Params) bool {
+	bz1 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p)
+	bz2 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p2)
+	return bytes.Equal(bz1, bz2)
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return Params{
+		VotingPeriod: DefaultVotingPeriod,
+	}
+}
+
+// String implements the stringer interface.
+func (p Params) String() string {
+	var sb strings.Builder
+	sb.WriteString("Params: \n")
+	sb.WriteString(fmt.Sprintf("VotingPeriod: %d\n", p.VotingPeriod))
+	return sb.String()
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if p.VotingPeriod <= 0 {
+		return fmt.Errorf("invalid voting period: %d", p.VotingPeriod)
+	}
+
+	return nil
+}
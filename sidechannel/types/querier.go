@@ -0,0 +1,30 @@
+package types
+
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// query endpoints supported by the sidechannel Querier
+const (
+	QueryParams         = "params"
+	QueryPendingSideTxs = "pending-txs"
+	QueryPendingSideTx  = "pending-tx"
+	QuerySideTxVotes    = "votes"
+	QuerySideTxResult   = "side-tx-result"
+)
+
+// QuerySideTxParams defines the params for querying side tx.
+type QuerySideTxParams struct {
+	TxHash hmTypes.HeimdallHash
+}
+
+// NewQuerySideTxParams creates a new instance of QuerySideTxParams.
+func NewQuerySideTxParams(txHash hmTypes.HeimdallHash) QuerySideTxParams {
+	return QuerySideTxParams{TxHash: txHash}
+}
+
+// SideTxVotes represents votes and their tally on pending side tx
+type SideTxVotes struct {
+	Votes []SideTxVote `json:"votes" yaml:"votes"`
+	Tally SideTxTally  `json:"tally" yaml:"tally"`
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+var isAlphaNumeric = regexp.MustCompile(`^[a-zA-Z0-9]+$`).MatchString
+
+// Router routes side tx msgs to side and post tx handlers of their modules
+type Router interface {
+	AddRoute(r string, sideHandler hmTypes.SideTxHandler, postHandler hmTypes.PostTxHandler) (rtr Router)
+	HasRoute(r string) bool
+	GetSideTxHandler(path string) hmTypes.SideTxHandler
+	GetPostTxHandler(path string) hmTypes.PostTxHandler
+	Seal()
+}
+
+type sideTxHandlers struct {
+	sideHandler hmTypes.SideTxHandler
+	postHandler hmTypes.PostTxHandler
+}
+
+type router struct {
+	routes map[string]sideTxHandlers
+	sealed bool
+}
+
+var _ Router = (*router)(nil)
+
+// NewRouter creates a new Router interface instance
+func NewRouter() Router {
+	return &router{
+		routes: make(map[string]sideTxHandlers),
+	}
+}
+
+// Seal seals the router which prohibits any subsequent route handlers to be
+// added. Seal will panic if called more than once.
+func (rtr *router) Seal() {
+	if rtr.sealed {
+		panic("router already sealed")
+	}
+	rtr.sealed = true
+}
+
+// AddRoute adds side and post tx handlers for a given path. It returns the Router
+// so AddRoute calls can be linked. It will panic if the router is sealed.
+func (rtr *router) AddRoute(path string, sideHandler hmTypes.SideTxHandler, postHandler hmTypes.PostTxHandler) Router {
+	if rtr.sealed {
+		panic("router sealed; cannot add route handler")
+	}
+
+	if !isAlphaNumeric(path) {
+		panic("route expressions can only contain alphanumeric characters")
+	}
+
+	if rtr.HasRoute(path) {
+		panic(fmt.Sprintf("route %s has already been initialized", path))
+	}
+
+	rtr.routes[path] = sideTxHandlers{
+		sideHandler: sideHandler,
+		postHandler: postHandler,
+	}
+	return rtr
+}
+
+// HasRoute returns true if the router has handlers for a given path
+func (rtr *router) HasRoute(path string) bool {
+	_, ok := rtr.routes[path]
+	return ok
+}
+
+// GetSideTxHandler returns side tx handler for a given path
+func (rtr *router) GetSideTxHandler(path string) hmTypes.SideTxHandler {
+	if !rtr.HasRoute(path) {
+		panic(fmt.Sprintf("route \"%s\" does not exist", path))
+	}
+
+	return rtr.routes[path].sideHandler
+}
+
+// GetPostTxHandler returns post tx handler for a given path
+func (rtr *router) GetPostTxHandler(path string) hmTypes.PostTxHandler {
+	if !rtr.HasRoute(path) {
+		panic(fmt.Sprintf("route \"%s\" does not exist", path))
+	}
+
+	return rtr.routes[path].postHandler
+}
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// PendingSideTx represents side tx msg waiting for validator votes
+type PendingSideTx struct {
+	TxHash hmTypes.HeimdallHash    `json:"tx_hash" yaml:"tx_hash"`
+	Height int64                   `json:"height" yaml:"height"`
+	Msg    sdk.Msg                 `json:"msg" yaml:"msg"`
+	Payer  hmTypes.HeimdallAddress `json:"payer" yaml:"payer"`
+	Fee    hmTypes.Coins           `json:"fee" yaml:"fee"`
+}
+
+// NewPendingSideTx creates new pending side tx, fee paid by payer is refunded by post tx handler which applies it
+func NewPendingSideTx(txHash hmTypes.HeimdallHash, height int64, msg sdk.Msg, payer hmTypes.HeimdallAddress, fee hmTypes.Coins) PendingSideTx {
+	return PendingSideTx{
+		TxHash: txHash,
+		Height: height,
+		Msg:    msg,
+		Payer:  payer,
+		Fee:    fee,
+	}
+}
+
+// String returns human readable pending side tx
+func (tx PendingSideTx) String() string {
+	return fmt.Sprintf("PendingSideTx{%v height:%v type:%v/%v}",
+		tx.TxHash.String(),
+		tx.Height,
+		tx.Msg.Route(),
+		tx.Msg.Type())
+}
+
+// SideTxVote represents vote of a validator on side tx
+type SideTxVote struct {
+	TxHash    hmTypes.HeimdallHash     `json:"tx_hash" yaml:"tx_hash"`
+	Validator hmTypes.HeimdallAddress  `json:"validator" yaml:"validator"`
+	Result    hmTypes.SideTxResultType `json:"result" yaml:"result"`
+	Height    int64                    `json:"height" yaml:"height"`
+}
+
+// NewSideTxVote creates new side tx vote
+func NewSideTxVote(
+	txHash hmTypes.HeimdallHash,
+	validator hmTypes.HeimdallAddress,
+	result hmTypes.SideTxResultType,
+	height int64,
+) SideTxVote {
+	return SideTxVote{
+		TxHash:    txHash,
+		Validator: validator,
+		Result:    result,
+		Height:    height,
+	}
+}
+
+// String returns human readable vote
+func (v SideTxVote) String() string {
+	return fmt.Sprintf("SideTxVote{%v validator:%v result:%v height:%v}",
+		v.TxHash.String(),
+		v.Validator.String(),
+		v.Result.String(),
+		v.Height)
+}
+
+// SideTxTally represents voting power of votes on side tx
+type SideTxTally struct {
+	Yes   int64 `json:"yes" yaml:"yes"`
+	No    int64 `json:"no" yaml:"no"`
+	Total int64 `json:"total" yaml:"total"`
+}
+
+// Result returns final result of voting, or skip if voting is not finished yet.
+// Side tx passes once 2/3+ of voting power votes yes and fails once it can't reach it anymore.
+func (t SideTxTally) Result() hmTypes.SideTxResultType {
+	if t.Total <= 0 {
+		return hmTypes.SideTxResultSkip
+	}
+
+	if t.Yes*3 > t.Total*2 {
+		return hmTypes.SideTxResultYes
+	}
+
+	if (t.Total-t.No)*3 <= t.Total*2 {
+		return hmTypes.SideTxResultNo
+	}
+
+	return hmTypes.SideTxResultSkip
+}
@@ -0,0 +1,90 @@
+package slashing
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/maticnetwork/heimdall/slashing/types"
+)
+
+// BeginBlocker tracks liveness of validators which signed previous block,
+// and slashes and jails validators reported by tendermint for double signing
+func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
+	for _, vote := range req.LastCommitInfo.GetVotes() {
+		info, jailed, err := k.HandleValidatorSignature(ctx, vote.Validator.Address, vote.Validator.Power, vote.SignedLastBlock)
+		if err != nil {
+			k.Logger(ctx).Error("Unable to handle validator signature",
+				"error", err,
+				"validator", sdk.ConsAddress(vote.Validator.Address))
+			continue
+		}
+
+		if !vote.SignedLastBlock {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeLiveness,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+					sdk.NewAttribute(types.AttributeKeyValidatorID, info.ValidatorID.String()),
+					sdk.NewAttribute(types.AttributeKeyMissedBlocks, strconv.FormatInt(info.MissedBlocksCounter, 10)),
+					sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+				),
+			)
+		}
+
+		if jailed {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeJail,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+					sdk.NewAttribute(types.AttributeKeyValidatorID, info.ValidatorID.String()),
+					sdk.NewAttribute(types.AttributeKeyPower, strconv.FormatInt(vote.Validator.Power, 10)),
+					sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueDowntime),
+				),
+			)
+		}
+	}
+
+	for _, evidence := range req.ByzantineValidators {
+		if evidence.Type != tmtypes.ABCIEvidenceTypeDuplicateVote {
+			k.Logger(ctx).Error("Ignored unknown evidence type", "type", evidence.Type)
+			continue
+		}
+
+		result, slashed, err := k.HandleDoubleSign(ctx, evidence.Validator.Address, evidence.Height, evidence.Validator.Power)
+		if err != nil {
+			k.Logger(ctx).Error("Unable to handle double sign evidence",
+				"error", err,
+				"validator", sdk.ConsAddress(evidence.Validator.Address),
+				"infractionHeight", evidence.Height)
+			continue
+		}
+
+		if !slashed {
+			k.Logger(ctx).Info("Recorded double sign evidence of jailed validator",
+				"validatorID", result.ValidatorID,
+				"infractionHeight", result.InfractionHeight)
+			continue
+		}
+
+		k.Logger(ctx).Info("Slashed validator for double signing",
+			"validatorID", result.ValidatorID,
+			"infractionHeight", result.InfractionHeight,
+			"amount", result.SlashedAmount)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSlash,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyValidatorID, result.ValidatorID.String()),
+				sdk.NewAttribute(types.AttributeKeySigner, result.Signer.String()),
+				sdk.NewAttribute(types.AttributeKeyPower, strconv.FormatInt(result.Power, 10)),
+				sdk.NewAttribute(types.AttributeKeyAmount, result.SlashedAmount),
+				sdk.NewAttribute(types.AttributeKeyReason, result.Type),
+				sdk.NewAttribute(types.AttributeKeyInfractionHeight, strconv.FormatInt(result.InfractionHeight, 10)),
+			),
+		)
+	}
+}
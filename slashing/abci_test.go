@@ -0,0 +1,256 @@
+package slashing_test
+
+import (
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/slashing"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
+	"github.com/maticnetwork/heimdall/staking"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	cmn "github.com/maticnetwork/heimdall/test"
+	"github.com/maticnetwork/heimdall/types"
+)
+
+// zeroModuleCommunicator always returns zero ack count
+type zeroModuleCommunicator struct{}
+
+func (zeroModuleCommunicator) GetACKCount(ctx sdk.Context) uint64 { return 0 }
+
+func (zeroModuleCommunicator) GetConfirmationBlocks(ctx sdk.Context) uint64 { return 0 }
+
+func (zeroModuleCommunicator) RefundRelayerFee(ctx sdk.Context) (types.HeimdallAddress, sdk.Error) {
+	return types.HeimdallAddress{}, nil
+}
+
+func createTestInput(t *testing.T) (sdk.Context, staking.Keeper, slashing.Keeper) {
+	helper.InitHeimdallConfig(os.ExpandEnv("$HOME/.heimdalld"))
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+
+	keyStaking := sdk.NewKVStoreKey(stakingTypes.StoreKey)
+	keySlashing := sdk.NewKVStoreKey(slashingTypes.StoreKey)
+	keyParams := sdk.NewKVStoreKey(subspace.StoreKey)
+	tKeyParams := sdk.NewTransientStoreKey(subspace.TStoreKey)
+
+	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keySlashing, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tKeyParams, sdk.StoreTypeTransient, db)
+	require.Nil(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "foochainid", Height: 10}, false, log.NewNopLogger())
+
+	cdc := codec.New()
+	codec.RegisterCrypto(cdc)
+	sdk.RegisterCodec(cdc)
+	slashingTypes.RegisterCodec(cdc)
+	cdc.Seal()
+
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tKeyParams, common.DefaultCodespace)
+	stakingKeeper := staking.NewKeeper(
+		cdc,
+		keyStaking,
+		paramsKeeper.Subspace(stakingTypes.DefaultParamspace),
+		common.DefaultCodespace,
+		zeroModuleCommunicator{},
+	)
+
+	slashingKeeper := slashing.NewKeeper(
+		cdc,
+		keySlashing,
+		paramsKeeper.Subspace(slashingTypes.DefaultParamspace),
+		slashingTypes.DefaultCodespace,
+		&stakingKeeper,
+	)
+
+	// slashing subscribes to staking hooks, like in app
+	stakingKeeper.SetHooks(stakingTypes.NewMultiStakingHooks(slashingKeeper.Hooks()))
+	slashingKeeper.SetParams(ctx, slashingTypes.DefaultParams())
+
+	return ctx, stakingKeeper, slashingKeeper
+}
+
+func doubleSignRequest(validator types.Validator, infractionHeight int64) abci.RequestBeginBlock {
+	return abci.RequestBeginBlock{
+		ByzantineValidators: []abci.Evidence{
+			{
+				Type:      tmtypes.ABCIEvidenceTypeDuplicateVote,
+				Validator: abci.Validator{Address: validator.Signer.Bytes(), Power: validator.VotingPower},
+				Height:    infractionHeight,
+			},
+		},
+	}
+}
+
+func TestBeginBlockerDoubleSign(t *testing.T) {
+	ctx, sk, keeper := createTestInput(t)
+
+	validators := cmn.GenRandomVal(2, 0, 10, 10, false, 1)
+	for _, validator := range validators {
+		require.Nil(t, sk.AddValidator(ctx, validator))
+	}
+	offender := validators[0]
+	require.Nil(t, sk.AddDividendAccount(ctx, types.NewDividendAccount(types.DividendAccountID(offender.ID), "100", "0")))
+
+	currentSet := types.NewValidatorSet(nil)
+	require.Nil(t, currentSet.UpdateWithChangeSet(helper.GetUpdatedValidators(currentSet, sk.GetAllValidators(ctx), 0, sk.GetMaxValidators(ctx), sk.MinSelfStakeFilter(ctx))))
+	require.Nil(t, sk.UpdateValidatorSetInStore(ctx, *currentSet))
+
+	accountRoot, err := checkpointTypes.GetAccountRootHash(sk.GetAllDividendAccounts(ctx))
+	require.Nil(t, err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	slashing.BeginBlocker(ctx, doubleSignRequest(offender, 8), keeper)
+
+	// offender is jailed and dropped from next validator set
+	jailed, err := sk.GetValidatorInfo(ctx, offender.Signer.Bytes())
+	require.Nil(t, err)
+	require.True(t, jailed.Jailed)
+	require.Len(t, sk.GetCurrentValidators(ctx), 1)
+
+	validatorSet := sk.GetValidatorSet(ctx)
+	updates := helper.GetUpdatedValidators(&validatorSet, sk.GetAllValidators(ctx), 0, sk.GetMaxValidators(ctx), sk.MinSelfStakeFilter(ctx))
+	require.Len(t, updates, 1)
+	require.Equal(t, offender.Signer, updates[0].Signer)
+	require.Equal(t, int64(0), updates[0].VotingPower)
+
+	// slash fraction of stake is added to dividend account and changes account root
+	expectedAmount := big.NewInt(0).Mul(big.NewInt(offender.VotingPower), big.NewInt(1000000000000000000))
+	expectedAmount = expectedAmount.Div(expectedAmount, big.NewInt(20))
+	dividendAccount, err := sk.GetDividendAccountByID(ctx, types.DividendAccountID(offender.ID))
+	require.Nil(t, err)
+	require.Equal(t, expectedAmount.String(), dividendAccount.SlashedAmount)
+	require.Equal(t, "100", dividendAccount.FeeAmount)
+
+	newAccountRoot, err := checkpointTypes.GetAccountRootHash(sk.GetAllDividendAccounts(ctx))
+	require.Nil(t, err)
+	require.NotEqual(t, accountRoot, newAccountRoot)
+
+	// evidence is recorded and event emitted
+	evidences := keeper.GetValidatorEvidences(ctx, offender.ID)
+	require.Len(t, evidences, 1)
+	require.Equal(t, int64(8), evidences[0].InfractionHeight)
+	require.Equal(t, expectedAmount.String(), evidences[0].SlashedAmount)
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, slashingTypes.EventTypeSlash, ctx.EventManager().Events()[0].Type)
+
+	// same evidence is handled once
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	slashing.BeginBlocker(ctx, doubleSignRequest(offender, 8), keeper)
+	require.Empty(t, ctx.EventManager().Events())
+
+	// jailed validator isn't slashed again for another infraction
+	slashing.BeginBlocker(ctx, doubleSignRequest(offender, 9), keeper)
+	require.Empty(t, ctx.EventManager().Events())
+	require.Len(t, keeper.GetValidatorEvidences(ctx, offender.ID), 2)
+	dividendAccount, _ = sk.GetDividendAccountByID(ctx, types.DividendAccountID(offender.ID))
+	require.Equal(t, expectedAmount.String(), dividendAccount.SlashedAmount)
+
+	// unknown validator is ignored
+	slashing.BeginBlocker(ctx, doubleSignRequest(cmn.GenRandomVal(1, 0, 10, 10, false, 5)[0], 8), keeper)
+	require.Len(t, keeper.GetEvidences(ctx), 2)
+}
+
+func livenessRequest(validators []types.Validator, missing types.Validator) abci.RequestBeginBlock {
+	var votes []abci.VoteInfo
+	for _, validator := range validators {
+		votes = append(votes, abci.VoteInfo{
+			Validator:       abci.Validator{Address: validator.Signer.Bytes(), Power: validator.VotingPower},
+			SignedLastBlock: validator.ID != missing.ID,
+		})
+	}
+	return abci.RequestBeginBlock{LastCommitInfo: abci.LastCommitInfo{Votes: votes}}
+}
+
+func TestBeginBlockerLiveness(t *testing.T) {
+	ctx, sk, keeper := createTestInput(t)
+
+	params := keeper.GetParams(ctx)
+	params.SignedBlocksWindow = 10
+	params.MinSignedPerWindow = types.NewDecWithPrec(5, 1)
+	keeper.SetParams(ctx, params)
+
+	validators := cmn.GenRandomVal(2, 0, 10, 10, false, 1)
+	for _, validator := range validators {
+		require.Nil(t, sk.AddValidator(ctx, validator))
+	}
+	offender := validators[0]
+
+	// offender misses every block, but downtime jail is disabled
+	height := ctx.BlockHeight()
+	for ; height < 25; height++ {
+		ctx = ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		slashing.BeginBlocker(ctx, livenessRequest(validators, offender), keeper)
+	}
+
+	info, found := keeper.GetValidatorSigningInfo(ctx, offender.ID)
+	require.True(t, found)
+	require.Equal(t, int64(10), info.StartHeight)
+	require.Equal(t, int64(15), info.IndexOffset)
+	require.Equal(t, int64(10), info.MissedBlocksCounter, "missed blocks are counted in sliding window")
+	require.Equal(t, int64(24), info.LastMissedHeight)
+	require.Len(t, keeper.GetValidatorMissedBlocks(ctx, offender.ID), 10)
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, slashingTypes.EventTypeLiveness, ctx.EventManager().Events()[0].Type)
+
+	signer, found := keeper.GetValidatorSigningInfo(ctx, validators[1].ID)
+	require.True(t, found)
+	require.Equal(t, int64(0), signer.MissedBlocksCounter)
+	require.Equal(t, int64(0), signer.LastMissedHeight)
+
+	validator, err := sk.GetValidatorInfo(ctx, offender.Signer.Bytes())
+	require.Nil(t, err)
+	require.False(t, validator.Jailed)
+
+	// signed blocks replace missed blocks in window
+	ctx = ctx.WithBlockHeight(height)
+	slashing.BeginBlocker(ctx, livenessRequest(validators, types.Validator{}), keeper)
+	info, _ = keeper.GetValidatorSigningInfo(ctx, offender.ID)
+	require.Equal(t, int64(9), info.MissedBlocksCounter)
+	height++
+
+	// offender is jailed once downtime jail is enabled
+	params.DowntimeJailEnabled = true
+	keeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+	slashing.BeginBlocker(ctx, livenessRequest(validators, offender), keeper)
+
+	validator, err = sk.GetValidatorInfo(ctx, offender.Signer.Bytes())
+	require.Nil(t, err)
+	require.True(t, validator.Jailed)
+	require.Equal(t, slashingTypes.EventTypeJail, ctx.EventManager().Events()[1].Type)
+
+	info, _ = keeper.GetValidatorSigningInfo(ctx, offender.ID)
+	require.Equal(t, height, info.StartHeight)
+	require.Equal(t, int64(0), info.MissedBlocksCounter)
+	require.Equal(t, ctx.BlockTime().Add(params.JailDuration), info.JailedUntil)
+	require.Empty(t, keeper.GetValidatorMissedBlocks(ctx, offender.ID))
+
+	// offender unjails once jail duration is over
+	got := slashing.NewHandler(keeper)(ctx.WithBlockTime(info.JailedUntil), slashingTypes.NewMsgUnjail(offender.Signer, offender.ID.Uint64()))
+	require.True(t, got.IsOK(), "expected unjail to be ok, got %v", got)
+	validator, _ = sk.GetValidatorInfo(ctx, offender.Signer.Bytes())
+	require.False(t, validator.Jailed)
+
+	// signing infos are exported with genesis
+	genesis := slashing.ExportGenesis(ctx, keeper)
+	require.Len(t, genesis.SigningInfos, 2)
+	require.Nil(t, slashingTypes.ValidateGenesis(genesis))
+}
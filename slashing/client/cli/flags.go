@@ -0,0 +1,6 @@
+package cli
+
+const (
+	FlagValidatorID = "id"
+	FlagSigner      = "signer"
+)
@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	hmClient "github.com/maticnetwork/heimdall/client"
+	"github.com/maticnetwork/heimdall/slashing/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	// Group slashing queries under a subcommand
+	queryCmds := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the slashing module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       hmClient.ValidateCmd,
+	}
+
+	// slashing query command
+	queryCmds.AddCommand(
+		client.GetCommands(
+			GetQueryParams(cdc),
+			GetEvidences(cdc),
+			GetSigningInfo(cdc),
+		)...,
+	)
+
+	return queryCmds
+}
+
+// GetQueryParams implements the params query command.
+func GetQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "show the current slashing parameters information",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := cdc.UnmarshalJSON(bz, &params); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+// GetEvidences shows handled evidences, optionally of single validator
+func GetEvidences(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "evidences",
+		Args:  cobra.NoArgs,
+		Short: "show validator misbehaviour evidences and slashed amounts",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var queryParams []byte
+			if viper.IsSet(FlagValidatorID) {
+				bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryEvidencesParams(hmTypes.ValidatorID(viper.GetUint64(FlagValidatorID))))
+				if err != nil {
+					return err
+				}
+				queryParams = bz
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryEvidences), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagValidatorID, 0, "--id=<validator ID>")
+	return cmd
+}
+
+// GetSigningInfo shows liveness signing info of validators, optionally of single validator
+func GetSigningInfo(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-info",
+		Args:  cobra.NoArgs,
+		Short: "show validator liveness signing info and missed blocks",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySigningInfos)
+			var queryParams []byte
+			if viper.IsSet(FlagValidatorID) {
+				bz, err := cliCtx.Codec.MarshalJSON(types.NewQuerySigningInfoParams(hmTypes.ValidatorID(viper.GetUint64(FlagValidatorID))))
+				if err != nil {
+					return err
+				}
+				route = fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySigningInfo)
+				queryParams = bz
+			}
+
+			res, _, err := cliCtx.QueryWithData(route, queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagValidatorID, 0, "--id=<validator ID>")
+	return cmd
+}
@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	hmClient "github.com/maticnetwork/heimdall/client"
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/slashing/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Slashing transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       hmClient.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		client.PostCommands(
+			SendUnjailTx(cdc),
+		)...,
+	)
+	return txCmd
+}
+
+// SendUnjailTx sends unjail tx for validator jailed by slashing
+func SendUnjailTx(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unjail",
+		Short: "unjail validator jailed for double signing or downtime once jail duration is over",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// get signer
+			signer := hmTypes.HexToHeimdallAddress(viper.GetString(FlagSigner))
+			if signer.Empty() {
+				signer = helper.GetFromAddress(cliCtx)
+			}
+
+			validatorID := viper.GetUint64(FlagValidatorID)
+			if validatorID == 0 {
+				return fmt.Errorf("Validator ID cannot be zero")
+			}
+
+			msg := types.NewMsgUnjail(signer, validatorID)
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagSigner, "", "--signer=<signer-address>")
+	cmd.Flags().Uint64(FlagValidatorID, 0, "--id=<validator-id>")
+	cmd.MarkFlagRequired(FlagValidatorID)
+	return cmd
+}
@@ -0,0 +1,45 @@
+package slashing
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/slashing/types"
+)
+
+// InitGenesis sets slashing information for genesis.
+func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+
+	for _, evidence := range data.Evidences {
+		if err := keeper.SetEvidence(ctx, evidence); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, info := range data.SigningInfos {
+		if err := keeper.SetValidatorSigningInfo(ctx, info); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, validatorMissedBlocks := range data.MissedBlocks {
+		for _, missedBlock := range validatorMissedBlocks.MissedBlocks {
+			keeper.SetValidatorMissedBlock(ctx, validatorMissedBlocks.ValidatorID, missedBlock.Index, missedBlock.Missed)
+		}
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
+	signingInfos := keeper.GetValidatorSigningInfos(ctx)
+
+	var missedBlocks []types.ValidatorMissedBlocks
+	for _, info := range signingInfos {
+		missedBlocks = append(missedBlocks, types.ValidatorMissedBlocks{
+			ValidatorID:  info.ValidatorID,
+			MissedBlocks: keeper.GetValidatorMissedBlocks(ctx, info.ValidatorID),
+		})
+	}
+
+	return types.NewGenesisState(keeper.GetParams(ctx), keeper.GetEvidences(ctx), signingInfos, missedBlocks)
+}
@@ -0,0 +1,72 @@
+package slashing
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/slashing/types"
+)
+
+// NewHandler returns a handler for "slashing" type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgUnjail:
+			return handleMsgUnjail(ctx, msg, k)
+		default:
+			return sdk.ErrTxDecode("Invalid message in slashing module").Result()
+		}
+	}
+}
+
+// handleMsgUnjail unjails validator jailed by slashing once jail duration is over
+func handleMsgUnjail(ctx sdk.Context, msg types.MsgUnjail, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("Handling unjail", "validatorID", msg.ID)
+
+	validator, ok := k.sk.GetValidatorFromValID(ctx, msg.ID)
+	if !ok {
+		k.Logger(ctx).Error("Unable to fetch validator", "validatorID", msg.ID)
+		return hmCommon.ErrNoValidator(k.Codespace()).Result()
+	}
+
+	if !bytes.Equal(validator.Signer.Bytes(), msg.From.Bytes()) {
+		k.Logger(ctx).Error("Unjail is not signed by validator signer", "from", msg.From, "signer", validator.Signer)
+		return hmCommon.ErrValSignerMismatch(k.Codespace()).Result()
+	}
+
+	if !validator.Jailed {
+		return hmCommon.ErrValidatorNotJailed(k.Codespace()).Result()
+	}
+
+	// validator jailed by staking contract is unjailed by mainchain unjailed event
+	if validator.JailExitEpoch != 0 {
+		return types.ErrJailedOnMainchain(k.Codespace()).Result()
+	}
+
+	if info, found := k.GetValidatorSigningInfo(ctx, validator.ID); found && ctx.BlockTime().Before(info.JailedUntil) {
+		k.Logger(ctx).Error("Jail duration is not over", "validatorID", validator.ID, "jailedUntil", info.JailedUntil)
+		return types.ErrValidatorJailed(k.Codespace()).Result()
+	}
+
+	if err := k.sk.UnjailValidator(ctx, validator.Signer.Bytes()); err != nil {
+		k.Logger(ctx).Error("Unable to unjail validator", "error", err, "validatorID", validator.ID)
+		return hmCommon.ErrValidatorSave(k.Codespace()).Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnjail,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, validator.ID.String()),
+			sdk.NewAttribute(types.AttributeKeySigner, validator.Signer.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
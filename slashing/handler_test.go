@@ -0,0 +1,78 @@
+package slashing_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/slashing"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
+	cmn "github.com/maticnetwork/heimdall/test"
+	"github.com/maticnetwork/heimdall/types"
+)
+
+func TestHandleMsgUnjail(t *testing.T) {
+	ctx, sk, keeper := createTestInput(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000000, 0).UTC())
+	handler := slashing.NewHandler(keeper)
+	jailDuration := keeper.GetParams(ctx).JailDuration
+
+	validators := cmn.GenRandomVal(2, 0, 10, 10, false, 1)
+	for _, validator := range validators {
+		require.Nil(t, sk.AddValidator(ctx, validator))
+	}
+	offender := validators[0]
+
+	// validator which isn't jailed can't unjail
+	got := handler(ctx, slashingTypes.NewMsgUnjail(offender.Signer, offender.ID.Uint64()))
+	require.Equal(t, sdk.CodeType(common.CodeValNotJailed), got.Code)
+
+	slashing.BeginBlocker(ctx, doubleSignRequest(offender, 8), keeper)
+	info, found := keeper.GetValidatorSigningInfo(ctx, offender.ID)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime().Add(jailDuration), info.JailedUntil)
+
+	// only validator signer can unjail
+	got = handler(ctx, slashingTypes.NewMsgUnjail(validators[1].Signer, offender.ID.Uint64()))
+	require.Equal(t, sdk.CodeType(common.CodeValSignerMismatch), got.Code)
+
+	// validator can't unjail before jail duration is over
+	got = handler(ctx.WithBlockTime(info.JailedUntil.Add(-time.Second)), slashingTypes.NewMsgUnjail(offender.Signer, offender.ID.Uint64()))
+	require.Equal(t, sdk.CodeType(slashingTypes.CodeValidatorJailed), got.Code)
+
+	// validator jailed on mainchain has to unjail on mainchain
+	jailed, _ := sk.GetValidatorFromValID(ctx, offender.ID)
+	jailed.JailExitEpoch = 5
+	require.Nil(t, sk.AddValidator(ctx, jailed))
+	got = handler(ctx.WithBlockTime(info.JailedUntil), slashingTypes.NewMsgUnjail(offender.Signer, offender.ID.Uint64()))
+	require.Equal(t, sdk.CodeType(slashingTypes.CodeJailedOnMainchain), got.Code)
+	jailed.JailExitEpoch = 0
+	require.Nil(t, sk.AddValidator(ctx, jailed))
+
+	// validator unjails once jail duration is over
+	info, _ = keeper.GetValidatorSigningInfo(ctx, offender.ID)
+	info.MissedBlocksCounter = 5
+	require.Nil(t, keeper.SetValidatorSigningInfo(ctx, info))
+	got = handler(ctx.WithBlockTime(info.JailedUntil).WithBlockHeight(20), slashingTypes.NewMsgUnjail(offender.Signer, offender.ID.Uint64()))
+	require.True(t, got.IsOK(), "expected unjail to be ok, got %v", got)
+
+	// liveness tracking restarts through unjailed hook
+	info, _ = keeper.GetValidatorSigningInfo(ctx, offender.ID)
+	require.Equal(t, int64(20), info.StartHeight)
+	require.Equal(t, int64(0), info.MissedBlocksCounter)
+
+	unjailed, _ := sk.GetValidatorFromValID(ctx, offender.ID)
+	require.False(t, unjailed.Jailed)
+	require.Len(t, sk.GetCurrentValidators(ctx), 2)
+
+	// unjailed validator is slashed again for new infraction
+	slashing.BeginBlocker(ctx, doubleSignRequest(offender, 9), keeper)
+	jailed, _ = sk.GetValidatorFromValID(ctx, offender.ID)
+	require.True(t, jailed.Jailed)
+	require.Len(t, keeper.GetValidatorEvidences(ctx, offender.ID), 2)
+	_, err := sk.GetDividendAccountByID(ctx, types.DividendAccountID(offender.ID))
+	require.Nil(t, err)
+}
@@ -0,0 +1,52 @@
+package slashing
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Hooks subscribes slashing to validator lifecycle events of staking
+type Hooks struct {
+	k *Keeper
+}
+
+// Implements StakingHooks interface
+var _ stakingTypes.StakingHooks = Hooks{}
+
+// Hooks returns staking hooks of keeper
+func (k *Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterValidatorJoined - no-op
+func (h Hooks) AfterValidatorJoined(ctx sdk.Context, validator hmTypes.Validator) {}
+
+// AfterStakeUpdated - no-op
+func (h Hooks) AfterStakeUpdated(ctx sdk.Context, validator hmTypes.Validator, prevPower int64) {}
+
+// AfterSignerChanged restarts liveness tracking, new signer isn't judged on blocks missed by previous signer
+func (h Hooks) AfterSignerChanged(ctx sdk.Context, validator hmTypes.Validator, prevSigner hmTypes.HeimdallAddress) {
+	if err := h.k.ResetValidatorSigningInfo(ctx, validator.ID); err != nil {
+		h.k.Logger(ctx).Error("Unable to reset signing info after signer change", "error", err, "validatorID", validator.ID)
+	}
+}
+
+// AfterValidatorExited - no-op
+func (h Hooks) AfterValidatorExited(ctx sdk.Context, validator hmTypes.Validator) {}
+
+// AfterValidatorJailed - no-op
+func (h Hooks) AfterValidatorJailed(ctx sdk.Context, validator hmTypes.Validator) {}
+
+// AfterValidatorUnjailed restarts liveness tracking, validator isn't judged on blocks missed while it was jailed.
+// Validators unjailed by mainchain unjailed event are reset as well
+func (h Hooks) AfterValidatorUnjailed(ctx sdk.Context, validator hmTypes.Validator) {
+	if err := h.k.ResetValidatorSigningInfo(ctx, validator.ID); err != nil {
+		h.k.Logger(ctx).Error("Unable to reset signing info after unjail", "error", err, "validatorID", validator.ID)
+	}
+}
+
+// BeforeValidatorSetUpdate - no-op
+func (h Hooks) BeforeValidatorSetUpdate(ctx sdk.Context, validatorSet hmTypes.ValidatorSet, updates []*hmTypes.Validator) {
+}
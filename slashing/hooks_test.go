@@ -0,0 +1,33 @@
+package slashing_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
+	cmn "github.com/maticnetwork/heimdall/test"
+)
+
+func TestSignerChangeResetsSigningInfo(t *testing.T) {
+	ctx, sk, keeper := createTestInput(t)
+
+	validator := cmn.GenRandomVal(1, 0, 10, 10, false, 1)[0]
+	require.Nil(t, sk.AddValidator(ctx, validator))
+
+	info := slashingTypes.NewValidatorSigningInfo(validator.ID, ctx.BlockHeight())
+	info.IndexOffset = 7
+	info.MissedBlocksCounter = 3
+	require.Nil(t, keeper.SetValidatorSigningInfo(ctx, info))
+	keeper.SetValidatorMissedBlock(ctx, validator.ID, 2, true)
+
+	// new signer starts with clean window
+	sk.AfterSignerChanged(ctx.WithBlockHeight(20), validator, validator.Signer)
+
+	info, found := keeper.GetValidatorSigningInfo(ctx, validator.ID)
+	require.True(t, found)
+	require.Equal(t, int64(20), info.StartHeight)
+	require.Equal(t, int64(0), info.IndexOffset)
+	require.Equal(t, int64(0), info.MissedBlocksCounter)
+	require.Empty(t, keeper.GetValidatorMissedBlocks(ctx, validator.ID))
+}
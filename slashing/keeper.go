@@ -0,0 +1,222 @@
+package slashing
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/slashing/types"
+	"github.com/maticnetwork/heimdall/staking"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+var (
+	EvidenceKey = []byte{0x51} // prefix key to store evidences by validator ID and infraction height
+)
+
+// Keeper stores all related data
+type Keeper struct {
+	cdc *codec.Codec
+	// staking keeper, referred by pointer so it sees hooks registered after slashing keeper is created
+	sk *staking.Keeper
+	// The (unexposed) keys used to access the stores from the Context.
+	storeKey sdk.StoreKey
+	// codespace
+	codespace sdk.CodespaceType
+	// param space
+	paramSpace params.Subspace
+}
+
+// NewKeeper create new keeper
+func NewKeeper(
+	cdc *codec.Codec,
+	storeKey sdk.StoreKey,
+	paramSpace params.Subspace,
+	codespace sdk.CodespaceType,
+	stakingKeeper *staking.Keeper,
+) Keeper {
+	keeper := Keeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
+		codespace:  codespace,
+		sk:         stakingKeeper,
+	}
+	return keeper
+}
+
+// Codespace returns the codespace
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// Logger returns a module-specific logger
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+// GetEvidencesKey returns prefix key for evidences of validator
+func GetEvidencesKey(validatorID hmTypes.ValidatorID) []byte {
+	return append(EvidenceKey, sdk.Uint64ToBigEndian(validatorID.Uint64())...)
+}
+
+// GetEvidenceKey returns key for evidence of validator at infraction height
+func GetEvidenceKey(validatorID hmTypes.ValidatorID, infractionHeight int64) []byte {
+	return append(GetEvidencesKey(validatorID), sdk.Uint64ToBigEndian(uint64(infractionHeight))...)
+}
+
+// SetEvidence stores evidence
+func (k *Keeper) SetEvidence(ctx sdk.Context, evidence types.Evidence) error {
+	store := ctx.KVStore(k.storeKey)
+
+	out, err := k.cdc.MarshalBinaryBare(evidence)
+	if err != nil {
+		k.Logger(ctx).Error("Error marshalling evidence", "error", err)
+		return err
+	}
+
+	store.Set(GetEvidenceKey(evidence.ValidatorID, evidence.InfractionHeight), out)
+	return nil
+}
+
+// HasEvidence checks if evidence of validator at infraction height is already handled
+func (k *Keeper) HasEvidence(ctx sdk.Context, validatorID hmTypes.ValidatorID, infractionHeight int64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetEvidenceKey(validatorID, infractionHeight))
+}
+
+// GetEvidences returns all evidences
+func (k *Keeper) GetEvidences(ctx sdk.Context) (evidences []types.Evidence) {
+	k.IterateEvidencesAndApplyFn(ctx, EvidenceKey, func(evidence types.Evidence) error {
+		evidences = append(evidences, evidence)
+		return nil
+	})
+	return
+}
+
+// GetValidatorEvidences returns evidences of validator
+func (k *Keeper) GetValidatorEvidences(ctx sdk.Context, validatorID hmTypes.ValidatorID) (evidences []types.Evidence) {
+	k.IterateEvidencesAndApplyFn(ctx, GetEvidencesKey(validatorID), func(evidence types.Evidence) error {
+		evidences = append(evidences, evidence)
+		return nil
+	})
+	return
+}
+
+// IterateEvidencesAndApplyFn iterates evidences by prefix and apply the given function
+func (k *Keeper) IterateEvidencesAndApplyFn(ctx sdk.Context, prefix []byte, f func(evidence types.Evidence) error) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var evidence types.Evidence
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &evidence); err != nil {
+			k.Logger(ctx).Error("Error unmarshalling evidence", "error", err)
+			continue
+		}
+
+		if err := f(evidence); err != nil {
+			return
+		}
+	}
+}
+
+// GetSlashAmount returns amount slashed from stake backing voting power
+func GetSlashAmount(power int64, powerReduction *big.Int, fraction hmTypes.Dec) *big.Int {
+	stake := big.NewInt(0).Mul(big.NewInt(power), powerReduction)
+	return fraction.MulInt(hmTypes.NewIntFromBigInt(stake)).TruncateInt().BigInt()
+}
+
+// HandleDoubleSign records evidence and slashes and jails validator who signed conflicting votes at infraction height.
+// Slashed amount is added to validator's dividend account, so it's committed in account root of next checkpoint.
+// Already jailed validator is not slashed again, returned flag reports if slash was applied.
+func (k *Keeper) HandleDoubleSign(ctx sdk.Context, address []byte, infractionHeight int64, power int64) (*types.Evidence, bool, error) {
+	validator, err := k.sk.GetValidatorInfo(ctx, address)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if k.HasEvidence(ctx, validator.ID, infractionHeight) {
+		return nil, false, errors.New("Evidence already handled")
+	}
+
+	evidence := types.Evidence{
+		Type:             types.EvidenceTypeDoubleSign,
+		ValidatorID:      validator.ID,
+		Signer:           validator.Signer,
+		InfractionHeight: infractionHeight,
+		Power:            power,
+		SlashedAmount:    big.NewInt(0).String(),
+		Height:           ctx.BlockHeight(),
+	}
+
+	// validator is slashed once per jailing, later evidences are only recorded
+	if !validator.Jailed {
+		amount := GetSlashAmount(power, k.sk.GetPowerReduction(ctx).BigInt(), k.GetParams(ctx).SlashFractionDoubleSign)
+		if err := k.addSlashedAmount(ctx, validator.ID, amount); err != nil {
+			return nil, false, err
+		}
+
+		if err := k.sk.JailValidator(ctx, address); err != nil {
+			return nil, false, err
+		}
+
+		if err := k.ResetValidatorSigningInfo(ctx, validator.ID); err != nil {
+			return nil, false, err
+		}
+
+		if err := k.setJailedUntil(ctx, validator.ID); err != nil {
+			return nil, false, err
+		}
+
+		evidence.SlashedAmount = amount.String()
+	}
+
+	if err := k.SetEvidence(ctx, evidence); err != nil {
+		return nil, false, err
+	}
+
+	return &evidence, !validator.Jailed, nil
+}
+
+// addSlashedAmount adds slashed amount to dividend account of validator
+func (k *Keeper) addSlashedAmount(ctx sdk.Context, valID hmTypes.ValidatorID, amount *big.Int) error {
+	// Get or create dividend account
+	var dividendAccount hmTypes.DividendAccount
+
+	if k.sk.CheckIfDividendAccountExists(ctx, hmTypes.DividendAccountID(valID)) {
+		dividendAccount, _ = k.sk.GetDividendAccountByID(ctx, hmTypes.DividendAccountID(valID))
+	} else {
+		dividendAccount = hmTypes.NewDividendAccount(hmTypes.DividendAccountID(valID), big.NewInt(0).String(), big.NewInt(0).String())
+	}
+
+	// update slashed amount
+	oldSlashedAmount, ok := big.NewInt(0).SetString(dividendAccount.SlashedAmount, 10)
+	if !ok {
+		oldSlashedAmount = big.NewInt(0)
+	}
+	dividendAccount.SlashedAmount = big.NewInt(0).Add(oldSlashedAmount, amount).String()
+
+	k.Logger(ctx).Info("Dividend Account slashed amount of validator ", "ID", dividendAccount.ID, "SlashedAmount", dividendAccount.SlashedAmount)
+	return k.sk.AddDividendAccount(ctx, dividendAccount)
+}
+
+// -----------------------------------------------------------------------------
+// Params
+
+// SetParams sets the slashing module's parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetParams gets the slashing module's parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return
+}
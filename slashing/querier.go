@@ -0,0 +1,92 @@
+package slashing
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/slashing/types"
+)
+
+// NewQuerier creates a querier for slashing REST endpoints
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryParams:
+			return handleQueryParams(ctx, req, keeper)
+		case types.QueryEvidences:
+			return handleQueryEvidences(ctx, req, keeper)
+		case types.QuerySigningInfo:
+			return handleQuerySigningInfo(ctx, req, keeper)
+		case types.QuerySigningInfos:
+			return handleQuerySigningInfos(ctx, req, keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown slashing query endpoint")
+		}
+	}
+}
+
+func handleQueryParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := json.Marshal(keeper.GetParams(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+// handleQueryEvidences returns evidences of validator, or all evidences if no params are passed
+func handleQueryEvidences(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var evidences []types.Evidence
+	if len(req.Data) == 0 {
+		evidences = keeper.GetEvidences(ctx)
+	} else {
+		var params types.QueryEvidencesParams
+		if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+			return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+		}
+		evidences = keeper.GetValidatorEvidences(ctx, params.ValidatorID)
+	}
+
+	if evidences == nil {
+		evidences = make([]types.Evidence, 0)
+	}
+
+	bz, err := json.Marshal(evidences)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQuerySigningInfo(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QuerySigningInfoParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	info, found := keeper.GetValidatorSigningInfo(ctx, params.ValidatorID)
+	if !found {
+		return nil, sdk.ErrUnknownRequest("No signing info found")
+	}
+
+	bz, err := json.Marshal(info)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQuerySigningInfos(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	infos := keeper.GetValidatorSigningInfos(ctx)
+	if infos == nil {
+		infos = make([]types.ValidatorSigningInfo, 0)
+	}
+
+	bz, err := json.Marshal(infos)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
@@ -0,0 +1,213 @@
+package slashing
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/slashing/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+var (
+	ValidatorSigningInfoKey         = []byte{0x53} // prefix key to store signing info by validator ID
+	ValidatorMissedBlockBitArrayKey = []byte{0x54} // prefix key to store missed block bitmap by validator ID and window index
+)
+
+// GetValidatorSigningInfoKey returns key for signing info of validator
+func GetValidatorSigningInfoKey(validatorID hmTypes.ValidatorID) []byte {
+	return append(ValidatorSigningInfoKey, sdk.Uint64ToBigEndian(validatorID.Uint64())...)
+}
+
+// GetValidatorMissedBlocksKey returns prefix key for missed block bitmap of validator
+func GetValidatorMissedBlocksKey(validatorID hmTypes.ValidatorID) []byte {
+	return append(ValidatorMissedBlockBitArrayKey, sdk.Uint64ToBigEndian(validatorID.Uint64())...)
+}
+
+// GetValidatorMissedBlockKey returns key for window index in missed block bitmap of validator
+func GetValidatorMissedBlockKey(validatorID hmTypes.ValidatorID, index int64) []byte {
+	return append(GetValidatorMissedBlocksKey(validatorID), sdk.Uint64ToBigEndian(uint64(index))...)
+}
+
+// SetValidatorSigningInfo stores signing info of validator
+func (k *Keeper) SetValidatorSigningInfo(ctx sdk.Context, info types.ValidatorSigningInfo) error {
+	store := ctx.KVStore(k.storeKey)
+
+	out, err := k.cdc.MarshalBinaryBare(info)
+	if err != nil {
+		k.Logger(ctx).Error("Error marshalling signing info", "error", err)
+		return err
+	}
+
+	store.Set(GetValidatorSigningInfoKey(info.ValidatorID), out)
+	return nil
+}
+
+// GetValidatorSigningInfo returns signing info of validator
+func (k *Keeper) GetValidatorSigningInfo(ctx sdk.Context, validatorID hmTypes.ValidatorID) (info types.ValidatorSigningInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetValidatorSigningInfoKey(validatorID))
+	if bz == nil {
+		return info, false
+	}
+
+	if err := k.cdc.UnmarshalBinaryBare(bz, &info); err != nil {
+		k.Logger(ctx).Error("Error unmarshalling signing info", "error", err)
+		return info, false
+	}
+	return info, true
+}
+
+// GetValidatorSigningInfos returns signing infos of all tracked validators
+func (k *Keeper) GetValidatorSigningInfos(ctx sdk.Context) (infos []types.ValidatorSigningInfo) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, ValidatorSigningInfoKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.ValidatorSigningInfo
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &info); err != nil {
+			k.Logger(ctx).Error("Error unmarshalling signing info", "error", err)
+			continue
+		}
+		infos = append(infos, info)
+	}
+	return
+}
+
+// SetValidatorMissedBlock sets window index in missed block bitmap of validator
+func (k *Keeper) SetValidatorMissedBlock(ctx sdk.Context, validatorID hmTypes.ValidatorID, index int64, missed bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !missed {
+		store.Delete(GetValidatorMissedBlockKey(validatorID, index))
+		return
+	}
+	store.Set(GetValidatorMissedBlockKey(validatorID, index), []byte{0x01})
+}
+
+// GetValidatorMissedBlock checks if validator missed block at window index
+func (k *Keeper) GetValidatorMissedBlock(ctx sdk.Context, validatorID hmTypes.ValidatorID, index int64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetValidatorMissedBlockKey(validatorID, index))
+}
+
+// GetValidatorMissedBlocks returns missed blocks in window of validator
+func (k *Keeper) GetValidatorMissedBlocks(ctx sdk.Context, validatorID hmTypes.ValidatorID) (missedBlocks []types.MissedBlock) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := GetValidatorMissedBlocksKey(validatorID)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		index := int64(binary.BigEndian.Uint64(iterator.Key()[len(prefix):]))
+		missedBlocks = append(missedBlocks, types.MissedBlock{Index: index, Missed: true})
+	}
+	return
+}
+
+// clearValidatorMissedBlocks clears missed block bitmap of validator
+func (k *Keeper) clearValidatorMissedBlocks(ctx sdk.Context, validatorID hmTypes.ValidatorID) {
+	for _, missedBlock := range k.GetValidatorMissedBlocks(ctx, validatorID) {
+		k.SetValidatorMissedBlock(ctx, validatorID, missedBlock.Index, false)
+	}
+}
+
+// ResetValidatorSigningInfo restarts liveness tracking of validator from current height,
+// so validator isn't judged on blocks missed before it was jailed.
+func (k *Keeper) ResetValidatorSigningInfo(ctx sdk.Context, validatorID hmTypes.ValidatorID) error {
+	info, found := k.GetValidatorSigningInfo(ctx, validatorID)
+	if !found {
+		return nil
+	}
+
+	k.clearValidatorMissedBlocks(ctx, validatorID)
+	info.StartHeight = ctx.BlockHeight()
+	info.IndexOffset = 0
+	info.MissedBlocksCounter = 0
+	return k.SetValidatorSigningInfo(ctx, info)
+}
+
+// setJailedUntil records time after which validator jailed by slashing can unjail
+func (k *Keeper) setJailedUntil(ctx sdk.Context, validatorID hmTypes.ValidatorID) error {
+	info, found := k.GetValidatorSigningInfo(ctx, validatorID)
+	if !found {
+		info = types.NewValidatorSigningInfo(validatorID, ctx.BlockHeight())
+	}
+
+	info.JailedUntil = ctx.BlockTime().Add(k.GetParams(ctx).JailDuration)
+	return k.SetValidatorSigningInfo(ctx, info)
+}
+
+// HandleValidatorSignature records if validator signed previous block in its sliding window.
+// If downtime jail is enabled, validator which signed less than minimum blocks in full window is jailed,
+// it can unjail with unjail msg once jail duration is over.
+// Returned flag reports if validator was jailed.
+func (k *Keeper) HandleValidatorSignature(ctx sdk.Context, address []byte, power int64, signed bool) (*types.ValidatorSigningInfo, bool, error) {
+	validator, err := k.sk.GetValidatorInfo(ctx, address)
+	if err != nil {
+		return nil, false, err
+	}
+
+	params := k.GetParams(ctx)
+	height := ctx.BlockHeight()
+
+	info, found := k.GetValidatorSigningInfo(ctx, validator.ID)
+	if !found {
+		info = types.NewValidatorSigningInfo(validator.ID, height)
+	}
+
+	// update missed block bitmap at current position in window
+	index := info.IndexOffset % params.SignedBlocksWindow
+	info.IndexOffset++
+
+	previous := k.GetValidatorMissedBlock(ctx, validator.ID, index)
+	missed := !signed
+	switch {
+	case !previous && missed:
+		k.SetValidatorMissedBlock(ctx, validator.ID, index, true)
+		info.MissedBlocksCounter++
+	case previous && !missed:
+		k.SetValidatorMissedBlock(ctx, validator.ID, index, false)
+		info.MissedBlocksCounter--
+	}
+
+	if missed {
+		info.LastMissedHeight = height
+		k.Logger(ctx).Debug("Validator missed precommit",
+			"validatorID", validator.ID,
+			"height", height,
+			"missed", info.MissedBlocksCounter,
+			"threshold", params.SignedBlocksWindow-params.MinSignedBlocks())
+	}
+
+	// validator is judged only after it was tracked for full window
+	jailed := false
+	minHeight := info.StartHeight + params.SignedBlocksWindow
+	maxMissed := params.SignedBlocksWindow - params.MinSignedBlocks()
+	if params.DowntimeJailEnabled && !validator.Jailed && height > minHeight && info.MissedBlocksCounter > maxMissed {
+		if err := k.sk.JailValidator(ctx, address); err != nil {
+			return nil, false, err
+		}
+
+		k.Logger(ctx).Info("Jailed validator for downtime",
+			"validatorID", validator.ID,
+			"power", power,
+			"missed", info.MissedBlocksCounter)
+
+		// validator starts with clean window after it is unjailed
+		k.clearValidatorMissedBlocks(ctx, validator.ID)
+		info.StartHeight = height
+		info.IndexOffset = 0
+		info.MissedBlocksCounter = 0
+		info.JailedUntil = ctx.BlockTime().Add(params.JailDuration)
+		jailed = true
+	}
+
+	if err := k.SetValidatorSigningInfo(ctx, info); err != nil {
+		return nil, false, err
+	}
+
+	return &info, jailed, nil
+}
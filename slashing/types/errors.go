@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Slashing errors reserve 5600 ~ 5699.
+const (
+	CodeJailedOnMainchain sdk.CodeType = 5600
+	CodeValidatorJailed                = 5601
+)
+
+// ErrJailedOnMainchain represents unjail of validator jailed by staking contract
+func ErrJailedOnMainchain(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeJailedOnMainchain, "Validator is jailed on mainchain, it has to unjail on mainchain")
+}
+
+// ErrValidatorJailed represents unjail before jail duration is over
+func ErrValidatorJailed(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeValidatorJailed, "Validator is still jailed, jail duration is not over")
+}
@@ -0,0 +1,22 @@
+package types
+
+// slashing module event types
+var (
+	EventTypeSlash    = "slash"
+	EventTypeLiveness = "liveness"
+	EventTypeJail     = "jail"
+	EventTypeUnjail   = "unjail"
+
+	AttributeKeyValidatorID      = "validator-id"
+	AttributeKeySigner           = "signer"
+	AttributeKeyPower            = "power"
+	AttributeKeyAmount           = "amount"
+	AttributeKeyReason           = "reason"
+	AttributeKeyInfractionHeight = "infraction-height"
+	AttributeKeyMissedBlocks     = "missed-blocks"
+	AttributeKeyHeight           = "height"
+
+	AttributeValueDowntime = "downtime"
+
+	AttributeValueCategory = ModuleName
+)
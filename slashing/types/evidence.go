@@ -0,0 +1,35 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Evidence types
+const (
+	EvidenceTypeDoubleSign = "double-sign"
+)
+
+// Evidence is misbehaviour of validator reported by tendermint, with slash applied for it
+type Evidence struct {
+	Type             string                  `json:"type" yaml:"type"`
+	ValidatorID      hmTypes.ValidatorID     `json:"validator_id" yaml:"validator_id"`
+	Signer           hmTypes.HeimdallAddress `json:"signer" yaml:"signer"`
+	InfractionHeight int64                   `json:"infraction_height" yaml:"infraction_height"`
+	Power            int64                   `json:"power" yaml:"power"`
+	SlashedAmount    string                  `json:"slashed_amount" yaml:"slashed_amount"` // string representation of big.Int
+	Height           int64                   `json:"height" yaml:"height"`                 // heimdall height evidence was handled at
+}
+
+// String implements the stringer interface.
+func (e Evidence) String() string {
+	return fmt.Sprintf("Evidence{%v %v %v %v %v %v %v}",
+		e.Type,
+		e.ValidatorID,
+		e.Signer,
+		e.InfractionHeight,
+		e.Power,
+		e.SlashedAmount,
+		e.Height)
+}
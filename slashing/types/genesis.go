@@ -0,0 +1,46 @@
+package types
+
+import (
+	"errors"
+)
+
+// GenesisState is the slashing state that must be provided at genesis.
+type GenesisState struct {
+	Params       Params                  `json:"params" yaml:"params"`
+	Evidences    []Evidence              `json:"evidences" yaml:"evidences"`
+	SigningInfos []ValidatorSigningInfo  `json:"signing_infos" yaml:"signing_infos"`
+	MissedBlocks []ValidatorMissedBlocks `json:"missed_blocks" yaml:"missed_blocks"`
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, evidences []Evidence, signingInfos []ValidatorSigningInfo, missedBlocks []ValidatorMissedBlocks) GenesisState {
+	return GenesisState{
+		Params:       params,
+		Evidences:    evidences,
+		SigningInfos: signingInfos,
+		MissedBlocks: missedBlocks,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), nil, nil, nil)
+}
+
+// ValidateGenesis performs basic validation of slashing genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	for _, evidence := range data.Evidences {
+		if evidence.Signer.Empty() {
+			return errors.New("Invalid evidence signer")
+		}
+	}
+
+	for _, info := range data.SigningInfos {
+		if info.MissedBlocksCounter < 0 || info.MissedBlocksCounter > data.Params.SignedBlocksWindow {
+			return errors.New("Invalid missed blocks counter in signing info")
+		}
+	}
+
+	return data.Params.Validate()
+}
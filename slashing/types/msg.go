@@ -0,0 +1,65 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+//
+// Unjail
+//
+
+var _ sdk.Msg = &MsgUnjail{}
+
+// MsgUnjail represents unjail of validator jailed by slashing, signed by validator signer
+type MsgUnjail struct {
+	From hmTypes.HeimdallAddress `json:"from"`
+	ID   hmTypes.ValidatorID     `json:"id"`
+}
+
+// NewMsgUnjail creates new unjail msg
+func NewMsgUnjail(from hmTypes.HeimdallAddress, id uint64) MsgUnjail {
+	return MsgUnjail{
+		From: from,
+		ID:   hmTypes.NewValidatorID(id),
+	}
+}
+
+// Type returns message type
+func (msg MsgUnjail) Type() string {
+	return "unjail"
+}
+
+// Route returns route for message
+func (msg MsgUnjail) Route() string {
+	return RouterKey
+}
+
+// GetSigners returns address of the signer
+func (msg MsgUnjail) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+// GetSignBytes returns sign bytes for unjail msg
+func (msg MsgUnjail) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic validates the message and returns error
+func (msg MsgUnjail) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid from %v", msg.From.String())
+	}
+
+	if msg.ID == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Default parameter values
+var (
+	DefaultSlashFractionDoubleSign = hmTypes.NewDecWithPrec(5, 2) // 5% of stake is slashed for double signing
+	DefaultSignedBlocksWindow      = int64(100)
+	DefaultMinSignedPerWindow      = hmTypes.NewDecWithPrec(5, 1) // validator missing more than half of window is down
+	DefaultDowntimeJailEnabled     = false
+	DefaultJailDuration            = 10 * time.Minute // validator jailed by slashing can unjail after it
+)
+
+// Parameter keys
+var (
+	KeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
+	KeySignedBlocksWindow      = []byte("SignedBlocksWindow")
+	KeyMinSignedPerWindow      = []byte("MinSignedPerWindow")
+	KeyDowntimeJailEnabled     = []byte("DowntimeJailEnabled")
+	KeyJailDuration            = []byte("JailDuration")
+)
+
+var _ subspace.ParamSet = &Params{}
+
+// Params defines the parameters for the slashing module.
+type Params struct {
+	SlashFractionDoubleSign hmTypes.Dec   `json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"`
+	SignedBlocksWindow      int64         `json:"signed_blocks_window" yaml:"signed_blocks_window"`
+	MinSignedPerWindow      hmTypes.Dec   `json:"min_signed_per_window" yaml:"min_signed_per_window"`
+	DowntimeJailEnabled     bool          `json:"downtime_jail_enabled" yaml:"downtime_jail_enabled"`
+	JailDuration            time.Duration `json:"jail_duration" yaml:"jail_duration"`
+}
+
+// NewParams creates a new Params object
+func NewParams(slashFractionDoubleSign hmTypes.Dec, signedBlocksWindow int64, minSignedPerWindow hmTypes.Dec, downtimeJailEnabled bool, jailDuration time.Duration) Params {
+	return Params{
+		SlashFractionDoubleSign: slashFractionDoubleSign,
+		SignedBlocksWindow:      signedBlocksWindow,
+		MinSignedPerWindow:      minSignedPerWindow,
+		DowntimeJailEnabled:     downtimeJailEnabled,
+		JailDuration:            jailDuration,
+	}
+}
+
+// ParamKeyTable for slashing module
+func ParamKeyTable() subspace.KeyTable {
+	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of slashing module's parameters.
+func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
+	return subspace.ParamSetPairs{
+		{Key: KeySlashFractionDoubleSign, Value: &p.SlashFractionDoubleSign},
+		{Key: KeySignedBlocksWindow, Value: &p.SignedBlocksWindow},
+		{Key: KeyMinSignedPerWindow, Value: &p.MinSignedPerWindow},
+		{Key: KeyDowntimeJailEnabled, Value: &p.DowntimeJailEnabled},
+		{Key: KeyJailDuration, Value: &p.JailDuration},
+	}
+}
+
+// Equal returns a boolean determining if two Params types are identical.
+func (p Params) Equal(p2 Params) bool {
+	bz1 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p)
+	bz2 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p2)
+	return bytes.Equal(bz1, bz2)
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return Params{
+		SlashFractionDoubleSign: DefaultSlashFractionDoubleSign,
+		SignedBlocksWindow:      DefaultSignedBlocksWindow,
+		MinSignedPerWindow:      DefaultMinSignedPerWindow,
+		DowntimeJailEnabled:     DefaultDowntimeJailEnabled,
+		JailDuration:            DefaultJailDuration,
+	}
+}
+
+// String implements the stringer interface.
+func (p Params) String() string {
+	var sb strings.Builder
+	sb.WriteString("Params: \n")
+	sb.WriteString(fmt.Sprintf("SlashFractionDoubleSign: %s\n", p.SlashFractionDoubleSign))
+	sb.WriteString(fmt.Sprintf("SignedBlocksWindow: %d\n", p.SignedBlocksWindow))
+	sb.WriteString(fmt.Sprintf("MinSignedPerWindow: %s\n", p.MinSignedPerWindow))
+	sb.WriteString(fmt.Sprintf("DowntimeJailEnabled: %t\n", p.DowntimeJailEnabled))
+	sb.WriteString(fmt.Sprintf("JailDuration: %s\n", p.JailDuration))
+	return sb.String()
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if p.SlashFractionDoubleSign.IsNil() || p.SlashFractionDoubleSign.IsNegative() || p.SlashFractionDoubleSign.GT(hmTypes.OneDec()) {
+		return fmt.Errorf("invalid double sign slash fraction: %s", p.SlashFractionDoubleSign)
+	}
+
+	if p.SignedBlocksWindow <= 0 {
+		return fmt.Errorf("signed blocks window must be positive: %d", p.SignedBlocksWindow)
+	}
+
+	if p.MinSignedPerWindow.IsNil() || p.MinSignedPerWindow.IsNegative() || p.MinSignedPerWindow.GT(hmTypes.OneDec()) {
+		return fmt.Errorf("invalid min signed per window: %s", p.MinSignedPerWindow)
+	}
+
+	if p.JailDuration <= 0 {
+		return fmt.Errorf("jail duration must be positive: %s", p.JailDuration)
+	}
+
+	return nil
+}
+
+// MinSignedBlocks returns minimum number of blocks validator has to sign in window
+func (p Params) MinSignedBlocks() int64 {
+	return p.MinSignedPerWindow.MulInt64(p.SignedBlocksWindow).RoundInt64()
+}
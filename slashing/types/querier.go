@@ -0,0 +1,33 @@
+package types
+
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// query endpoints supported by the slashing Querier
+const (
+	QueryParams       = "params"
+	QueryEvidences    = "evidences"
+	QuerySigningInfo  = "signing-info"
+	QuerySigningInfos = "signing-infos"
+)
+
+// QueryEvidencesParams defines the params for querying evidences of validator.
+type QueryEvidencesParams struct {
+	ValidatorID hmTypes.ValidatorID
+}
+
+// NewQueryEvidencesParams creates a new instance of QueryEvidencesParams.
+func NewQueryEvidencesParams(validatorID hmTypes.ValidatorID) QueryEvidencesParams {
+	return QueryEvidencesParams{ValidatorID: validatorID}
+}
+
+// QuerySigningInfoParams defines the params for querying signing info of validator.
+type QuerySigningInfoParams struct {
+	ValidatorID hmTypes.ValidatorID
+}
+
+// NewQuerySigningInfoParams creates a new instance of QuerySigningInfoParams.
+func NewQuerySigningInfoParams(validatorID hmTypes.ValidatorID) QuerySigningInfoParams {
+	return QuerySigningInfoParams{ValidatorID: validatorID}
+}
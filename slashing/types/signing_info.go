@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// ValidatorSigningInfo tracks precommits of validator over sliding window of blocks
+type ValidatorSigningInfo struct {
+	ValidatorID         hmTypes.ValidatorID `json:"validator_id" yaml:"validator_id"`
+	StartHeight         int64               `json:"start_height" yaml:"start_height"`                   // height at which tracking of validator started
+	IndexOffset         int64               `json:"index_offset" yaml:"index_offset"`                   // number of blocks tracked, position in missed block bitmap
+	MissedBlocksCounter int64               `json:"missed_blocks_counter" yaml:"missed_blocks_counter"` // missed blocks in current window
+	LastMissedHeight    int64               `json:"last_missed_height" yaml:"last_missed_height"`
+	JailedUntil         time.Time           `json:"jailed_until" yaml:"jailed_until"` // time after which validator jailed by slashing can unjail
+}
+
+// NewValidatorSigningInfo creates signing info of validator tracked from start height
+func NewValidatorSigningInfo(validatorID hmTypes.ValidatorID, startHeight int64) ValidatorSigningInfo {
+	return ValidatorSigningInfo{
+		ValidatorID: validatorID,
+		StartHeight: startHeight,
+	}
+}
+
+// String implements the stringer interface.
+func (i ValidatorSigningInfo) String() string {
+	return fmt.Sprintf("ValidatorSigningInfo{%v %v %v %v %v %v}",
+		i.ValidatorID,
+		i.StartHeight,
+		i.IndexOffset,
+		i.MissedBlocksCounter,
+		i.LastMissedHeight,
+		i.JailedUntil)
+}
+
+// MissedBlock is position of missed block in sliding window bitmap
+type MissedBlock struct {
+	Index  int64 `json:"index" yaml:"index"`
+	Missed bool  `json:"missed" yaml:"missed"`
+}
+
+// ValidatorMissedBlocks is missed block bitmap of validator, used in genesis
+type ValidatorMissedBlocks struct {
+	ValidatorID  hmTypes.ValidatorID `json:"validator_id" yaml:"validator_id"`
+	MissedBlocks []MissedBlock       `json:"missed_blocks" yaml:"missed_blocks"`
+}
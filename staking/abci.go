@@ -0,0 +1,54 @@
+package staking
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/staking/types"
+)
+
+// EndBlocker updates validator set whenever ack count or any validator changed in block,
+// and sends joined, exited and updated validators to tendermint
+func EndBlocker(ctx sdk.Context, k Keeper) []abci.ValidatorUpdate {
+	ackCount := k.moduleCommunicator.GetACKCount(ctx)
+	if !k.HasValidatorUpdatedInBlock(ctx) && ackCount == k.GetLastValidatorSetAckCount(ctx) {
+		return nil
+	}
+
+	previousValidatorSet := k.GetValidatorSet(ctx)
+	setUpdates, err := k.ApplyValidatorSetUpdates(ctx)
+	if err != nil {
+		k.Logger(ctx).Error("Unable to update current validator set", "error", err)
+		return nil
+	}
+
+	var tmValUpdates []abci.ValidatorUpdate
+	for _, validator := range setUpdates {
+		eventType := types.EventTypeValidatorSetPowerChange
+		if validator.VotingPower == 0 {
+			eventType = types.EventTypeValidatorSetRemove
+		} else if _, val := previousValidatorSet.GetByAddress(validator.Signer.Bytes()); val == nil {
+			eventType = types.EventTypeValidatorSetAdd
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				eventType,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyValidatorID, validator.ID.String()),
+				sdk.NewAttribute(types.AttributeKeySigner, validator.Signer.String()),
+				sdk.NewAttribute(types.AttributeKeyVotingPower, strconv.FormatInt(validator.VotingPower, 10)),
+				sdk.NewAttribute(types.AttributeKeyAckCount, strconv.FormatUint(ackCount, 10)),
+			),
+		)
+
+		tmValUpdates = append(tmValUpdates, abci.ValidatorUpdate{
+			Power:  validator.VotingPower,
+			PubKey: validator.PubKey.ABCIPubKey(),
+		})
+	}
+
+	return tmValUpdates
+}
@@ -0,0 +1,59 @@
+package staking_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maticnetwork/heimdall/staking"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	cmn "github.com/maticnetwork/heimdall/test"
+)
+
+func TestEndBlocker(t *testing.T) {
+	ctx, keeper, checkpointKeeper := cmn.CreateTestInput(t, false)
+	valSet := cmn.LoadValidatorSet(4, t, keeper, ctx, false, 0)
+
+	// nothing changed in block
+	ctx = ctx.WithBlockHeight(1).WithEventManager(sdk.NewEventManager())
+	require.Empty(t, staking.EndBlocker(ctx, keeper))
+	require.Empty(t, ctx.EventManager().Events())
+
+	// validator exits after next ack, update is applied without any tx in block
+	exiting := *valSet.Validators[0]
+	exiting.EndEpoch = 1
+	require.NoError(t, keeper.AddValidator(ctx, exiting))
+
+	joining := cmn.GenRandomVal(1, 2, 10, 0, false, 5)[0]
+	joining.EndEpoch = 0
+	require.NoError(t, keeper.AddValidator(ctx, joining))
+
+	// validator changes are not effective before ack
+	require.Empty(t, staking.EndBlocker(ctx, keeper))
+
+	ctx = ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+	require.Empty(t, staking.EndBlocker(ctx, keeper), "no update without ack count or validator change")
+
+	checkpointKeeper.UpdateACKCount(ctx)
+	updates := staking.EndBlocker(ctx, keeper)
+	require.Len(t, updates, 2)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 2)
+	eventTypes := []string{events[0].Type, events[1].Type}
+	require.Contains(t, eventTypes, stakingTypes.EventTypeValidatorSetAdd)
+	require.Contains(t, eventTypes, stakingTypes.EventTypeValidatorSetRemove)
+
+	validatorSet := keeper.GetValidatorSet(ctx)
+	require.Len(t, validatorSet.Validators, 4)
+	_, removed := validatorSet.GetByAddress(exiting.Signer.Bytes())
+	require.Nil(t, removed)
+	_, added := validatorSet.GetByAddress(joining.Signer.Bytes())
+	require.NotNil(t, added)
+	require.Equal(t, uint64(1), keeper.GetLastValidatorSetAckCount(ctx))
+
+	// same ack count in next block
+	ctx = ctx.WithBlockHeight(3).WithEventManager(sdk.NewEventManager())
+	require.Empty(t, staking.EndBlocker(ctx, keeper))
+}
@@ -12,6 +12,17 @@ const (
 	FlagTxHash           = "tx-hash"
 	FlagLogIndex         = "log-index"
 	FlagFeeAmount        = "fee-amount"
+	FlagDelegatorAddress = "delegator"
+	FlagAckCount         = "ack"
+	FlagBlockHeight      = "block-height"
+	FlagStatus           = "status"
+	FlagPage             = "page"
+	FlagLimit            = "limit"
+
+	FlagMoniker  = "moniker"
+	FlagWebsite  = "website"
+	FlagContact  = "contact"
+	FlagIdentity = "identity"
 
 	FlagStartEpoch = "start-epoch"
 	FlagEndEpoch   = "end-epoch"
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -29,14 +31,49 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	// supply query command
 	supplyQueryCmd.AddCommand(
 		client.GetCommands(
+			GetQueryParams(cdc),
 			GetValidatorInfo(cdc),
 			GetCurrentValSet(cdc),
+			GetValidators(cdc),
+			GetValidatorSetSnapshot(cdc),
+			GetValidatorDelegations(cdc),
+			GetDelegatorDelegations(cdc),
 		)...,
 	)
 
 	return supplyQueryCmd
 }
 
+// GetQueryParams get staking params
+func GetQueryParams(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "show the current staking parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams), nil)
+			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
+				return errors.New("No staking params found")
+			}
+
+			var params types.Params
+			if err := json.Unmarshal(res, &params); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(params)
+		},
+	}
+
+	return cmd
+}
+
 // GetValidatorInfo validator information via id or address
 func GetValidatorInfo(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -104,3 +141,143 @@ func GetCurrentValSet(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetValidators validators filtered by status, with aggregate stake statistics
+func GetValidators(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validators",
+		Short: "show validators filtered by status (active, pending, standby, unbonding, exited or jailed)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			status := viper.GetString(FlagStatus)
+			if status != "" && !types.IsValidValidatorStatus(status) {
+				return fmt.Errorf("invalid validator status %v", status)
+			}
+
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorsParams(status, viper.GetUint64(FlagPage), viper.GetUint64(FlagLimit)))
+			if err != nil {
+				return err
+			}
+
+			// get validators
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidators), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagStatus, "", "--status=<active|pending|standby|unbonding|exited|jailed>")
+	cmd.Flags().Uint64(FlagPage, 1, "--page=<page number>")
+	cmd.Flags().Uint64(FlagLimit, types.DefaultValidatorListLimit, "--limit=<validators per page>")
+	return cmd
+}
+
+// GetValidatorDelegations delegations bonded to validator via validator id
+func GetValidatorDelegations(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-delegations",
+		Short: "show delegations bonded to validator via validator id",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			validatorID := viper.GetInt64(FlagValidatorID)
+			if validatorID == 0 {
+				return fmt.Errorf("validator ID cannot be 0")
+			}
+
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorParams(hmTypes.ValidatorID(validatorID)))
+			if err != nil {
+				return err
+			}
+
+			// get delegations
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorDelegations), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Int(FlagValidatorID, 0, "--id=<validator ID here>")
+	return cmd
+}
+
+// GetDelegatorDelegations delegations of delegator via delegator address
+func GetDelegatorDelegations(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegator-delegations",
+		Short: "show delegations of delegator via delegator address",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			delegatorAddressStr := viper.GetString(FlagDelegatorAddress)
+			if delegatorAddressStr == "" {
+				return fmt.Errorf("delegator address required")
+			}
+
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryDelegatorParams(hmTypes.HexToHeimdallAddress(delegatorAddressStr)))
+			if err != nil {
+				return err
+			}
+
+			// get delegations
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDelegatorDelegations), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagDelegatorAddress, "", "--delegator=<delegator address here>")
+	return cmd
+}
+
+// GetValidatorSetSnapshot validator set which was active at ack count or block height
+func GetValidatorSetSnapshot(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-set-snapshot",
+		Short: "show validator set which was active at ack count or block height",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var queryParams []byte
+			var err error
+			var t string
+			if viper.IsSet(FlagAckCount) {
+				queryParams, err = cliCtx.Codec.MarshalJSON(types.NewQueryValidatorSetAckParams(viper.GetUint64(FlagAckCount)))
+				t = types.QueryValidatorSetByAck
+			} else if viper.IsSet(FlagBlockHeight) {
+				queryParams, err = cliCtx.Codec.MarshalJSON(types.NewQueryValidatorSetHeightParams(viper.GetInt64(FlagBlockHeight)))
+				t = types.QueryValidatorSetByHeight
+			} else {
+				return fmt.Errorf("ack count or block height required")
+			}
+
+			if err != nil {
+				return err
+			}
+
+			// get validator set snapshot
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, t), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagAckCount, 0, "--ack=<ack count here>")
+	cmd.Flags().Int64(FlagBlockHeight, 0, "--block-height=<block height here>")
+	return cmd
+}
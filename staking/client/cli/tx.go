@@ -3,6 +3,7 @@ package cli
 import (
 	"bytes"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -14,6 +15,8 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/maticnetwork/bor/common"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	hmClient "github.com/maticnetwork/heimdall/client"
 	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
 	"github.com/maticnetwork/heimdall/helper"
@@ -37,6 +40,12 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 			SendValidatorUpdateTx(cdc),
 			SendValidatorExitTx(cdc),
 			SendValidatorStakeUpdateTx(cdc),
+			SendValidatorJailedTx(cdc),
+			SendValidatorUnjailedTx(cdc),
+			SendValidatorReStakeTx(cdc),
+			SendDelegatorBondTx(cdc),
+			SendDelegatorUnbondTx(cdc),
+			SendEditValidatorMetadataTx(cdc),
 		)...,
 	)
 	return txCmd
@@ -61,24 +70,24 @@ func SendValidatorJoinTx(cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("transaction hash is required")
 			}
 
-			pubkeyStr := viper.GetString(FlagSignerPubkey)
-			if pubkeyStr == "" {
-				return fmt.Errorf("pubkey is required")
+			contractCallerObj, err := helper.NewContractCaller()
+			if err != nil {
+				return err
 			}
 
-			pubkeyBytes := common.FromHex(pubkeyStr)
-			if len(pubkeyBytes) != 65 {
-				return fmt.Errorf("Invalid public key length")
+			// get confirmation blocks from checkpoint params
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", checkpointTypes.QuerierRoute, checkpointTypes.QueryParams), nil)
+			if err != nil {
+				return err
 			}
-			pubkey := hmTypes.NewPubKey(pubkeyBytes)
 
-			contractCallerObj, err := helper.NewContractCaller()
-			if err != nil {
+			var checkpointParams checkpointTypes.Params
+			if err := json.Unmarshal(res, &checkpointParams); err != nil {
 				return err
 			}
 
 			// get main tx receipt
-			receipt, err := contractCallerObj.GetConfirmedTxReceipt(hmTypes.HexToHeimdallHash(txhash).EthHash())
+			receipt, err := contractCallerObj.GetConfirmedTxReceipt(hmTypes.HexToHeimdallHash(txhash).EthHash(), checkpointParams.ConfirmationBlocks)
 			if err != nil || receipt == nil {
 				return errors.New("Transaction is not confirmed yet. Please for sometime and try again")
 			}
@@ -104,6 +113,17 @@ func SendValidatorJoinTx(cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("Invalid tx for validator join")
 			}
 
+			// signer pubkey is taken from staked event, unless provided
+			pubkeyBytes := event.SignerPubkey
+			if pubkeyStr := viper.GetString(FlagSignerPubkey); pubkeyStr != "" {
+				pubkeyBytes = common.FromHex(pubkeyStr)
+			}
+
+			if len(pubkeyBytes) != 65 {
+				return fmt.Errorf("Invalid public key length")
+			}
+			pubkey := hmTypes.NewPubKey(pubkeyBytes)
+
 			if !bytes.Equal(event.Signer.Bytes(), pubkey.Address().Bytes()) {
 				return fmt.Errorf("Invalid public key. Signer address and pubkey are not related")
 			}
@@ -112,9 +132,12 @@ func SendValidatorJoinTx(cdc *codec.Codec) *cobra.Command {
 			msg := types.NewMsgValidatorJoin(
 				proposer,
 				event.ValidatorId.Uint64(),
+				event.ActivationEpoch.Uint64(),
+				hmTypes.NewIntFromBigInt(event.Amount),
 				pubkey,
 				hmTypes.HexToHeimdallHash(txhash),
 				uint64(logIndex),
+				receipt.BlockNumber.Uint64(),
 			)
 
 			// broadcast messages
@@ -125,7 +148,6 @@ func SendValidatorJoinTx(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
 	cmd.Flags().String(FlagSignerPubkey, "", "--signer-pubkey=<signer pubkey here>")
 	cmd.Flags().String(FlagTxHash, "", "--tx-hash=<transaction-hash>")
-	cmd.MarkFlagRequired(FlagSignerPubkey)
 	cmd.MarkFlagRequired(FlagTxHash)
 	return cmd
 }
@@ -154,12 +176,32 @@ func SendValidatorExitTx(cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("transaction hash has to be supplied")
 			}
 
+			contractCallerObj, err := helper.NewContractCaller()
+			if err != nil {
+				return err
+			}
+
+			// get main tx receipt
+			receipt, err := getConfirmedTxReceipt(cliCtx, &contractCallerObj, txhash)
+			if err != nil {
+				return err
+			}
+
+			// get unstake init event
+			logIndex := uint64(viper.GetInt64(FlagLogIndex))
+			event, err := contractCallerObj.DecodeValidatorExitEvent(receipt, logIndex)
+			if err != nil {
+				return err
+			}
+
 			// draf msg
 			msg := types.NewMsgValidatorExit(
 				proposer,
 				uint64(validator),
+				event.DeactivationEpoch.Uint64(),
 				hmTypes.HexToHeimdallHash(txhash),
-				uint64(viper.GetInt64(FlagLogIndex)),
+				logIndex,
+				receipt.BlockNumber.Uint64(),
 			)
 
 			// broadcast messages
@@ -213,12 +255,24 @@ func SendValidatorUpdateTx(cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("transaction hash has to be supplied")
 			}
 
+			contractCallerObj, err := helper.NewContractCaller()
+			if err != nil {
+				return err
+			}
+
+			// get main tx receipt
+			receipt, err := getConfirmedTxReceipt(cliCtx, &contractCallerObj, txhash)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgSignerUpdate(
 				proposer,
 				uint64(validator),
 				pubkey,
 				hmTypes.HexToHeimdallHash(txhash),
 				uint64(viper.GetInt64(FlagLogIndex)),
+				receipt.BlockNumber.Uint64(),
 			)
 
 			// broadcast messages
@@ -262,11 +316,222 @@ func SendValidatorStakeUpdateTx(cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("transaction hash has to be supplied")
 			}
 
+			contractCallerObj, err := helper.NewContractCaller()
+			if err != nil {
+				return err
+			}
+
+			// get main tx receipt
+			receipt, err := getConfirmedTxReceipt(cliCtx, &contractCallerObj, txhash)
+			if err != nil {
+				return err
+			}
+
+			// get stake update event
+			logIndex := uint64(viper.GetInt64(FlagLogIndex))
+			event, err := contractCallerObj.DecodeValidatorStakeUpdateEvent(receipt, logIndex)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgStakeUpdate(
+				proposer,
+				uint64(validator),
+				hmTypes.NewIntFromBigInt(event.NewAmount),
+				hmTypes.HexToHeimdallHash(txhash),
+				logIndex,
+				receipt.BlockNumber.Uint64(),
+			)
+
+			// broadcast messages
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
+	cmd.Flags().Int(FlagValidatorID, 0, "--id=<validator-id>")
+	cmd.Flags().String(FlagTxHash, "", "--tx-hash=<transaction-hash>")
+	cmd.Flags().String(FlagLogIndex, "", "--log-index=<log-index>")
+	cmd.MarkFlagRequired(FlagTxHash)
+	cmd.MarkFlagRequired(FlagLogIndex)
+
+	return cmd
+}
+
+// SendValidatorJailedTx send validator jailed transaction
+func SendValidatorJailedTx(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "jailed",
+		Short: "Jail validator jailed on mainchain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// get proposer
+			proposer := hmTypes.HexToHeimdallAddress(viper.GetString(FlagProposerAddress))
+			if proposer.Empty() {
+				proposer = helper.GetFromAddress(cliCtx)
+			}
+
+			validator := viper.GetInt64(FlagValidatorID)
+			if validator == 0 {
+				return fmt.Errorf("validator ID cannot be 0")
+			}
+
+			txhash := viper.GetString(FlagTxHash)
+			if txhash == "" {
+				return fmt.Errorf("transaction hash has to be supplied")
+			}
+
+			contractCallerObj, err := helper.NewContractCaller()
+			if err != nil {
+				return err
+			}
+
+			// get main tx receipt
+			receipt, err := getConfirmedTxReceipt(cliCtx, &contractCallerObj, txhash)
+			if err != nil {
+				return err
+			}
+
+			// get jailed event
+			logIndex := uint64(viper.GetInt64(FlagLogIndex))
+			event, err := contractCallerObj.DecodeValidatorJailedEvent(receipt, logIndex)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgValidatorJailed(
+				proposer,
+				uint64(validator),
+				event.ExitEpoch.Uint64(),
+				hmTypes.HexToHeimdallHash(txhash),
+				logIndex,
+				receipt.BlockNumber.Uint64(),
+			)
+
+			// broadcast messages
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
+	cmd.Flags().Int(FlagValidatorID, 0, "--id=<validator-id>")
+	cmd.Flags().String(FlagTxHash, "", "--tx-hash=<transaction-hash>")
+	cmd.Flags().String(FlagLogIndex, "", "--log-index=<log-index>")
+	cmd.MarkFlagRequired(FlagTxHash)
+	cmd.MarkFlagRequired(FlagLogIndex)
+
+	return cmd
+}
+
+// SendValidatorUnjailedTx send validator unjailed transaction
+func SendValidatorUnjailedTx(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unjailed",
+		Short: "Unjail validator unjailed on mainchain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// get proposer
+			proposer := hmTypes.HexToHeimdallAddress(viper.GetString(FlagProposerAddress))
+			if proposer.Empty() {
+				proposer = helper.GetFromAddress(cliCtx)
+			}
+
+			validator := viper.GetInt64(FlagValidatorID)
+			if validator == 0 {
+				return fmt.Errorf("validator ID cannot be 0")
+			}
+
+			txhash := viper.GetString(FlagTxHash)
+			if txhash == "" {
+				return fmt.Errorf("transaction hash has to be supplied")
+			}
+
+			contractCallerObj, err := helper.NewContractCaller()
+			if err != nil {
+				return err
+			}
+
+			// get main tx receipt
+			receipt, err := getConfirmedTxReceipt(cliCtx, &contractCallerObj, txhash)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgValidatorUnjailed(
 				proposer,
 				uint64(validator),
 				hmTypes.HexToHeimdallHash(txhash),
 				uint64(viper.GetInt64(FlagLogIndex)),
+				receipt.BlockNumber.Uint64(),
+			)
+
+			// broadcast messages
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
+	cmd.Flags().Int(FlagValidatorID, 0, "--id=<validator-id>")
+	cmd.Flags().String(FlagTxHash, "", "--tx-hash=<transaction-hash>")
+	cmd.Flags().String(FlagLogIndex, "", "--log-index=<log-index>")
+	cmd.MarkFlagRequired(FlagTxHash)
+	cmd.MarkFlagRequired(FlagLogIndex)
+
+	return cmd
+}
+
+// SendValidatorReStakeTx send validator restake transaction
+func SendValidatorReStakeTx(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restake",
+		Short: "Update validator power after restake on mainchain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// get proposer
+			proposer := hmTypes.HexToHeimdallAddress(viper.GetString(FlagProposerAddress))
+			if proposer.Empty() {
+				proposer = helper.GetFromAddress(cliCtx)
+			}
+
+			validator := viper.GetInt64(FlagValidatorID)
+			if validator == 0 {
+				return fmt.Errorf("validator ID cannot be 0")
+			}
+
+			txhash := viper.GetString(FlagTxHash)
+			if txhash == "" {
+				return fmt.Errorf("transaction hash has to be supplied")
+			}
+
+			contractCallerObj, err := helper.NewContractCaller()
+			if err != nil {
+				return err
+			}
+
+			// get main tx receipt
+			receipt, err := getConfirmedTxReceipt(cliCtx, &contractCallerObj, txhash)
+			if err != nil {
+				return err
+			}
+
+			// get restaked event
+			logIndex := uint64(viper.GetInt64(FlagLogIndex))
+			event, err := contractCallerObj.DecodeValidatorReStakeEvent(receipt, logIndex)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgValidatorReStake(
+				proposer,
+				uint64(validator),
+				hmTypes.NewIntFromBigInt(event.Amount),
+				hmTypes.NewIntFromBigInt(event.Total),
+				hmTypes.HexToHeimdallHash(txhash),
+				logIndex,
+				receipt.BlockNumber.Uint64(),
 			)
 
 			// broadcast messages
@@ -283,3 +548,197 @@ func SendValidatorStakeUpdateTx(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// SendDelegatorBondTx send delegator bond transaction
+func SendDelegatorBondTx(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegator-bond",
+		Short: "Add delegator stake bonded on mainchain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// get proposer
+			proposer := hmTypes.HexToHeimdallAddress(viper.GetString(FlagProposerAddress))
+			if proposer.Empty() {
+				proposer = helper.GetFromAddress(cliCtx)
+			}
+
+			validator := viper.GetInt64(FlagValidatorID)
+			if validator == 0 {
+				return fmt.Errorf("validator ID cannot be 0")
+			}
+
+			txhash := viper.GetString(FlagTxHash)
+			if txhash == "" {
+				return fmt.Errorf("transaction hash has to be supplied")
+			}
+
+			contractCallerObj, err := helper.NewContractCaller()
+			if err != nil {
+				return err
+			}
+
+			// get main tx receipt
+			receipt, err := getConfirmedTxReceipt(cliCtx, &contractCallerObj, txhash)
+			if err != nil {
+				return err
+			}
+
+			// get share minted event
+			logIndex := uint64(viper.GetInt64(FlagLogIndex))
+			event, err := contractCallerObj.DecodeShareMintedEvent(receipt, logIndex)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDelegatorBond(
+				proposer,
+				uint64(validator),
+				hmTypes.BytesToHeimdallAddress(event.User.Bytes()),
+				hmTypes.NewIntFromBigInt(event.Amount),
+				hmTypes.NewIntFromBigInt(event.Tokens),
+				hmTypes.HexToHeimdallHash(txhash),
+				logIndex,
+				receipt.BlockNumber.Uint64(),
+			)
+
+			// broadcast messages
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
+	cmd.Flags().Int(FlagValidatorID, 0, "--id=<validator-id>")
+	cmd.Flags().String(FlagTxHash, "", "--tx-hash=<transaction-hash>")
+	cmd.Flags().String(FlagLogIndex, "", "--log-index=<log-index>")
+	cmd.MarkFlagRequired(FlagTxHash)
+	cmd.MarkFlagRequired(FlagLogIndex)
+
+	return cmd
+}
+
+// SendDelegatorUnbondTx send delegator unbond transaction
+func SendDelegatorUnbondTx(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegator-unbond",
+		Short: "Remove delegator stake unbonded on mainchain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// get proposer
+			proposer := hmTypes.HexToHeimdallAddress(viper.GetString(FlagProposerAddress))
+			if proposer.Empty() {
+				proposer = helper.GetFromAddress(cliCtx)
+			}
+
+			validator := viper.GetInt64(FlagValidatorID)
+			if validator == 0 {
+				return fmt.Errorf("validator ID cannot be 0")
+			}
+
+			txhash := viper.GetString(FlagTxHash)
+			if txhash == "" {
+				return fmt.Errorf("transaction hash has to be supplied")
+			}
+
+			contractCallerObj, err := helper.NewContractCaller()
+			if err != nil {
+				return err
+			}
+
+			// get main tx receipt
+			receipt, err := getConfirmedTxReceipt(cliCtx, &contractCallerObj, txhash)
+			if err != nil {
+				return err
+			}
+
+			// get share burned event
+			logIndex := uint64(viper.GetInt64(FlagLogIndex))
+			event, err := contractCallerObj.DecodeShareBurnedEvent(receipt, logIndex)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDelegatorUnbond(
+				proposer,
+				uint64(validator),
+				hmTypes.BytesToHeimdallAddress(event.User.Bytes()),
+				hmTypes.NewIntFromBigInt(event.Amount),
+				hmTypes.NewIntFromBigInt(event.Tokens),
+				hmTypes.HexToHeimdallHash(txhash),
+				logIndex,
+				receipt.BlockNumber.Uint64(),
+			)
+
+			// broadcast messages
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
+	cmd.Flags().Int(FlagValidatorID, 0, "--id=<validator-id>")
+	cmd.Flags().String(FlagTxHash, "", "--tx-hash=<transaction-hash>")
+	cmd.Flags().String(FlagLogIndex, "", "--log-index=<log-index>")
+	cmd.MarkFlagRequired(FlagTxHash)
+	cmd.MarkFlagRequired(FlagLogIndex)
+
+	return cmd
+}
+
+// SendEditValidatorMetadataTx send validator metadata edit transaction signed by validator signer
+func SendEditValidatorMetadataTx(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit-validator-metadata",
+		Short: "Edit moniker, website, contact and identity of validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			validator := viper.GetInt64(FlagValidatorID)
+			if validator == 0 {
+				return fmt.Errorf("validator ID cannot be 0")
+			}
+
+			msg := types.NewMsgEditValidatorMetadata(
+				helper.GetFromAddress(cliCtx),
+				uint64(validator),
+				viper.GetString(FlagMoniker),
+				viper.GetString(FlagWebsite),
+				viper.GetString(FlagContact),
+				viper.GetString(FlagIdentity),
+			)
+
+			// broadcast messages
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Int(FlagValidatorID, 0, "--id=<validator-id>")
+	cmd.Flags().String(FlagMoniker, "", "--moniker=<moniker>")
+	cmd.Flags().String(FlagWebsite, "", "--website=<website>")
+	cmd.Flags().String(FlagContact, "", "--contact=<contact>")
+	cmd.Flags().String(FlagIdentity, "", "--identity=<identity>")
+	cmd.MarkFlagRequired(FlagValidatorID)
+
+	return cmd
+}
+
+// getConfirmedTxReceipt fetches mainchain tx receipt confirmed by checkpoint confirmation blocks
+func getConfirmedTxReceipt(cliCtx context.CLIContext, contractCaller helper.IContractCaller, txhash string) (*ethTypes.Receipt, error) {
+	// get confirmation blocks from checkpoint params
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", checkpointTypes.QuerierRoute, checkpointTypes.QueryParams), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var checkpointParams checkpointTypes.Params
+	if err := json.Unmarshal(res, &checkpointParams); err != nil {
+		return nil, err
+	}
+
+	receipt, err := contractCaller.GetConfirmedTxReceipt(hmTypes.HexToHeimdallHash(txhash).EthHash(), checkpointParams.ConfirmationBlocks)
+	if err != nil || receipt == nil {
+		return nil, errors.New("Transaction is not confirmed yet. Please for sometime and try again")
+	}
+
+	return receipt, nil
+}
@@ -18,6 +18,10 @@ import (
 )
 
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc(
+		"/staking/params",
+		paramsHandlerFn(cliCtx),
+	).Methods("GET")
 	r.HandleFunc(
 		"/staking/signer/{address}",
 		validatorByAddressHandlerFn(cliCtx),
@@ -30,14 +34,30 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/staking/validator/{id}",
 		validatorByIDHandlerFn(cliCtx),
 	).Methods("GET")
+	r.HandleFunc(
+		"/staking/validator/{id}/delegations",
+		validatorDelegationsHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/staking/delegator/{address}/delegations",
+		delegatorDelegationsHandlerFn(cliCtx),
+	).Methods("GET")
 	r.HandleFunc(
 		"/staking/validator-set",
 		validatorSetHandlerFn(cliCtx),
 	).Methods("GET")
+	r.HandleFunc(
+		"/staking/validators",
+		validatorsHandlerFn(cliCtx),
+	).Methods("GET")
 	r.HandleFunc(
 		"/staking/proposer/{times}",
 		proposerHandlerFn(cliCtx),
 	).Methods("GET")
+	r.HandleFunc(
+		"/staking/proposer-schedule/{times}",
+		proposerScheduleHandlerFn(cliCtx),
+	).Methods("GET")
 	r.HandleFunc(
 		"/staking/current-proposer",
 		currentProposerHandlerFn(cliCtx),
@@ -68,6 +88,25 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	).Methods("GET")
 }
 
+// Returns staking params
+func paramsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams), nil)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // Returns validator information by signer address
 func validatorByAddressHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -193,9 +232,66 @@ func validatorByIDHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
-// get current validator set
+// Returns validators filtered by status, with aggregate stake statistics
+func validatorsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := r.URL.Query()
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get page
+		page := uint64(hmRest.DefaultPage)
+		if vars.Get("page") != "" {
+			if page, ok = rest.ParseUint64OrReturnBadRequest(w, vars.Get("page")); !ok {
+				return
+			}
+		}
+
+		// get limit
+		limit := uint64(hmRest.DefaultLimit)
+		if vars.Get("limit") != "" {
+			if limit, ok = rest.ParseUint64OrReturnBadRequest(w, vars.Get("limit")); !ok {
+				return
+			}
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorsParams(vars.Get("status"), page, limit))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidators), queryParams)
+		if err != nil {
+			RestLogger.Error("Error while fetching validators", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// error if no validators found
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No validators found"); !ok {
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// get current validator set, or validator set snapshot at ack count or height
 func validatorSetHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		// historical validator set is read from snapshots in latest state, so height isn't query height here
+		if r.URL.Query().Get("ack") != "" || r.URL.Query().Get("height") != "" {
+			validatorSetSnapshotHandler(cliCtx, w, r)
+			return
+		}
+
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
 		if !ok {
 			return
@@ -219,6 +315,45 @@ func validatorSetHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+// validatorSetSnapshotHandler writes validator set snapshot by ack or height query param
+func validatorSetSnapshotHandler(cliCtx context.CLIContext, w http.ResponseWriter, r *http.Request) {
+	var route string
+	var queryParams []byte
+	var err error
+
+	if ackStr := r.URL.Query().Get("ack"); ackStr != "" {
+		ack, ok := rest.ParseUint64OrReturnBadRequest(w, ackStr)
+		if !ok {
+			return
+		}
+		route = types.QueryValidatorSetByAck
+		queryParams, err = cliCtx.Codec.MarshalJSON(types.NewQueryValidatorSetAckParams(ack))
+	} else {
+		height, ok := rest.ParseInt64OrReturnBadRequest(w, r.URL.Query().Get("height"))
+		if !ok {
+			return
+		}
+		route = types.QueryValidatorSetByHeight
+		queryParams, err = cliCtx.Codec.MarshalJSON(types.NewQueryValidatorSetHeightParams(height))
+	}
+
+	if err != nil {
+		hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, route), queryParams)
+	if err != nil {
+		RestLogger.Error("Error while fetching validator set snapshot", "Error", err.Error())
+		hmRest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	// return result
+	cliCtx = cliCtx.WithHeight(height)
+	rest.PostProcessResponse(w, cliCtx, res)
+}
+
 // get proposer for current validator set
 func proposerHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -260,6 +395,47 @@ func proposerHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+// proposerScheduleHandlerFn get expected proposers for next ack counts including pending validator changes
+func proposerScheduleHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get number of future ack counts
+		times, ok := rest.ParseUint64OrReturnBadRequest(w, vars["times"])
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryProposerParams(times))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProposerSchedule), queryParams)
+		if err != nil {
+			RestLogger.Error("Error while fetching proposer schedule ", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// error if no proposer found
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No proposer found"); !ok {
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // currentProposerHandlerFn get proposer for current validator set
 func currentProposerHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -545,3 +721,70 @@ func VerifyAccountProofHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 
 	}
 }
+
+// Returns delegations bonded to validator by val ID
+func validatorDelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get id
+		id, ok := rest.ParseUint64OrReturnBadRequest(w, vars["id"])
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorParams(hmTypes.ValidatorID(id)))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorDelegations), queryParams)
+		if err != nil {
+			RestLogger.Error("Error while fetching validator delegations", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// Returns delegations of delegator by delegator address
+func delegatorDelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		delegatorAddress := hmTypes.HexToHeimdallAddress(vars["address"])
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryDelegatorParams(delegatorAddress))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDelegatorDelegations), queryParams)
+		if err != nil {
+			RestLogger.Error("Error while fetching delegator delegations", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -2,16 +2,20 @@ package staking
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/staking/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+
 	// get current val set
 	var vals []*hmTypes.Validator
 	if len(data.CurrentValSet.Validators) == 0 {
-		vals = data.Validators
+		// validators beyond max validators stay standby
+		vals = helper.RankValidators(data.Validators, keeper.GetMaxValidators(ctx))
 	} else {
 		vals = data.CurrentValSet.Validators
 	}
@@ -19,6 +23,11 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	// result
 	resultValSet := hmTypes.NewValidatorSet(vals)
 
+	// add standby validators in store
+	for _, validator := range data.Validators {
+		keeper.AddValidator(ctx, *validator)
+	}
+
 	// add validators in store
 	for _, validator := range resultValSet.Validators {
 		// Add individual validator to state
@@ -38,6 +47,27 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 		}
 	}
 
+	// Add genesis delegations
+	for _, delegation := range data.Delegations {
+		if err := keeper.SetDelegation(ctx, delegation); err != nil {
+			panic(err)
+		}
+	}
+
+	// Add genesis validator metadata
+	for _, metadata := range data.ValidatorMetadata {
+		if err := keeper.SetValidatorMetadata(ctx, metadata); err != nil {
+			panic(err)
+		}
+	}
+
+	// Add genesis validator stakes
+	for _, stake := range data.ValidatorStakes {
+		if err := keeper.storeValidatorStake(ctx, stake); err != nil {
+			panic(err)
+		}
+	}
+
 	// increament accum if init validator set
 	if len(data.CurrentValSet.Validators) == 0 {
 		keeper.IncrementAccum(ctx, 1)
@@ -48,8 +78,12 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	// return new genesis state
 	return types.NewGenesisState(
+		keeper.GetParams(ctx),
 		keeper.GetAllValidators(ctx),
 		keeper.GetValidatorSet(ctx),
 		keeper.GetAllDividendAccounts(ctx),
+		keeper.GetAllDelegations(ctx),
+		keeper.GetAllValidatorMetadata(ctx),
+		keeper.GetAllValidatorStakes(ctx),
 	)
 }
@@ -19,314 +19,391 @@ func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
 
 		switch msg := msg.(type) {
 		case types.MsgValidatorJoin:
-			return HandleMsgValidatorJoin(ctx, msg, k, contractCaller)
+			return HandleMsgValidatorJoin(ctx, msg, k)
 		case types.MsgValidatorExit:
-			return HandleMsgValidatorExit(ctx, msg, k, contractCaller)
+			return HandleMsgValidatorExit(ctx, msg, k)
 		case types.MsgSignerUpdate:
-			return HandleMsgSignerUpdate(ctx, msg, k, contractCaller)
+			return HandleMsgSignerUpdate(ctx, msg, k)
 		case types.MsgStakeUpdate:
-			return HandleMsgStakeUpdate(ctx, msg, k, contractCaller)
+			return HandleMsgStakeUpdate(ctx, msg, k)
+		case types.MsgValidatorJailed:
+			return HandleMsgValidatorJailed(ctx, msg, k)
+		case types.MsgValidatorUnjailed:
+			return HandleMsgValidatorUnjailed(ctx, msg, k)
+		case types.MsgValidatorReStake:
+			return HandleMsgValidatorReStake(ctx, msg, k)
+		case types.MsgDelegatorBond:
+			return HandleMsgDelegatorBond(ctx, msg, k)
+		case types.MsgDelegatorUnbond:
+			return HandleMsgDelegatorUnbond(ctx, msg, k)
+		case types.MsgEditValidatorMetadata:
+			return HandleMsgEditValidatorMetadata(ctx, msg, k)
 		default:
 			return sdk.ErrTxDecode("Invalid message in checkpoint module").Result()
 		}
 	}
 }
 
-// HandleMsgValidatorJoin msg validator join
-func HandleMsgValidatorJoin(ctx sdk.Context, msg types.MsgValidatorJoin, k Keeper, contractCaller helper.IContractCaller) sdk.Result {
+// HandleMsgValidatorJoin msg validator join, staked event is validated by side tx handler
+func HandleMsgValidatorJoin(ctx sdk.Context, msg types.MsgValidatorJoin, k Keeper) sdk.Result {
 	k.Logger(ctx).Info("Handing new validator join", "msg", msg)
 
-	if confirmed := contractCaller.IsTxConfirmed(msg.TxHash.EthHash()); !confirmed {
-		return hmCommon.ErrWaitForConfirmation(k.Codespace()).Result()
+	if err := validateValidatorJoin(ctx, msg, k); err != nil {
+		return err.Result()
 	}
 
-	// fetch validator from mainchain
-	validator, err := contractCaller.GetValidatorInfo(msg.ID)
-	if err != nil {
-		k.Logger(ctx).Error(
-			"Unable to fetch validator from rootchain",
-			"error", err,
-		)
-		return hmCommon.ErrNoValidator(k.Codespace()).Result()
-	}
-
-	if bytes.Equal(validator.Signer.Bytes(), helper.ZeroAddress.Bytes()) {
-		k.Logger(ctx).Error(
-			"No validator signer found",
-			"msgValidator", msg.ID,
-		)
-		return hmCommon.ErrNoValidator(k.Codespace()).Result()
-	}
-
-	k.Logger(ctx).Debug("Fetched validator from rootchain successfully", "validator", validator.String())
-
-	// Generate PubKey from Pubkey in message and signer
-	pubkey := msg.SignerPubKey
-	signer := pubkey.Address()
-
-	// check signer in message corresponds
-	if !bytes.Equal(signer.Bytes(), validator.Signer.Bytes()) {
-		k.Logger(ctx).Error(
-			"Signer Address does not match",
-			"msgValidator", signer.String(),
-			"mainchainValidator", validator.Signer.String(),
-		)
-		return hmCommon.ErrNoValidator(k.Codespace()).Result()
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
 	}
+}
 
+// validateValidatorJoin checks validator join against current state
+func validateValidatorJoin(ctx sdk.Context, msg types.MsgValidatorJoin, k Keeper) sdk.Error {
 	// Check if validator has been validator before
 	if _, ok := k.GetSignerFromValidatorID(ctx, msg.ID); ok {
 		k.Logger(ctx).Error("Validator has been validator before, cannot join with same ID", "validatorId", msg.ID)
-		return hmCommon.ErrValidatorAlreadyJoined(k.Codespace()).Result()
+		return hmCommon.ErrValidatorAlreadyJoined(k.Codespace())
 	}
 
 	// get validator by signer
+	signer := msg.SignerPubKey.Address()
 	checkVal, err := k.GetValidatorInfo(ctx, signer.Bytes())
 	if err == nil || bytes.Equal(checkVal.Signer.Bytes(), signer.Bytes()) {
-		return hmCommon.ErrValidatorAlreadyJoined(k.Codespace()).Result()
+		return hmCommon.ErrValidatorAlreadyJoined(k.Codespace())
 	}
 
-	// create new validator
-	newValidator := hmTypes.Validator{
-		ID:          validator.ID,
-		StartEpoch:  validator.StartEpoch,
-		EndEpoch:    validator.EndEpoch,
-		VotingPower: validator.VotingPower,
-		PubKey:      pubkey,
-		Signer:      validator.Signer,
-		LastUpdated: 0,
-	}
+	// sequence id
+	sequence := (msg.BlockNumber * hmTypes.DefaultLogIndexUnit) + msg.LogIndex
 
-	// add validator to store
-	k.Logger(ctx).Debug("Adding new validator to state", "validator", newValidator.String())
-	err = k.AddValidator(ctx, newValidator)
-	if err != nil {
-		k.Logger(ctx).Error("Unable to add validator to state", "error", err, "validator", newValidator.String())
-		return hmCommon.ErrValidatorSave(k.Codespace()).Result()
+	// check if staked event is already processed
+	if k.HasStakingSequence(ctx, sequence) {
+		k.Logger(ctx).Error("Older invalid tx found")
+		return hmCommon.ErrOldTx(k.Codespace())
 	}
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeValidatorJoin,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(newValidator.ID.Uint64(), 10)),
-			sdk.NewAttribute(types.AttributeKeySigner, newValidator.Signer.String()),
-		),
-	})
+	return nil
+}
+
+// HandleMsgStakeUpdate handles stake update message, stake update event is validated by side tx handler
+func HandleMsgStakeUpdate(ctx sdk.Context, msg types.MsgStakeUpdate, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("Handling stake update", "Validator", msg.ID)
+
+	if _, err := validateStakeUpdate(ctx, msg, k); err != nil {
+		return err.Result()
+	}
 
 	return sdk.Result{
 		Events: ctx.EventManager().Events(),
 	}
 }
 
-// HandleMsgStakeUpdate handles stake update message
-func HandleMsgStakeUpdate(ctx sdk.Context, msg types.MsgStakeUpdate, k Keeper, contractCaller helper.IContractCaller) sdk.Result {
-	k.Logger(ctx).Debug("Handling stake update", "Validator", msg.ID)
+// validateStakeUpdate checks stake update against current state
+func validateStakeUpdate(ctx sdk.Context, msg types.MsgStakeUpdate, k Keeper) (hmTypes.Validator, sdk.Error) {
+	// pull validator from store
+	validator, ok := k.GetValidatorFromValID(ctx, msg.ID)
+	if !ok {
+		k.Logger(ctx).Error("Fetching of validator from store failed", "validatorId", msg.ID)
+		return validator, hmCommon.ErrNoValidator(k.Codespace())
+	}
 
-	// get main tx receipt
-	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash())
-	if err != nil || receipt == nil {
-		return hmCommon.ErrWaitForConfirmation(k.Codespace()).Result()
+	// sequence id
+	sequence := (msg.BlockNumber * hmTypes.DefaultLogIndexUnit) + msg.LogIndex
+
+	// check if incoming tx is older
+	if k.HasStakingSequence(ctx, sequence) || sequence <= validator.LastUpdated {
+		k.Logger(ctx).Error("Older invalid tx found")
+		return validator, hmCommon.ErrOldTx(k.Codespace())
 	}
 
-	eventLog, err := contractCaller.DecodeValidatorStakeUpdateEvent(receipt, msg.LogIndex)
-	if err != nil || eventLog == nil {
-		k.Logger(ctx).Error("Error fetching log from txhash")
-		return hmCommon.ErrInvalidMsg(k.Codespace(), "Unable to fetch logs for txHash").Result()
+	return validator, nil
+}
+
+// HandleMsgSignerUpdate handles signer update message, signer change event is validated by side tx handler
+func HandleMsgSignerUpdate(ctx sdk.Context, msg types.MsgSignerUpdate, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("Handling signer update", "Validator", msg.ID, "Signer", msg.NewSignerPubKey.Address())
+
+	if _, err := validateSignerUpdate(ctx, msg, k); err != nil {
+		return err.Result()
 	}
 
-	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
-		k.Logger(ctx).Error("ID in message doesnt match id in logs", "MsgID", msg.ID, "IdFromTx", eventLog.ValidatorId)
-		return hmCommon.ErrInvalidMsg(k.Codespace(), "Invalid txhash, id's dont match. Id from tx hash is %v", eventLog.ValidatorId.Uint64()).Result()
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
 	}
+}
 
+// validateSignerUpdate checks signer update against current state
+func validateSignerUpdate(ctx sdk.Context, msg types.MsgSignerUpdate, k Keeper) (hmTypes.Validator, sdk.Error) {
 	// pull validator from store
 	validator, ok := k.GetValidatorFromValID(ctx, msg.ID)
 	if !ok {
 		k.Logger(ctx).Error("Fetching of validator from store failed", "validatorId", msg.ID)
-		return hmCommon.ErrNoValidator(k.Codespace()).Result()
+		return validator, hmCommon.ErrNoValidator(k.Codespace())
 	}
 
 	// sequence id
-	sequence := (receipt.BlockNumber.Uint64() * hmTypes.DefaultLogIndexUnit) + msg.LogIndex
+	sequence := (msg.BlockNumber * hmTypes.DefaultLogIndexUnit) + msg.LogIndex
 
 	// check if incoming tx is older
-	if k.HasStakingSequence(ctx, sequence) {
+	if k.HasStakingSequence(ctx, sequence) || sequence <= validator.LastUpdated {
 		k.Logger(ctx).Error("Older invalid tx found")
-		return hmCommon.ErrOldTx(k.Codespace()).Result()
+		return validator, hmCommon.ErrOldTx(k.Codespace())
 	}
 
-	// update last updated
-	validator.LastUpdated = sequence
+	return validator, nil
+}
+
+// HandleMsgValidatorExit handle msg validator exit, unstake init event is validated by side tx handler
+func HandleMsgValidatorExit(ctx sdk.Context, msg types.MsgValidatorExit, k Keeper) sdk.Result {
+	k.Logger(ctx).Info("Handling validator exit", "ValidatorID", msg.ID)
 
-	// set validator amount
-	p, err := helper.GetPowerFromAmount(eventLog.NewAmount)
-	if err != nil {
-		return hmCommon.ErrInvalidMsg(k.Codespace(), "Invalid amount for validator: %v", msg.ID).Result()
+	if _, err := validateValidatorExit(ctx, msg, k); err != nil {
+		return err.Result()
 	}
-	validator.VotingPower = p.Int64()
 
-	// save validator
-	err = k.AddValidator(ctx, validator)
-	if err != nil {
-		k.Logger(ctx).Error("Unable to update signer", "error", err, "ValidatorID", validator.ID)
-		return hmCommon.ErrSignerUpdateError(k.Codespace()).Result()
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
 	}
+}
 
-	// save staking sequence
-	k.SetStakingSequence(ctx, sequence)
+// validateValidatorExit checks validator exit against current state
+func validateValidatorExit(ctx sdk.Context, msg types.MsgValidatorExit, k Keeper) (hmTypes.Validator, sdk.Error) {
+	validator, ok := k.GetValidatorFromValID(ctx, msg.ID)
+	if !ok {
+		k.Logger(ctx).Error("Fetching of validator from store failed", "validatorID", msg.ID)
+		return validator, hmCommon.ErrNoValidator(k.Codespace())
+	}
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeStakeUpdate,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(validator.ID.Uint64(), 10)),
-			sdk.NewAttribute(types.AttributeKeyUpdatedAt, strconv.FormatUint(validator.LastUpdated, 10)),
-		),
-	})
+	k.Logger(ctx).Debug("validator in store", "validator", validator)
+	// check if validator deactivation period is set
+	if validator.EndEpoch != 0 {
+		k.Logger(ctx).Error("Validator already unbonded")
+		return validator, hmCommon.ErrValUnbonded(k.Codespace())
+	}
+
+	// sequence id
+	sequence := (msg.BlockNumber * hmTypes.DefaultLogIndexUnit) + msg.LogIndex
+
+	// check if incoming tx is older
+	if k.HasStakingSequence(ctx, sequence) || sequence <= validator.LastUpdated {
+		k.Logger(ctx).Error("Older invalid tx found")
+		return validator, hmCommon.ErrOldTx(k.Codespace())
+	}
+
+	return validator, nil
+}
+
+// HandleMsgValidatorJailed msg validator jailed, jailed event is validated by side tx handler
+func HandleMsgValidatorJailed(ctx sdk.Context, msg types.MsgValidatorJailed, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("Handling validator jailed", "Validator", msg.ID)
+
+	if _, err := validateValidatorJailed(ctx, msg, k); err != nil {
+		return err.Result()
+	}
 
 	return sdk.Result{
 		Events: ctx.EventManager().Events(),
 	}
 }
 
-// HandleMsgSignerUpdate handles signer update message
-func HandleMsgSignerUpdate(ctx sdk.Context, msg types.MsgSignerUpdate, k Keeper, contractCaller helper.IContractCaller) sdk.Result {
-	k.Logger(ctx).Debug("Handling signer update", "Validator", msg.ID, "Signer", msg.NewSignerPubKey.Address())
-
-	// get main tx receipt
-	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash())
-	if err != nil || receipt == nil {
-		return hmCommon.ErrWaitForConfirmation(k.Codespace()).Result()
+// validateValidatorJailed checks validator jailed against current state
+func validateValidatorJailed(ctx sdk.Context, msg types.MsgValidatorJailed, k Keeper) (hmTypes.Validator, sdk.Error) {
+	// pull validator from store
+	validator, ok := k.GetValidatorFromValID(ctx, msg.ID)
+	if !ok {
+		k.Logger(ctx).Error("Fetching of validator from store failed", "validatorId", msg.ID)
+		return validator, hmCommon.ErrNoValidator(k.Codespace())
 	}
 
-	newPubKey := msg.NewSignerPubKey
-	newSigner := newPubKey.Address()
+	// sequence id
+	sequence := (msg.BlockNumber * hmTypes.DefaultLogIndexUnit) + msg.LogIndex
 
-	eventLog, err := contractCaller.DecodeSignerUpdateEvent(receipt, msg.LogIndex)
-	if err != nil || eventLog == nil {
-		k.Logger(ctx).Error("Error fetching log from txhash")
-		return hmCommon.ErrInvalidMsg(k.Codespace(), "Unable to fetch signer update log for txHash").Result()
+	// check if incoming tx is older
+	if k.HasStakingSequence(ctx, sequence) || sequence <= validator.LastUpdated {
+		k.Logger(ctx).Error("Older invalid tx found")
+		return validator, hmCommon.ErrOldTx(k.Codespace())
 	}
 
-	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
-		k.Logger(ctx).Error("ID in message doesnt match id in logs", "MsgID", msg.ID, "IdFromTx", eventLog.ValidatorId.Uint64())
-		return hmCommon.ErrInvalidMsg(k.Codespace(), "Invalid txhash, id's dont match. Id from tx hash is %v", eventLog.ValidatorId.Uint64()).Result()
+	return validator, nil
+}
+
+// HandleMsgValidatorUnjailed msg validator unjailed, unjailed event is validated by side tx handler
+func HandleMsgValidatorUnjailed(ctx sdk.Context, msg types.MsgValidatorUnjailed, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("Handling validator unjailed", "Validator", msg.ID)
+
+	if _, err := validateValidatorUnjailed(ctx, msg, k); err != nil {
+		return err.Result()
 	}
 
-	if bytes.Compare(eventLog.NewSigner.Bytes(), newSigner.Bytes()) != 0 {
-		k.Logger(ctx).Error("Signer in txhash and msg dont match", "MsgSigner", newSigner.String(), "SignerTx", eventLog.NewSigner.String())
-		return hmCommon.ErrInvalidMsg(k.Codespace(), "Signer in txhash and msg dont match").Result()
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
 	}
+}
 
+// validateValidatorUnjailed checks validator unjailed against current state
+func validateValidatorUnjailed(ctx sdk.Context, msg types.MsgValidatorUnjailed, k Keeper) (hmTypes.Validator, sdk.Error) {
 	// pull validator from store
 	validator, ok := k.GetValidatorFromValID(ctx, msg.ID)
 	if !ok {
 		k.Logger(ctx).Error("Fetching of validator from store failed", "validatorId", msg.ID)
-		return hmCommon.ErrNoValidator(k.Codespace()).Result()
+		return validator, hmCommon.ErrNoValidator(k.Codespace())
+	}
+
+	if !validator.Jailed {
+		k.Logger(ctx).Error("Validator is not jailed", "validatorId", msg.ID)
+		return validator, hmCommon.ErrValidatorNotJailed(k.Codespace())
 	}
-	oldValidator := validator.Copy()
 
 	// sequence id
-	sequence := (receipt.BlockNumber.Uint64() * hmTypes.DefaultLogIndexUnit) + msg.LogIndex
+	sequence := (msg.BlockNumber * hmTypes.DefaultLogIndexUnit) + msg.LogIndex
 
 	// check if incoming tx is older
-	if k.HasStakingSequence(ctx, sequence) {
+	if k.HasStakingSequence(ctx, sequence) || sequence <= validator.LastUpdated {
 		k.Logger(ctx).Error("Older invalid tx found")
-		return hmCommon.ErrOldTx(k.Codespace()).Result()
+		return validator, hmCommon.ErrOldTx(k.Codespace())
 	}
 
-	// update last udpated
-	validator.LastUpdated = sequence
+	return validator, nil
+}
+
+// HandleMsgValidatorReStake msg validator restake, restaked event is validated by side tx handler
+func HandleMsgValidatorReStake(ctx sdk.Context, msg types.MsgValidatorReStake, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("Handling validator restake", "Validator", msg.ID)
 
-	// check if we are actually updating signer
-	if !bytes.Equal(newSigner.Bytes(), validator.Signer.Bytes()) {
-		// Update signer in prev Validator
-		validator.Signer = hmTypes.HeimdallAddress(newSigner)
-		validator.PubKey = newPubKey
-		k.Logger(ctx).Debug("Updating new signer", "signer", newSigner.String(), "oldSigner", oldValidator.Signer.String(), "validatorID", msg.ID)
+	if _, err := validateValidatorReStake(ctx, msg, k); err != nil {
+		return err.Result()
 	}
 
-	k.Logger(ctx).Debug("Removing old validator", "validator", oldValidator.String())
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
 
-	// remove old validator from HM
-	oldValidator.EndEpoch = k.ackRetriever.GetACKCount(ctx)
+// validateValidatorReStake checks validator restake against current state
+func validateValidatorReStake(ctx sdk.Context, msg types.MsgValidatorReStake, k Keeper) (hmTypes.Validator, sdk.Error) {
+	// pull validator from store
+	validator, ok := k.GetValidatorFromValID(ctx, msg.ID)
+	if !ok {
+		k.Logger(ctx).Error("Fetching of validator from store failed", "validatorId", msg.ID)
+		return validator, hmCommon.ErrNoValidator(k.Codespace())
+	}
 
-	// remove old validator from TM
-	oldValidator.VotingPower = 0
-	// updated last
-	oldValidator.LastUpdated = sequence
+	// sequence id
+	sequence := (msg.BlockNumber * hmTypes.DefaultLogIndexUnit) + msg.LogIndex
 
-	// save old validator
-	if err := k.AddValidator(ctx, *oldValidator); err != nil {
-		k.Logger(ctx).Error("Unable to update signer", "error", err, "validatorId", validator.ID)
-		return hmCommon.ErrSignerUpdateError(k.Codespace()).Result()
+	// check if incoming tx is older
+	if k.HasStakingSequence(ctx, sequence) || sequence <= validator.LastUpdated {
+		k.Logger(ctx).Error("Older invalid tx found")
+		return validator, hmCommon.ErrOldTx(k.Codespace())
 	}
 
-	// adding new validator
-	k.Logger(ctx).Debug("Adding new validator", "validator", validator.String())
+	return validator, nil
+}
+
+// HandleMsgDelegatorBond msg delegator bond, share minted event is validated by side tx handler
+func HandleMsgDelegatorBond(ctx sdk.Context, msg types.MsgDelegatorBond, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("Handling delegator bond", "Validator", msg.ID)
 
-	// save validator
-	err = k.AddValidator(ctx, validator)
-	if err != nil {
-		k.Logger(ctx).Error("Unable to update signer", "error", err, "ValidatorID", validator.ID)
-		return hmCommon.ErrSignerUpdateError(k.Codespace()).Result()
+	if _, err := validateDelegatorBond(ctx, msg, k); err != nil {
+		return err.Result()
 	}
-	// save staking sequence
-	k.SetStakingSequence(ctx, sequence)
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeSignerUpdate,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(validator.ID.Uint64(), 10)),
-			sdk.NewAttribute(types.AttributeKeyUpdatedAt, strconv.FormatUint(validator.LastUpdated, 10)),
-		),
-	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// validateDelegatorBond checks delegator bond against current state, returns delegation to be bonded
+func validateDelegatorBond(ctx sdk.Context, msg types.MsgDelegatorBond, k Keeper) (hmTypes.Delegation, sdk.Error) {
+	// delegation is only tracked for known validators
+	if _, ok := k.GetValidatorFromValID(ctx, msg.ID); !ok {
+		k.Logger(ctx).Error("Fetching of validator from store failed", "validatorId", msg.ID)
+		return hmTypes.Delegation{}, hmCommon.ErrNoValidator(k.Codespace())
+	}
+
+	// sequence id
+	sequence := (msg.BlockNumber * hmTypes.DefaultLogIndexUnit) + msg.LogIndex
+
+	delegation, ok := k.GetDelegation(ctx, msg.ID, msg.Delegator)
+	if !ok {
+		delegation = hmTypes.NewDelegation(msg.ID, msg.Delegator, "0", "0")
+	}
+
+	// bond adds to delegation, so it is applied in any order and only replays are rejected
+	if k.HasStakingSequence(ctx, sequence) {
+		k.Logger(ctx).Error("Older invalid tx found")
+		return delegation, hmCommon.ErrOldTx(k.Codespace())
+	}
+
+	return delegation, nil
+}
+
+// HandleMsgDelegatorUnbond msg delegator unbond, share burned event is validated by side tx handler
+func HandleMsgDelegatorUnbond(ctx sdk.Context, msg types.MsgDelegatorUnbond, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("Handling delegator unbond", "Validator", msg.ID)
+
+	if _, err := validateDelegatorUnbond(ctx, msg, k); err != nil {
+		return err.Result()
+	}
 
 	return sdk.Result{
 		Events: ctx.EventManager().Events(),
 	}
 }
 
-// HandleMsgValidatorExit handle msg validator exit
-func HandleMsgValidatorExit(ctx sdk.Context, msg types.MsgValidatorExit, k Keeper, contractCaller helper.IContractCaller) sdk.Result {
-	k.Logger(ctx).Info("Handling validator exit", "ValidatorID", msg.ID)
+// validateDelegatorUnbond checks delegator unbond against current state, returns delegation to be unbonded
+func validateDelegatorUnbond(ctx sdk.Context, msg types.MsgDelegatorUnbond, k Keeper) (hmTypes.Delegation, sdk.Error) {
+	// delegation is only tracked for known validators
+	if _, ok := k.GetValidatorFromValID(ctx, msg.ID); !ok {
+		k.Logger(ctx).Error("Fetching of validator from store failed", "validatorId", msg.ID)
+		return hmTypes.Delegation{}, hmCommon.ErrNoValidator(k.Codespace())
+	}
+
+	// sequence id
+	sequence := (msg.BlockNumber * hmTypes.DefaultLogIndexUnit) + msg.LogIndex
+
+	// unbond might be voted before bond it burns shares of
+	delegation, ok := k.GetDelegation(ctx, msg.ID, msg.Delegator)
+	if !ok {
+		delegation = hmTypes.NewDelegation(msg.ID, msg.Delegator, "0", "0")
+	}
 
-	if confirmed := contractCaller.IsTxConfirmed(msg.TxHash.EthHash()); !confirmed {
-		return hmCommon.ErrWaitForConfirmation(k.Codespace()).Result()
+	// unbond subtracts from delegation, so it is applied in any order and only replays are rejected
+	if k.HasStakingSequence(ctx, sequence) {
+		k.Logger(ctx).Error("Older invalid tx found")
+		return delegation, hmCommon.ErrOldTx(k.Codespace())
 	}
+
+	return delegation, nil
+}
+
+// HandleMsgEditValidatorMetadata handles metadata update signed by validator signer
+func HandleMsgEditValidatorMetadata(ctx sdk.Context, msg types.MsgEditValidatorMetadata, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("Handling validator metadata edit", "validatorID", msg.ID)
+
 	validator, ok := k.GetValidatorFromValID(ctx, msg.ID)
 	if !ok {
 		k.Logger(ctx).Error("Fetching of validator from store failed", "validatorID", msg.ID)
 		return hmCommon.ErrNoValidator(k.Codespace()).Result()
 	}
 
-	k.Logger(ctx).Debug("validator in store", "validator", validator)
-	// check if validator deactivation period is set
-	if validator.EndEpoch != 0 {
-		k.Logger(ctx).Error("Validator already unbonded")
-		return hmCommon.ErrValUnbonded(k.Codespace()).Result()
-	}
-
-	// get validator from mainchain
-	updatedVal, err := contractCaller.GetValidatorInfo(validator.ID)
-	if err != nil {
-		k.Logger(ctx).Error("Cannot fetch validator info while unstaking", "Error", err, "validatorID", validator.ID)
-		return hmCommon.ErrNoValidator(k.Codespace()).Result()
+	// only signer of validator can edit its metadata
+	if !bytes.Equal(validator.Signer.Bytes(), msg.From.Bytes()) {
+		k.Logger(ctx).Error("Signer address does not match", "validatorSigner", validator.Signer.String(), "msgFrom", msg.From.String())
+		return hmCommon.ErrValSignerMismatch(k.Codespace()).Result()
 	}
 
-	// Add deactivation time for validator
-	if err := k.AddDeactivationEpoch(ctx, validator, updatedVal); err != nil {
-		k.Logger(ctx).Error("Error while setting deactivation epoch to validator", "error", err, "validatorID", validator.ID)
-		return hmCommon.ErrValidatorNotDeactivated(k.Codespace()).Result()
+	metadata := msg.GetMetadata()
+	if err := k.SetValidatorMetadata(ctx, metadata); err != nil {
+		k.Logger(ctx).Error("Unable to store validator metadata", "error", err, "validatorID", msg.ID)
+		return hmCommon.ErrInvalidMsg(k.Codespace(), "Unable to store validator metadata").Result()
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
-			types.EventTypeValidatorExit,
+			types.EventTypeValidatorMetadata,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(validator.ID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(msg.ID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeyMoniker, metadata.Moniker),
 		),
 	})
 
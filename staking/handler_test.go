@@ -2,17 +2,20 @@ package staking_test
 
 import (
 	"math/big"
+	"strings"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/common"
 	ethTypes "github.com/maticnetwork/bor/core/types"
-	"github.com/maticnetwork/heimdall/contracts/stakemanager"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
 	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/helper/mocks"
 	"github.com/maticnetwork/heimdall/staking"
 	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
 	cmn "github.com/maticnetwork/heimdall/test"
 	"github.com/maticnetwork/heimdall/types"
-	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -23,29 +26,89 @@ func TestHandleMsgValidatorJoin(t *testing.T) {
 	// select first validator from slice
 	mockVal := mockVals[0]
 	t.Log("Inserting ===>", "Validator", mockVal.Signer.String())
-	contractCallerObj.On("GetValidatorInfo", mock.Anything).Return(mockVal, nil)
 	// insert new validator
-	// msgTxHash := types.HeimdallHash("123")
 	msgTxHash := types.HexToHeimdallHash("123")
-	contractCallerObj.On("IsTxConfirmed", msgTxHash.EthHash()).Return(true)
-	msgValJoin := stakingTypes.NewMsgValidatorJoin(mockVal.Signer, uint64(mockVal.ID), mockVal.PubKey, msgTxHash, 0)
+	amount := new(big.Int).Mul(big.NewInt(mockVal.VotingPower), big.NewInt(1000000000000000000))
+	msgValJoin := stakingTypes.NewMsgValidatorJoin(mockVal.Signer, uint64(mockVal.ID), 1, types.NewIntFromBigInt(amount), mockVal.PubKey, msgTxHash, 0, 10)
 	t.Log("msg val join", msgValJoin)
-	got := staking.HandleMsgValidatorJoin(ctx, msgValJoin, keeper, &contractCallerObj)
+	got := staking.HandleMsgValidatorJoin(ctx, msgValJoin, keeper)
 	require.True(t, got.IsOK(), "expected validator join to be ok, got %v", got)
+	// validator is not added before validators vote on it
+	_, err := keeper.GetValidatorInfo(ctx, mockVal.Signer.Bytes())
+	require.NotEmpty(t, err, "Validator should not be added before side tx is voted")
+
+	// side tx handler validates staked event on mainchain
+	txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
+	contractCallerObj.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), checkpointTypes.DefaultConfirmationBlocks).Return(txreceipt, nil)
+	stakedEvent := &stakinginfo.StakinginfoStaked{
+		Signer:          mockVal.Signer.EthAddress(),
+		ValidatorId:     new(big.Int).SetUint64(mockVal.ID.Uint64()),
+		ActivationEpoch: big.NewInt(1),
+		Amount:          amount,
+		SignerPubkey:    mockVal.PubKey.Bytes(),
+	}
+	contractCallerObj.On("DecodeValidatorJoinEvent", txreceipt, uint64(0)).Return(stakedEvent, nil)
+	sideResult := staking.SideHandleMsgValidatorJoin(ctx, msgValJoin, keeper, &contractCallerObj)
+	require.Equal(t, types.SideTxResultYes, sideResult, "expected side tx result to be yes, got %v", sideResult)
+
+	// post tx handler adds validator
+	got = staking.PostHandleMsgValidatorJoin(ctx, msgValJoin, sideResult, keeper)
+	require.True(t, got.IsOK(), "expected validator join post tx to be ok, got %v", got)
 	// validator is stored properly and signer is created properly
 	storedVal, err := keeper.GetValidatorInfo(ctx, mockVal.Signer.Bytes())
 	require.Empty(t, err, "Unable to get validator info from val address,ValAddr:%v Error:%v ", mockVal.Signer.String(), err)
 	require.Equal(t, mockVal.Signer, storedVal.Signer, "Signer address should match")
+	require.Equal(t, mockVal.VotingPower, storedVal.VotingPower, "Voting power should match stake amount")
 	t.Log("Stored ===>", "Validator", storedVal.String())
 	// signer to validator mapping should exist properly
 	storedSigner, found := keeper.GetSignerFromValidatorID(ctx, mockVal.ID)
 	require.True(t, found, "signer and validator address should be mapped, got %v", found)
 	require.Equal(t, mockVal.Signer.Bytes(), storedSigner.Bytes(), "Signer address in signer=>validator map should be same")
 	t.Log("Mapped validator ID and Signer ===>", "ID", mockVal.ID, "Signer", storedSigner.String())
+	// staked event sequence is stored
+	sequence := uint64(10 * types.DefaultLogIndexUnit)
+	require.True(t, keeper.HasStakingSequence(ctx, sequence), "staking sequence of staked event should be stored")
+	require.Equal(t, sequence, storedVal.LastUpdated, "LastUpdated should be set to staking sequence")
 	// insert validator again
-	got = staking.HandleMsgValidatorJoin(ctx, msgValJoin, keeper, &contractCallerObj)
+	got = staking.HandleMsgValidatorJoin(ctx, msgValJoin, keeper)
 	require.True(t, !got.IsOK(), "expected validator join to be not-ok, got %v", got)
-	// check if new validator gets added in validator set
+	// same staked event relayed by another validator is rejected
+	relayer := cmn.GenRandomVal(1, 0, 10, 10, false, 1)[0].Signer
+	relayedJoin := stakingTypes.NewMsgValidatorJoin(relayer, uint64(mockVal.ID), 1, types.NewIntFromBigInt(amount), mockVal.PubKey, msgTxHash, 0, 10)
+	got = staking.PostHandleMsgValidatorJoin(ctx, relayedJoin, types.SideTxResultYes, keeper)
+	require.True(t, !got.IsOK(), "expected duplicate validator join to be not-ok, got %v", got)
+}
+
+func TestSideHandleMsgValidatorJoinMismatch(t *testing.T) {
+	contractCallerObj := mocks.IContractCaller{}
+	ctx, keeper, _ := cmn.CreateTestInput(t, false)
+	mockVal := cmn.GenRandomVal(1, 0, 10, 10, false, 1)[0]
+
+	msgTxHash := types.HexToHeimdallHash("123")
+	msgValJoin := stakingTypes.NewMsgValidatorJoin(mockVal.Signer, uint64(mockVal.ID), 1, types.NewInt(1000), mockVal.PubKey, msgTxHash, 0, 10)
+	txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
+	contractCallerObj.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), checkpointTypes.DefaultConfirmationBlocks).Return(txreceipt, nil)
+	// amount in staked event differs from msg
+	stakedEvent := &stakinginfo.StakinginfoStaked{
+		Signer:          mockVal.Signer.EthAddress(),
+		ValidatorId:     new(big.Int).SetUint64(mockVal.ID.Uint64()),
+		ActivationEpoch: big.NewInt(1),
+		Amount:          big.NewInt(2000),
+		SignerPubkey:    mockVal.PubKey.Bytes(),
+	}
+	contractCallerObj.On("DecodeValidatorJoinEvent", txreceipt, uint64(0)).Return(stakedEvent, nil)
+	sideResult := staking.SideHandleMsgValidatorJoin(ctx, msgValJoin, keeper, &contractCallerObj)
+	require.Equal(t, types.SideTxResultNo, sideResult, "expected side tx result to be no, got %v", sideResult)
+
+	// block number in msg differs from receipt
+	wrongBlockJoin := stakingTypes.NewMsgValidatorJoin(mockVal.Signer, uint64(mockVal.ID), 1, types.NewInt(2000), mockVal.PubKey, msgTxHash, 0, 11)
+	require.Equal(t, types.SideTxResultNo, staking.SideHandleMsgValidatorJoin(ctx, wrongBlockJoin, keeper, &contractCallerObj), "expected side tx result to be no for wrong block number")
+
+	// nothing is added on no vote
+	got := staking.PostHandleMsgValidatorJoin(ctx, msgValJoin, sideResult, keeper)
+	require.True(t, got.IsOK(), "expected post tx to be ok, got %v", got)
+	_, err := keeper.GetValidatorInfo(ctx, mockVal.Signer.Bytes())
+	require.NotEmpty(t, err, "Validator should not be added on no vote")
 }
 
 func TestHandleMsgValidatorUpdate(t *testing.T) {
@@ -64,18 +127,26 @@ func TestHandleMsgValidatorUpdate(t *testing.T) {
 	t.Log("To be Updated ===>", "Validator", newSigner[0].String())
 	// gen msg
 	msgTxHash := types.HexToHeimdallHash("123")
-	msg := stakingTypes.NewMsgSignerUpdate(newSigner[0].Signer, uint64(newSigner[0].ID), newSigner[0].PubKey, msgTxHash, 0)
+	msg := stakingTypes.NewMsgSignerUpdate(newSigner[0].Signer, uint64(newSigner[0].ID), newSigner[0].PubKey, msgTxHash, 0, 10)
 	txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
-	contractCallerObj.On("GetConfirmedTxReceipt", msgTxHash.EthHash()).Return(txreceipt, nil)
-	signerUpdateEvent := &stakemanager.StakemanagerSignerChange{
-		ValidatorId: new(big.Int).SetUint64(oldSigner.ID.Uint64()),
-		OldSigner:   oldSigner.Signer.EthAddress(),
-		NewSigner:   newSigner[0].Signer.EthAddress(),
+	contractCallerObj.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), checkpointTypes.DefaultConfirmationBlocks).Return(txreceipt, nil)
+	signerUpdateEvent := &stakinginfo.StakinginfoSignerChange{
+		ValidatorId:  new(big.Int).SetUint64(oldSigner.ID.Uint64()),
+		OldSigner:    oldSigner.Signer.EthAddress(),
+		NewSigner:    newSigner[0].Signer.EthAddress(),
+		SignerPubkey: newSigner[0].PubKey.Bytes(),
 	}
 	contractCallerObj.On("DecodeSignerUpdateEvent", txreceipt, uint64(0)).Return(signerUpdateEvent, nil)
 
-	got := staking.HandleMsgSignerUpdate(ctx, msg, keeper, &contractCallerObj)
+	got := staking.HandleMsgSignerUpdate(ctx, msg, keeper)
 	require.True(t, got.IsOK(), "expected validator update to be ok, got %v", got)
+	_, err := keeper.GetValidatorInfo(ctx, newSigner[0].Signer.Bytes())
+	require.NotEmpty(t, err, "New signer should not be added before side tx is voted")
+
+	sideResult := staking.SideHandleMsgSignerUpdate(ctx, msg, keeper, &contractCallerObj)
+	require.Equal(t, types.SideTxResultYes, sideResult, "expected side tx result to be yes, got %v", sideResult)
+	got = staking.PostHandleMsgSignerUpdate(ctx, msg, sideResult, keeper)
+	require.True(t, got.IsOK(), "expected validator update post tx to be ok, got %v", got)
 	newValidators := keeper.GetCurrentValidators(ctx)
 	require.Equal(t, len(oldValSet.Validators), len(newValidators), "Number of current validators should be equal")
 	// apply updates
@@ -84,7 +155,7 @@ func TestHandleMsgValidatorUpdate(t *testing.T) {
 	// 	keeper.GetAllValidators(ctx),        // All validators
 	// 	checkpointkeeper.GetACKCount(ctx)+1, // ack count
 	// )
-	setUpdates := helper.GetUpdatedValidators(&oldValSet, keeper.GetAllValidators(ctx), 5)
+	setUpdates := helper.GetUpdatedValidators(&oldValSet, keeper.GetAllValidators(ctx), 5, keeper.GetMaxValidators(ctx), keeper.MinSelfStakeFilter(ctx))
 	oldValSet.UpdateWithChangeSet(setUpdates)
 	_ = keeper.UpdateValidatorSetInStore(ctx, oldValSet)
 
@@ -106,19 +177,36 @@ func TestHandleMsgValidatorExit(t *testing.T) {
 	cmn.LoadValidatorSet(4, t, keeper, ctx, false, 0)
 	validators := keeper.GetCurrentValidators(ctx)
 	msgTxHash := types.HexToHeimdallHash("123")
-	contractCallerObj.On("IsTxConfirmed", msgTxHash.EthHash()).Return(true)
+	txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
+	contractCallerObj.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), checkpointTypes.DefaultConfirmationBlocks).Return(txreceipt, nil)
+	contractCallerObj.On("DecodeValidatorExitEvent", txreceipt, uint64(0)).Return(&stakinginfo.StakinginfoUnstakeInit{
+		ValidatorId:       new(big.Int).SetUint64(validators[0].ID.Uint64()),
+		DeactivationEpoch: big.NewInt(10),
+	}, nil)
 
-	validators[0].EndEpoch = 10
-	msg := stakingTypes.NewMsgValidatorExit(validators[0].Signer, uint64(validators[0].ID), msgTxHash, 0)
-	contractCallerObj.On("GetValidatorInfo", validators[0].ID).Return(validators[0], nil)
-	got := staking.HandleMsgValidatorExit(ctx, msg, keeper, &contractCallerObj)
+	// deactivation epoch in msg must match deactivation epoch in event
+	invalidMsg := stakingTypes.NewMsgValidatorExit(validators[0].Signer, uint64(validators[0].ID), 11, msgTxHash, 0, 10)
+	sideResult := staking.SideHandleMsgValidatorExit(ctx, invalidMsg, keeper, &contractCallerObj)
+	require.Equal(t, types.SideTxResultNo, sideResult, "expected validator exit with mismatched deactivation epoch to be voted no")
+
+	msg := stakingTypes.NewMsgValidatorExit(validators[0].Signer, uint64(validators[0].ID), 10, msgTxHash, 0, 10)
+	got := staking.HandleMsgValidatorExit(ctx, msg, keeper)
 	require.True(t, got.IsOK(), "expected validator exit to be ok, got %v", got)
 	updatedValInfo, err := keeper.GetValidatorInfo(ctx, validators[0].Signer.Bytes())
+	require.Empty(t, err)
+	require.Equal(t, uint64(0), updatedValInfo.EndEpoch, "deactivation epoch should not be set before side tx is voted")
+
+	sideResult = staking.SideHandleMsgValidatorExit(ctx, msg, keeper, &contractCallerObj)
+	require.Equal(t, types.SideTxResultYes, sideResult, "expected side tx result to be yes, got %v", sideResult)
+	got = staking.PostHandleMsgValidatorExit(ctx, msg, sideResult, keeper)
+	require.True(t, got.IsOK(), "expected validator exit post tx to be ok, got %v", got)
+	updatedValInfo, err = keeper.GetValidatorInfo(ctx, validators[0].Signer.Bytes())
 	require.Empty(t, err, "Unable to get validator info from val address,ValAddr:%v Error:%v ", validators[0].Signer.String(), err)
-	require.Equal(t, updatedValInfo.EndEpoch, validators[0].EndEpoch, "deactivation epoch should be set correctly")
+	require.Equal(t, uint64(10), updatedValInfo.EndEpoch, "deactivation epoch should be set correctly")
+	require.True(t, keeper.HasStakingSequence(ctx, 10*types.DefaultLogIndexUnit), "staking sequence of unstake init event should be stored")
 	_, found := keeper.GetValidatorFromValID(ctx, validators[0].ID)
 	require.True(t, found, "Validator should be present even after deactivation")
-	got = staking.HandleMsgValidatorExit(ctx, msg, keeper, &contractCallerObj)
+	got = staking.HandleMsgValidatorExit(ctx, msg, keeper)
 	require.True(t, !got.IsOK(), "validator already exited. cannot exit again")
 
 	currentVals := keeper.GetCurrentValidators(ctx)
@@ -141,21 +229,299 @@ func TestHandleMsgStakeUpdate(t *testing.T) {
 	t.Log("To be Updated ===>", "Validator", oldVal.String())
 	// gen msg
 	msgTxHash := types.HexToHeimdallHash("123")
-	msg := stakingTypes.NewMsgStakeUpdate(oldVal.Signer, oldVal.ID.Uint64(), msgTxHash, 0)
+	msg := stakingTypes.NewMsgStakeUpdate(oldVal.Signer, oldVal.ID.Uint64(), types.NewInt(2000000000000000000), msgTxHash, 0, 10)
 	txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
-	contractCallerObj.On("GetConfirmedTxReceipt", msgTxHash.EthHash()).Return(txreceipt, nil)
-	stakeUpdateEvent := &stakemanager.StakemanagerStakeUpdate{
+	contractCallerObj.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), checkpointTypes.DefaultConfirmationBlocks).Return(txreceipt, nil)
+	stakeUpdateEvent := &stakinginfo.StakinginfoStakeUpdate{
 		ValidatorId: new(big.Int).SetUint64(oldVal.ID.Uint64()),
-		OldAmount:   new(big.Int).SetInt64(oldVal.VotingPower),
 		NewAmount:   new(big.Int).SetInt64(2000000000000000000),
 	}
 
 	contractCallerObj.On("DecodeValidatorStakeUpdateEvent", txreceipt, uint64(0)).Return(stakeUpdateEvent, nil)
 
-	got := staking.HandleMsgStakeUpdate(ctx, msg, keeper, &contractCallerObj)
+	got := staking.HandleMsgStakeUpdate(ctx, msg, keeper)
 	require.True(t, got.IsOK(), "expected validator stake update to be ok, got %v", got)
+
+	// amount in msg must match new amount in event
+	invalidMsg := stakingTypes.NewMsgStakeUpdate(oldVal.Signer, oldVal.ID.Uint64(), types.NewInt(3000000000000000000), msgTxHash, 0, 10)
+	require.Equal(t, types.SideTxResultNo, staking.SideHandleMsgStakeUpdate(ctx, invalidMsg, keeper, &contractCallerObj), "expected side tx result to be no for mismatched amount")
+
+	sideResult := staking.SideHandleMsgStakeUpdate(ctx, msg, keeper, &contractCallerObj)
+	require.Equal(t, types.SideTxResultYes, sideResult, "expected side tx result to be yes, got %v", sideResult)
+	got = staking.PostHandleMsgStakeUpdate(ctx, msg, sideResult, keeper)
+	require.True(t, got.IsOK(), "expected validator stake update post tx to be ok, got %v", got)
 	updatedVal, err := keeper.GetValidatorInfo(ctx, oldVal.Signer.Bytes())
 	require.Empty(t, err, "unable to fetch validator info %v-", err)
-	require.Equal(t, stakeUpdateEvent.NewAmount.Int64(), updatedVal.VotingPower, "Validator VotingPower should be updated to %v", stakeUpdateEvent.NewAmount.Uint64())
+	require.Equal(t, int64(2), updatedVal.VotingPower, "Validator VotingPower should be updated to stake in tokens")
+	require.Equal(t, int64(2000000000000000000), stakeUpdateEvent.NewAmount.Int64(), "event amount should not be modified")
+
+}
+
+func TestHandleMsgValidatorJailed(t *testing.T) {
+	contractCallerObj := mocks.IContractCaller{}
+	ctx, keeper, _ := cmn.CreateTestInput(t, false)
+
+	// pass 0 as time alive to generate non de-activated validators
+	cmn.LoadValidatorSet(4, t, keeper, ctx, false, 0)
+	oldValSet := keeper.GetValidatorSet(ctx)
+	oldVal := oldValSet.Validators[0]
+
+	// unjail isn't allowed for validator who isn't jailed
+	unjailTxHash := types.HexToHeimdallHash("456")
+	unjailReceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(20)}
+	contractCallerObj.On("GetConfirmedTxReceipt", unjailTxHash.EthHash(), checkpointTypes.DefaultConfirmationBlocks).Return(unjailReceipt, nil)
+	unjailedEvent := &stakinginfo.StakinginfoUnJailed{
+		ValidatorId: new(big.Int).SetUint64(oldVal.ID.Uint64()),
+	}
+	contractCallerObj.On("DecodeValidatorUnJailedEvent", unjailReceipt, uint64(0)).Return(unjailedEvent, nil)
+	unjailMsg := stakingTypes.NewMsgValidatorUnjailed(oldVal.Signer, oldVal.ID.Uint64(), unjailTxHash, 0, 20)
+	got := staking.HandleMsgValidatorUnjailed(ctx, unjailMsg, keeper)
+	require.False(t, got.IsOK(), "expected unjail of active validator to fail")
+
+	// jail validator
+	jailTxHash := types.HexToHeimdallHash("123")
+	jailReceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
+	contractCallerObj.On("GetConfirmedTxReceipt", jailTxHash.EthHash(), checkpointTypes.DefaultConfirmationBlocks).Return(jailReceipt, nil)
+	jailedEvent := &stakinginfo.StakinginfoJailed{
+		ValidatorId: new(big.Int).SetUint64(oldVal.ID.Uint64()),
+		ExitEpoch:   big.NewInt(5),
+	}
+	contractCallerObj.On("DecodeValidatorJailedEvent", jailReceipt, uint64(0)).Return(jailedEvent, nil)
+
+	// exit epoch in msg must match exit epoch in event
+	invalidJailMsg := stakingTypes.NewMsgValidatorJailed(oldVal.Signer, oldVal.ID.Uint64(), 6, jailTxHash, 0, 10)
+	require.Equal(t, types.SideTxResultNo, staking.SideHandleMsgValidatorJailed(ctx, invalidJailMsg, keeper, &contractCallerObj))
+
+	jailMsg := stakingTypes.NewMsgValidatorJailed(oldVal.Signer, oldVal.ID.Uint64(), 5, jailTxHash, 0, 10)
+	got = staking.HandleMsgValidatorJailed(ctx, jailMsg, keeper)
+	require.True(t, got.IsOK(), "expected validator jailed to be ok, got %v", got)
+	sideResult := staking.SideHandleMsgValidatorJailed(ctx, jailMsg, keeper, &contractCallerObj)
+	require.Equal(t, types.SideTxResultYes, sideResult, "expected side tx result to be yes, got %v", sideResult)
+	got = staking.PostHandleMsgValidatorJailed(ctx, jailMsg, sideResult, keeper)
+	require.True(t, got.IsOK(), "expected validator jailed post tx to be ok, got %v", got)
+
+	jailedVal, err := keeper.GetValidatorInfo(ctx, oldVal.Signer.Bytes())
+	require.Empty(t, err, "unable to fetch validator info %v-", err)
+	require.True(t, jailedVal.Jailed)
+	require.Equal(t, uint64(5), jailedVal.JailExitEpoch)
+	require.Len(t, keeper.GetCurrentValidators(ctx), 3, "jailed validator should leave validator set")
+	for _, validator := range keeper.GetSpanEligibleValidators(ctx) {
+		require.NotEqual(t, oldVal.ID, validator.ID, "jailed validator should not be selected for bor span")
+	}
+
+	// same event can't be replayed
+	got = staking.HandleMsgValidatorJailed(ctx, jailMsg, keeper)
+	require.False(t, got.IsOK(), "expected replayed jailed msg to fail")
+
+	// unjail validator
+	got = staking.HandleMsgValidatorUnjailed(ctx, unjailMsg, keeper)
+	require.True(t, got.IsOK(), "expected validator unjailed to be ok, got %v", got)
+	sideResult = staking.SideHandleMsgValidatorUnjailed(ctx, unjailMsg, keeper, &contractCallerObj)
+	require.Equal(t, types.SideTxResultYes, sideResult, "expected side tx result to be yes, got %v", sideResult)
+	got = staking.PostHandleMsgValidatorUnjailed(ctx, unjailMsg, sideResult, keeper)
+	require.True(t, got.IsOK(), "expected validator unjailed post tx to be ok, got %v", got)
+
+	unjailedVal, err := keeper.GetValidatorInfo(ctx, oldVal.Signer.Bytes())
+	require.Empty(t, err, "unable to fetch validator info %v-", err)
+	require.False(t, unjailedVal.Jailed)
+	require.Equal(t, uint64(0), unjailedVal.JailExitEpoch)
+	require.Len(t, keeper.GetCurrentValidators(ctx), 4, "unjailed validator should rejoin validator set")
+
+	// jailed event older than unjailed event can't jail validator again
+	staleJailMsg := stakingTypes.NewMsgValidatorJailed(oldVal.Signer, oldVal.ID.Uint64(), 5, types.HexToHeimdallHash("789"), 0, 15)
+	got = staking.HandleMsgValidatorJailed(ctx, staleJailMsg, keeper)
+	require.False(t, got.IsOK(), "expected jailed msg older than last update to fail")
+}
+
+func TestHandleMsgValidatorReStake(t *testing.T) {
+	contractCallerObj := mocks.IContractCaller{}
+	ctx, keeper, _ := cmn.CreateTestInput(t, false)
+
+	// pass 0 as time alive to generate non de-activated validators
+	cmn.LoadValidatorSet(4, t, keeper, ctx, false, 0)
+	oldValSet := keeper.GetValidatorSet(ctx)
+	oldVal := oldValSet.Validators[0]
+
+	// validator started unbonding
+	unbondingVal, ok := keeper.GetValidatorFromValID(ctx, oldVal.ID)
+	require.True(t, ok)
+	unbondingVal.EndEpoch = 10
+	require.Nil(t, keeper.AddValidator(ctx, unbondingVal))
+
+	msgTxHash := types.HexToHeimdallHash("123")
+	txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
+	contractCallerObj.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), checkpointTypes.DefaultConfirmationBlocks).Return(txreceipt, nil)
+	reStakeEvent := &stakinginfo.StakinginfoReStaked{
+		ValidatorId: new(big.Int).SetUint64(oldVal.ID.Uint64()),
+		Amount:      new(big.Int).Mul(big.NewInt(3), big.NewInt(1e18)),
+		Total:       new(big.Int).Mul(big.NewInt(5), big.NewInt(1e18)),
+	}
+	contractCallerObj.On("DecodeValidatorReStakeEvent", txreceipt, uint64(0)).Return(reStakeEvent, nil)
+
+	amount := types.NewIntFromBigInt(reStakeEvent.Amount)
+	total := types.NewIntFromBigInt(reStakeEvent.Total)
+
+	// id in msg must match id in event
+	invalidMsg := stakingTypes.NewMsgValidatorReStake(oldVal.Signer, oldVal.ID.Uint64()+1, amount, total, msgTxHash, 0, 10)
+	require.Equal(t, types.SideTxResultNo, staking.SideHandleMsgValidatorReStake(ctx, invalidMsg, keeper, &contractCallerObj))
+
+	// total in msg must match total in event
+	invalidMsg = stakingTypes.NewMsgValidatorReStake(oldVal.Signer, oldVal.ID.Uint64(), amount, amount, msgTxHash, 0, 10)
+	require.Equal(t, types.SideTxResultNo, staking.SideHandleMsgValidatorReStake(ctx, invalidMsg, keeper, &contractCallerObj))
+
+	msg := stakingTypes.NewMsgValidatorReStake(oldVal.Signer, oldVal.ID.Uint64(), amount, total, msgTxHash, 0, 10)
+	got := staking.HandleMsgValidatorReStake(ctx, msg, keeper)
+	require.True(t, got.IsOK(), "expected validator restake to be ok, got %v", got)
+
+	// power is updated only after validators vote on restaked event
+	sideResult := staking.SideHandleMsgValidatorReStake(ctx, msg, keeper, &contractCallerObj)
+	require.Equal(t, types.SideTxResultYes, sideResult, "expected side tx result to be yes, got %v", sideResult)
+	got = staking.PostHandleMsgValidatorReStake(ctx, msg, sideResult, keeper)
+	require.True(t, got.IsOK(), "expected validator restake post tx to be ok, got %v", got)
+
+	updatedVal, err := keeper.GetValidatorInfo(ctx, oldVal.Signer.Bytes())
+	require.Empty(t, err, "unable to fetch validator info %v-", err)
+	require.Equal(t, int64(5), updatedVal.VotingPower, "power should be derived from total stake")
+	require.Equal(t, uint64(0), updatedVal.EndEpoch, "end epoch should be reset after restake")
+	require.Equal(t, uint64(10*types.DefaultLogIndexUnit), updatedVal.LastUpdated)
+
+	// same event can't be replayed
+	got = staking.HandleMsgValidatorReStake(ctx, msg, keeper)
+	require.False(t, got.IsOK(), "expected replayed restake msg to fail")
+}
+
+func TestHandleMsgDelegatorBondUnbond(t *testing.T) {
+	contractCallerObj := mocks.IContractCaller{}
+	ctx, keeper, _ := cmn.CreateTestInput(t, false)
+
+	// pass 0 as time alive to generate non de-activated validators
+	cmn.LoadValidatorSet(4, t, keeper, ctx, false, 0)
+	validators := keeper.GetValidatorSet(ctx).Validators
+	delegator := common.HexToAddress("0x0000000000000000000000000000000000000d0d")
+
+	bond := func(txHash string, block int64, val *types.Validator, amount int64, tokens int64) sdk.Result {
+		msgTxHash := types.HexToHeimdallHash(txHash)
+		txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(block)}
+		contractCallerObj.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), checkpointTypes.DefaultConfirmationBlocks).Return(txreceipt, nil)
+		contractCallerObj.On("DecodeShareMintedEvent", txreceipt, uint64(0)).Return(&stakinginfo.StakinginfoShareMinted{
+			ValidatorId: new(big.Int).SetUint64(val.ID.Uint64()),
+			User:        delegator,
+			Amount:      big.NewInt(amount),
+			Tokens:      big.NewInt(tokens),
+		}, nil)
+		msg := stakingTypes.NewMsgDelegatorBond(val.Signer, val.ID.Uint64(), types.BytesToHeimdallAddress(delegator.Bytes()), types.NewInt(amount), types.NewInt(tokens), msgTxHash, 0, uint64(block))
+		if got := staking.HandleMsgDelegatorBond(ctx, msg, keeper); !got.IsOK() {
+			return got
+		}
+		// delegation is updated only after validators vote on event
+		sideResult := staking.SideHandleMsgDelegatorBond(ctx, msg, keeper, &contractCallerObj)
+		require.Equal(t, types.SideTxResultYes, sideResult, "expected side tx result to be yes, got %v", sideResult)
+		return staking.PostHandleMsgDelegatorBond(ctx, msg, sideResult, keeper)
+	}
+
+	unbond := func(txHash string, block int64, val *types.Validator, amount int64, tokens int64) sdk.Result {
+		msgTxHash := types.HexToHeimdallHash(txHash)
+		txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(block)}
+		contractCallerObj.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), checkpointTypes.DefaultConfirmationBlocks).Return(txreceipt, nil)
+		contractCallerObj.On("DecodeShareBurnedEvent", txreceipt, uint64(0)).Return(&stakinginfo.StakinginfoShareBurned{
+			ValidatorId: new(big.Int).SetUint64(val.ID.Uint64()),
+			User:        delegator,
+			Amount:      big.NewInt(amount),
+			Tokens:      big.NewInt(tokens),
+		}, nil)
+		msg := stakingTypes.NewMsgDelegatorUnbond(val.Signer, val.ID.Uint64(), types.BytesToHeimdallAddress(delegator.Bytes()), types.NewInt(amount), types.NewInt(tokens), msgTxHash, 0, uint64(block))
+		if got := staking.HandleMsgDelegatorUnbond(ctx, msg, keeper); !got.IsOK() {
+			return got
+		}
+		// delegation is updated only after validators vote on event
+		sideResult := staking.SideHandleMsgDelegatorUnbond(ctx, msg, keeper, &contractCallerObj)
+		require.Equal(t, types.SideTxResultYes, sideResult, "expected side tx result to be yes, got %v", sideResult)
+		return staking.PostHandleMsgDelegatorUnbond(ctx, msg, sideResult, keeper)
+	}
+
+	// unbond voted before bond it burns shares of
+	got := unbond("100", 5, validators[2], 10, 10)
+	require.True(t, got.IsOK(), "expected unbond before bond to be ok, got %v", got)
+	delegation, ok := keeper.GetDelegation(ctx, validators[2].ID, types.BytesToHeimdallAddress(delegator.Bytes()))
+	require.True(t, ok)
+	require.Equal(t, "-10", delegation.Shares, "shares should stay negative until bond is applied")
+	got = bond("107", 3, validators[2], 10, 10)
+	require.True(t, got.IsOK(), "expected older bond to be ok, got %v", got)
+	_, ok = keeper.GetDelegation(ctx, validators[2].ID, types.BytesToHeimdallAddress(delegator.Bytes()))
+	require.False(t, ok, "delegation should be removed once bond and unbond are applied")
+
+	// delegate to two validators, newer bond is voted first
+	got = bond("102", 11, validators[0], 50, 40)
+	require.True(t, got.IsOK(), "expected delegator bond to be ok, got %v", got)
+	got = bond("101", 10, validators[0], 100, 100)
+	require.True(t, got.IsOK(), "expected older delegator bond to be ok, got %v", got)
+	got = bond("103", 12, validators[1], 30, 30)
+	require.True(t, got.IsOK(), "expected delegator bond to be ok, got %v", got)
+
+	// replayed event
+	got = bond("101", 10, validators[0], 100, 100)
+	require.False(t, got.IsOK(), "expected replayed bond to fail")
+
+	// delegator in msg must match user in event
+	mismatchTxHash := types.HexToHeimdallHash("101")
+	mismatchMsg := stakingTypes.NewMsgDelegatorBond(validators[0].Signer, validators[0].ID.Uint64(), validators[0].Signer, types.NewInt(100), types.NewInt(100), mismatchTxHash, 0, 10)
+	require.Equal(t, types.SideTxResultNo, staking.SideHandleMsgDelegatorBond(ctx, mismatchMsg, keeper, &contractCallerObj))
+
+	delegation, ok = keeper.GetDelegation(ctx, validators[0].ID, types.BytesToHeimdallAddress(delegator.Bytes()))
+	require.True(t, ok)
+	require.Equal(t, "150", delegation.Amount)
+	require.Equal(t, "140", delegation.Shares)
+	require.Len(t, keeper.GetValidatorDelegations(ctx, validators[0].ID), 1)
+	require.Len(t, keeper.GetValidatorDelegations(ctx, validators[2].ID), 0)
+	require.Len(t, keeper.GetDelegatorDelegations(ctx, types.BytesToHeimdallAddress(delegator.Bytes())), 2)
+
+	// unbond from unknown validator
+	got = unbond("104", 13, &types.Validator{ID: 100, Signer: validators[1].Signer}, 30, 30)
+	require.False(t, got.IsOK(), "expected unbond from unknown validator to fail")
+
+	// partial unbond
+	got = unbond("105", 14, validators[0], 50, 40)
+	require.True(t, got.IsOK(), "expected delegator unbond to be ok, got %v", got)
+	delegation, ok = keeper.GetDelegation(ctx, validators[0].ID, types.BytesToHeimdallAddress(delegator.Bytes()))
+	require.True(t, ok)
+	require.Equal(t, "100", delegation.Amount)
+	require.Equal(t, "100", delegation.Shares)
+
+	// full unbond removes delegation
+	got = unbond("106", 15, validators[1], 30, 30)
+	require.True(t, got.IsOK(), "expected delegator unbond to be ok, got %v", got)
+	_, ok = keeper.GetDelegation(ctx, validators[1].ID, types.BytesToHeimdallAddress(delegator.Bytes()))
+	require.False(t, ok)
+	require.Len(t, keeper.GetDelegatorDelegations(ctx, types.BytesToHeimdallAddress(delegator.Bytes())), 1)
+}
+
+func TestHandleMsgEditValidatorMetadata(t *testing.T) {
+	ctx, keeper, _ := cmn.CreateTestInput(t, false)
+	cmn.LoadValidatorSet(4, t, keeper, ctx, false, 0)
+	validators := keeper.GetCurrentValidators(ctx)
+
+	// metadata can be edited only by validator signer
+	msg := stakingTypes.NewMsgEditValidatorMetadata(validators[1].Signer, uint64(validators[0].ID), "moniker", "https://example.com", "ops@example.com", "identity")
+	got := staking.HandleMsgEditValidatorMetadata(ctx, msg, keeper)
+	require.False(t, got.IsOK(), "metadata edit by other signer should fail")
+	_, found := keeper.GetValidatorMetadata(ctx, validators[0].ID)
+	require.False(t, found, "metadata should not be stored")
+
+	msg = stakingTypes.NewMsgEditValidatorMetadata(validators[0].Signer, uint64(validators[0].ID), "moniker", "https://example.com", "ops@example.com", "identity")
+	require.Nil(t, msg.ValidateBasic())
+	got = staking.HandleMsgEditValidatorMetadata(ctx, msg, keeper)
+	require.True(t, got.IsOK(), "expected metadata edit to be ok, got %v", got)
+	metadata, found := keeper.GetValidatorMetadata(ctx, validators[0].ID)
+	require.True(t, found, "metadata should be stored")
+	require.Equal(t, msg.GetMetadata(), metadata)
+	require.Equal(t, []stakingTypes.ValidatorMetadata{metadata}, staking.ExportGenesis(ctx, keeper).ValidatorMetadata)
+
+	// unknown validator
+	msg = stakingTypes.NewMsgEditValidatorMetadata(validators[0].Signer, 100, "moniker", "", "", "")
+	got = staking.HandleMsgEditValidatorMetadata(ctx, msg, keeper)
+	require.False(t, got.IsOK(), "metadata edit of unknown validator should fail")
 
+	// length limits
+	msg = stakingTypes.NewMsgEditValidatorMetadata(validators[0].Signer, uint64(validators[0].ID), strings.Repeat("m", stakingTypes.MaxMonikerLength+1), "", "", "")
+	require.NotNil(t, msg.ValidateBasic(), "moniker longer than limit should be invalid")
 }
@@ -0,0 +1,84 @@
+package staking
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/staking/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Implements StakingHooks interface
+var _ types.StakingHooks = Keeper{}
+
+// AfterValidatorJoined - call hook if registered
+func (k Keeper) AfterValidatorJoined(ctx sdk.Context, validator hmTypes.Validator) {
+	if k.hooks != nil {
+		k.hooks.AfterValidatorJoined(ctx, validator)
+	}
+}
+
+// AfterStakeUpdated - call hook if registered
+func (k Keeper) AfterStakeUpdated(ctx sdk.Context, validator hmTypes.Validator, prevPower int64) {
+	if k.hooks != nil {
+		k.hooks.AfterStakeUpdated(ctx, validator, prevPower)
+	}
+}
+
+// AfterSignerChanged - call hook if registered
+func (k Keeper) AfterSignerChanged(ctx sdk.Context, validator hmTypes.Validator, prevSigner hmTypes.HeimdallAddress) {
+	if k.hooks != nil {
+		k.hooks.AfterSignerChanged(ctx, validator, prevSigner)
+	}
+}
+
+// AfterValidatorExited - call hook if registered
+func (k Keeper) AfterValidatorExited(ctx sdk.Context, validator hmTypes.Validator) {
+	if k.hooks != nil {
+		k.hooks.AfterValidatorExited(ctx, validator)
+	}
+}
+
+// AfterValidatorJailed - call hook if registered
+func (k Keeper) AfterValidatorJailed(ctx sdk.Context, validator hmTypes.Validator) {
+	if k.hooks != nil {
+		k.hooks.AfterValidatorJailed(ctx, validator)
+	}
+}
+
+// AfterValidatorUnjailed - call hook if registered
+func (k Keeper) AfterValidatorUnjailed(ctx sdk.Context, validator hmTypes.Validator) {
+	if k.hooks != nil {
+		k.hooks.AfterValidatorUnjailed(ctx, validator)
+	}
+}
+
+// BeforeValidatorSetUpdate - call hook if registered
+func (k Keeper) BeforeValidatorSetUpdate(ctx sdk.Context, validatorSet hmTypes.ValidatorSet, updates []*hmTypes.Validator) {
+	if k.hooks != nil {
+		k.hooks.BeforeValidatorSetUpdate(ctx, validatorSet, updates)
+	}
+}
+
+// CheckpointHooks subscribes staking to checkpoint acks, checkpoint proposer rotates on every ack and no-ack
+type CheckpointHooks struct {
+	k Keeper
+}
+
+// Implements CheckpointHooks interface
+var _ checkpointTypes.CheckpointHooks = CheckpointHooks{}
+
+// CheckpointHooks returns checkpoint hooks of keeper
+func (k Keeper) CheckpointHooks() CheckpointHooks {
+	return CheckpointHooks{k}
+}
+
+// AfterCheckpointACK increments accum of validator set to select next proposer
+func (h CheckpointHooks) AfterCheckpointACK(ctx sdk.Context, headerBlock hmTypes.CheckpointBlockHeader) {
+	h.k.IncrementAccum(ctx, 1)
+}
+
+// AfterCheckpointNoACK increments accum of validator set to skip current proposer
+func (h CheckpointHooks) AfterCheckpointNoACK(ctx sdk.Context) {
+	h.k.IncrementAccum(ctx, 1)
+}
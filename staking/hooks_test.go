@@ -0,0 +1,112 @@
+package staking_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
+	"github.com/maticnetwork/heimdall/helper/mocks"
+	"github.com/maticnetwork/heimdall/staking"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	cmn "github.com/maticnetwork/heimdall/test"
+	"github.com/maticnetwork/heimdall/types"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingHooks records names of called hooks
+type recordingHooks struct {
+	calls []string
+}
+
+func (h *recordingHooks) AfterValidatorJoined(ctx sdk.Context, validator types.Validator) {
+	h.calls = append(h.calls, "joined")
+}
+
+func (h *recordingHooks) AfterStakeUpdated(ctx sdk.Context, validator types.Validator, prevPower int64) {
+	h.calls = append(h.calls, "stake-updated")
+}
+
+func (h *recordingHooks) AfterSignerChanged(ctx sdk.Context, validator types.Validator, prevSigner types.HeimdallAddress) {
+	h.calls = append(h.calls, "signer-changed")
+}
+
+func (h *recordingHooks) AfterValidatorExited(ctx sdk.Context, validator types.Validator) {
+	h.calls = append(h.calls, "exited")
+}
+
+func (h *recordingHooks) AfterValidatorJailed(ctx sdk.Context, validator types.Validator) {
+	h.calls = append(h.calls, "jailed")
+}
+
+func (h *recordingHooks) AfterValidatorUnjailed(ctx sdk.Context, validator types.Validator) {
+	h.calls = append(h.calls, "unjailed")
+}
+
+func (h *recordingHooks) BeforeValidatorSetUpdate(ctx sdk.Context, validatorSet types.ValidatorSet, updates []*types.Validator) {
+	h.calls = append(h.calls, "set-update")
+}
+
+func TestStakingHooks(t *testing.T) {
+	contractCallerObj := mocks.IContractCaller{}
+	ctx, keeper, checkpointKeeper := cmn.CreateTestInput(t, false)
+	cmn.LoadValidatorSet(4, t, keeper, ctx, false, 0)
+
+	// all combined hooks are called in order
+	first, second := &recordingHooks{}, &recordingHooks{}
+	keeper = *keeper.SetHooks(stakingTypes.NewMultiStakingHooks(first, second))
+	require.Panics(t, func() { keeper.SetHooks(stakingTypes.NewMultiStakingHooks()) }, "hooks can be set only once")
+
+	validators := keeper.GetCurrentValidators(ctx)
+	msgTxHash := types.HexToHeimdallHash("123")
+	txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
+	contractCallerObj.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), checkpointTypes.DefaultConfirmationBlocks).Return(txreceipt, nil)
+	contractCallerObj.On("DecodeValidatorExitEvent", txreceipt, uint64(0)).Return(&stakinginfo.StakinginfoUnstakeInit{
+		ValidatorId:       new(big.Int).SetUint64(validators[0].ID.Uint64()),
+		DeactivationEpoch: big.NewInt(10),
+	}, nil)
+
+	msg := stakingTypes.NewMsgValidatorExit(validators[0].Signer, uint64(validators[0].ID), 10, msgTxHash, 0, 10)
+	sideResult := staking.SideHandleMsgValidatorExit(ctx, msg, keeper, &contractCallerObj)
+	got := staking.PostHandleMsgValidatorExit(ctx, msg, sideResult, keeper)
+	require.True(t, got.IsOK(), "expected validator exit to be ok, got %v", got)
+	require.Equal(t, []string{"exited"}, first.calls)
+
+	// exited validator is removed from set after its deactivation epoch
+	checkpointKeeper.UpdateACKCountWithValue(ctx, 20)
+	_, err := keeper.ApplyValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"exited", "set-update"}, first.calls)
+	require.Equal(t, first.calls, second.calls)
+
+	// no hook without set updates
+	_, err = keeper.ApplyValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"exited", "set-update"}, first.calls)
+
+	// jail transitions call hooks
+	require.NoError(t, keeper.JailValidator(ctx, validators[1].Signer.Bytes()))
+	require.NoError(t, keeper.UnjailValidator(ctx, validators[1].Signer.Bytes()))
+	require.Equal(t, []string{"exited", "set-update", "jailed", "unjailed"}, first.calls)
+}
+
+func TestCheckpointHooksRotateProposer(t *testing.T) {
+	ctx, keeper, checkpointKeeper := cmn.CreateTestInput(t, false)
+	cmn.LoadValidatorSet(4, t, keeper, ctx, false, 0)
+
+	// staking subscribes to checkpoint acks and no-acks, each of them rotates proposer once
+	expected := keeper.GetValidatorSet(ctx)
+	for _, notify := range []func(){
+		func() { checkpointKeeper.AfterCheckpointNoACK(ctx) },
+		func() { checkpointKeeper.AfterCheckpointACK(ctx, types.CheckpointBlockHeader{}) },
+	} {
+		expected.IncrementProposerPriority(1)
+		notify()
+
+		validatorSet := keeper.GetValidatorSet(ctx)
+		require.Equal(t, expected.GetProposer().Signer, validatorSet.GetProposer().Signer)
+		require.Equal(t, expected.Validators, validatorSet.Validators)
+	}
+}
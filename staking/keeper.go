@@ -1,8 +1,12 @@
 package staking
 
 import (
+	"bytes"
+	"encoding/binary"
 	"encoding/hex"
 	"errors"
+	"math/big"
+	"sort"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -25,10 +29,20 @@ var (
 	PrevDividendAccountMapKey = []byte{0x41} // store for dividend accounts before checkpoint ack.
 	DividendAccountMapKey     = []byte{0x42} // prefix for each key for Dividend Account Map
 	StakingSequenceKey        = []byte{0x24} // prefix for each key for staking sequence map
+	DelegationKey             = []byte{0x25} // prefix for each key for delegation map
+	ValidatorSetSnapshotKey   = []byte{0x26} // prefix for each key for validator set snapshot by height
+
+	LastValidatorUpdateHeightKey = []byte{0x27} // Key to store height of last block in which validator was updated
+	LastValidatorSetAckCountKey  = []byte{0x28} // Key to store ack count at which validator set was last updated
+	ValidatorStakeKey            = []byte{0x29} // prefix for each key for validator stake amount
+	ValidatorMetadataKey         = []byte{0x2A} // prefix for each key for validator metadata
 )
 
-type AckRetriever interface {
+// ModuleCommunicator manages interaction of staking with other modules
+type ModuleCommunicator interface {
 	GetACKCount(ctx sdk.Context) uint64
+	GetConfirmationBlocks(ctx sdk.Context) uint64
+	RefundRelayerFee(ctx sdk.Context) (hmTypes.HeimdallAddress, sdk.Error)
 }
 
 // Keeper stores all related data
@@ -40,8 +54,10 @@ type Keeper struct {
 	codespace sdk.CodespaceType
 	// param space
 	paramSpace params.Subspace
-	// ack retriever
-	ackRetriever AckRetriever
+	// module communicator
+	moduleCommunicator ModuleCommunicator
+	// validator lifecycle hooks
+	hooks types.StakingHooks
 }
 
 // NewKeeper create new keeper
@@ -50,18 +66,27 @@ func NewKeeper(
 	storeKey sdk.StoreKey,
 	paramSpace params.Subspace,
 	codespace sdk.CodespaceType,
-	ackRetriever AckRetriever,
+	moduleCommunicator ModuleCommunicator,
 ) Keeper {
 	keeper := Keeper{
-		cdc:          cdc,
-		storeKey:     storeKey,
-		paramSpace:   paramSpace.WithKeyTable(types.ParamKeyTable()),
-		codespace:    codespace,
-		ackRetriever: ackRetriever,
+		cdc:                cdc,
+		storeKey:           storeKey,
+		paramSpace:         paramSpace.WithKeyTable(types.ParamKeyTable()),
+		codespace:          codespace,
+		moduleCommunicator: moduleCommunicator,
 	}
 	return keeper
 }
 
+// SetHooks sets validator lifecycle hooks, hooks must be set before keeper is passed to other modules
+func (k *Keeper) SetHooks(sh types.StakingHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set staking hooks twice")
+	}
+	k.hooks = sh
+	return k
+}
+
 // Codespace returns the codespace
 func (k Keeper) Codespace() sdk.CodespaceType {
 	return k.codespace
@@ -108,13 +133,75 @@ func (k *Keeper) AddValidator(ctx sdk.Context, validator hmTypes.Validator) erro
 	// add validator to validator ID => SignerAddress map
 	k.SetValidatorIDToSignerAddr(ctx, validator.ID, validator.Signer)
 
+	// validator set has to be updated in end blocker
+	store.Set(LastValidatorUpdateHeightKey, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())))
+
 	return nil
 }
 
+// HasValidatorUpdatedInBlock checks if any validator was added or updated in current block
+func (k *Keeper) HasValidatorUpdatedInBlock(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(LastValidatorUpdateHeightKey)
+	if bz == nil {
+		return false
+	}
+	return int64(binary.BigEndian.Uint64(bz)) == ctx.BlockHeight()
+}
+
+// GetLastValidatorSetAckCount returns ack count at which validator set was last updated
+func (k *Keeper) GetLastValidatorSetAckCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(LastValidatorSetAckCountKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetLastValidatorSetAckCount stores ack count at which validator set was last updated
+func (k *Keeper) SetLastValidatorSetAckCount(ctx sdk.Context, ackCount uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(LastValidatorSetAckCountKey, sdk.Uint64ToBigEndian(ackCount))
+}
+
+// ApplyValidatorSetUpdates adds newly eligible validators to validator set, removes expired ones and updates power at current ack count.
+// Returns applied updates, validators removed from set have zero power.
+func (k *Keeper) ApplyValidatorSetUpdates(ctx sdk.Context) ([]*hmTypes.Validator, error) {
+	ackCount := k.moduleCommunicator.GetACKCount(ctx)
+	validatorSet := k.GetValidatorSet(ctx)
+
+	// get validator updates
+	setUpdates := helper.GetUpdatedValidators(
+		&validatorSet,             // pointer to current validator set -- UpdateValidators will modify it
+		k.GetAllValidators(ctx),   // All validators
+		ackCount,                  // ack count
+		k.GetMaxValidators(ctx),   // max validators in set
+		k.MinSelfStakeFilter(ctx), // validators with min self stake
+	)
+
+	if len(setUpdates) > 0 {
+		k.BeforeValidatorSetUpdate(ctx, validatorSet, setUpdates)
+	}
+
+	// create new validator set
+	if err := validatorSet.UpdateWithChangeSet(setUpdates); err != nil {
+		return nil, err
+	}
+
+	// save set in store
+	if err := k.UpdateValidatorSetInStore(ctx, validatorSet); err != nil {
+		return nil, err
+	}
+
+	k.SetLastValidatorSetAckCount(ctx, ackCount)
+	return setUpdates, nil
+}
+
 // IsCurrentValidatorByAddress check if validator is in current validator set by signer address
 func (k *Keeper) IsCurrentValidatorByAddress(ctx sdk.Context, address []byte) bool {
 	// get ack count
-	ackCount := k.ackRetriever.GetACKCount(ctx)
+	ackCount := k.moduleCommunicator.GetACKCount(ctx)
 
 	// get validator info
 	validator, err := k.GetValidatorInfo(ctx, address)
@@ -123,7 +210,20 @@ func (k *Keeper) IsCurrentValidatorByAddress(ctx sdk.Context, address []byte) bo
 	}
 
 	// check if validator is current validator
-	return validator.IsCurrentValidator(ackCount)
+	if !validator.IsCurrentValidator(ackCount) {
+		return false
+	}
+
+	// standby validators are not part of validator set, without max validators only min self stake is checked
+	if k.GetMaxValidators(ctx) == 0 {
+		return k.HasMinSelfStake(ctx, validator)
+	}
+	for _, currentValidator := range k.GetCurrentValidators(ctx) {
+		if currentValidator.Signer == validator.Signer {
+			return true
+		}
+	}
+	return false
 }
 
 // GetValidatorInfo returns validator
@@ -149,36 +249,24 @@ func (k *Keeper) GetValidatorInfo(ctx sdk.Context, address []byte) (validator hm
 // GetCurrentValidators returns all validators who are in validator set
 func (k *Keeper) GetCurrentValidators(ctx sdk.Context) (validators []hmTypes.Validator) {
 	// get ack count
-	ackCount := k.ackRetriever.GetACKCount(ctx)
+	ackCount := k.moduleCommunicator.GetACKCount(ctx)
 
-	// Get validators
-	// iterate through validator list
-	k.IterateValidatorsAndApplyFn(ctx, func(validator hmTypes.Validator) error {
-		// check if validator is valid for current epoch
-		if validator.IsCurrentValidator(ackCount) {
-			// append if validator is current valdiator
-			validators = append(validators, validator)
-		}
-		return nil
-	})
+	// select top validators by power among validators valid for current epoch
+	for _, validator := range helper.SelectCurrentValidators(k.GetAllValidators(ctx), ackCount, k.GetMaxValidators(ctx), k.MinSelfStakeFilter(ctx)) {
+		validators = append(validators, *validator)
+	}
 
 	return
 }
 
 // GetSpanEligibleValidators returns current validators who are not getting deactivated in between next span
 func (k *Keeper) GetSpanEligibleValidators(ctx sdk.Context) (validators []hmTypes.Validator) {
-	// get ack count
-	ackCount := k.ackRetriever.GetACKCount(ctx)
-
-	// Get validators and iterate through validator list
-	k.IterateValidatorsAndApplyFn(ctx, func(validator hmTypes.Validator) error {
-		// check if validator is valid for current epoch and endEpoch is not set.
-		if validator.EndEpoch == 0 && validator.IsCurrentValidator(ackCount) {
-			// append if validator is current valdiator
+	// Get current validators and check if endEpoch is not set.
+	for _, validator := range k.GetCurrentValidators(ctx) {
+		if validator.EndEpoch == 0 {
 			validators = append(validators, validator)
 		}
-		return nil
-	})
+	}
 
 	return
 }
@@ -195,6 +283,56 @@ func (k *Keeper) GetAllValidators(ctx sdk.Context) (validators []*hmTypes.Valida
 	return
 }
 
+// GetValidatorList returns page of validators with given status, ordered by validator ID and signer.
+// Empty status lists validators of all statuses, aggregate powers are computed over all validators.
+func (k *Keeper) GetValidatorList(ctx sdk.Context, status string, page uint64, limit uint64) types.ValidatorList {
+	// get ack count
+	ackCount := k.moduleCommunicator.GetACKCount(ctx)
+
+	result := types.ValidatorList{
+		Validators: []hmTypes.Validator{},
+		AckCount:   ackCount,
+	}
+
+	// validators selected for current validator set, others valid for current epoch are standby
+	allValidators := k.GetAllValidators(ctx)
+	selected := make(map[hmTypes.HeimdallAddress]bool)
+	for _, validator := range helper.SelectCurrentValidators(allValidators, ackCount, k.GetMaxValidators(ctx), k.MinSelfStakeFilter(ctx)) {
+		selected[validator.Signer] = true
+	}
+
+	var validators []hmTypes.Validator
+	for _, validator := range allValidators {
+		validatorStatus := types.GetValidatorStatus(*validator, ackCount, selected[validator.Signer])
+		switch validatorStatus {
+		case types.ValidatorStatusActive:
+			result.ActivePower += validator.VotingPower
+		case types.ValidatorStatusUnbonding:
+			result.UnbondingPower += validator.VotingPower
+		}
+
+		if status == "" || status == validatorStatus {
+			validators = append(validators, *validator)
+		}
+	}
+
+	// validators are stored by signer, order them by ID for stable pages across signer updates
+	sort.Slice(validators, func(i, j int) bool {
+		if validators[i].ID != validators[j].ID {
+			return validators[i].ID < validators[j].ID
+		}
+		return bytes.Compare(validators[i].Signer.Bytes(), validators[j].Signer.Bytes()) < 0
+	})
+	result.ValidatorCount = uint64(len(validators))
+
+	start, end := helper.Paginate(len(validators), int(page), int(limit), types.DefaultValidatorListLimit)
+	if start >= 0 && end >= 0 {
+		result.Validators = validators[start:end]
+	}
+
+	return result
+}
+
 // IterateValidatorsAndApplyFn interate validators and apply the given function.
 func (k *Keeper) IterateValidatorsAndApplyFn(ctx sdk.Context, f func(validator hmTypes.Validator) error) {
 	store := ctx.KVStore(k.storeKey)
@@ -226,6 +364,46 @@ func (k *Keeper) AddDeactivationEpoch(ctx sdk.Context, validator hmTypes.Validat
 	return errors.New("Deactivation period not set")
 }
 
+// JailValidator jails validator by signer address, jailed validator is dropped from validator set
+func (k *Keeper) JailValidator(ctx sdk.Context, address []byte) error {
+	validator, err := k.GetValidatorInfo(ctx, address)
+	if err != nil {
+		return err
+	}
+
+	if validator.Jailed {
+		return errors.New("Validator already jailed")
+	}
+
+	validator.Jailed = true
+	if err := k.AddValidator(ctx, validator); err != nil {
+		return err
+	}
+
+	k.AfterValidatorJailed(ctx, validator)
+	return nil
+}
+
+// UnjailValidator unjails validator jailed by heimdall
+func (k *Keeper) UnjailValidator(ctx sdk.Context, address []byte) error {
+	validator, err := k.GetValidatorInfo(ctx, address)
+	if err != nil {
+		return err
+	}
+
+	if !validator.Jailed {
+		return errors.New("Validator not jailed")
+	}
+
+	validator.Jailed = false
+	if err := k.AddValidator(ctx, validator); err != nil {
+		return err
+	}
+
+	k.AfterValidatorUnjailed(ctx, validator)
+	return nil
+}
+
 // UpdateSigner updates validator with signer and pubkey + validator => signer map
 func (k *Keeper) UpdateSigner(ctx sdk.Context, newSigner hmTypes.HeimdallAddress, newPubkey hmTypes.PubKey, prevSigner hmTypes.HeimdallAddress) error {
 	// get old validator from state and make power 0
@@ -265,7 +443,9 @@ func (k *Keeper) UpdateValidatorSetInStore(ctx sdk.Context, newValidatorSet hmTy
 
 	// set validator set with CurrentValidatorSetKey as key in store
 	store.Set(CurrentValidatorSetKey, bz)
-	return nil
+
+	// keep validator set of current ack count for history
+	return k.snapshotValidatorSet(ctx, newValidatorSet)
 }
 
 // GetValidatorSet returns current Validator Set from store
@@ -313,6 +493,44 @@ func (k *Keeper) GetCurrentProposer(ctx sdk.Context) *hmTypes.Validator {
 	return validatorSet.GetProposer()
 }
 
+// GetProposerSchedule projects checkpoint proposer and validator set for current and next `times` ack counts.
+// Validator changes which are not yet part of validator set are applied like end blocker does after each ack.
+func (k *Keeper) GetProposerSchedule(ctx sdk.Context, times uint64) []types.ProposerProjection {
+	validatorSet := k.GetValidatorSet(ctx)
+	allValidators := k.GetAllValidators(ctx)
+	ackCount := k.moduleCommunicator.GetACKCount(ctx)
+	maxValidators := k.GetMaxValidators(ctx)
+	hasMinSelfStake := k.MinSelfStakeFilter(ctx)
+
+	schedule := make([]types.ProposerProjection, 0, times+1)
+	for i := uint64(0); i <= times; i++ {
+		if i > 0 {
+			// ack increments accum before validator set is updated
+			validatorSet.IncrementProposerPriority(1)
+		}
+
+		// apply pending validator changes at ack count
+		setUpdates := helper.GetUpdatedValidators(&validatorSet, allValidators, ackCount+i, maxValidators, hasMinSelfStake)
+		if err := validatorSet.UpdateWithChangeSet(setUpdates); err != nil {
+			k.Logger(ctx).Error("Unable to project validator set", "ackCount", ackCount+i, "error", err)
+			break
+		}
+
+		proposer := validatorSet.GetProposer()
+		if proposer == nil {
+			break
+		}
+
+		schedule = append(schedule, types.ProposerProjection{
+			AckCount:         ackCount + i,
+			Proposer:         *proposer,
+			ValidatorSetHash: hmTypes.BytesToHeimdallHash(validatorSet.Hash()),
+		})
+	}
+
+	return schedule
+}
+
 // SetValidatorIDToSignerAddr sets mapping for validator ID to signer address
 func (k *Keeper) SetValidatorIDToSignerAddr(ctx sdk.Context, valID hmTypes.ValidatorID, signerAddr hmTypes.HeimdallAddress) {
 	store := ctx.KVStore(k.storeKey)
@@ -456,3 +674,485 @@ func (k *Keeper) HasStakingSequence(ctx sdk.Context, sequence uint64) bool {
 	store := ctx.KVStore(k.storeKey)
 	return store.Has(GetStakingSequenceKey(sequence))
 }
+
+// GetConfirmationBlocks returns number of mainchain blocks a staking tx must be buried under
+func (k *Keeper) GetConfirmationBlocks(ctx sdk.Context) uint64 {
+	return k.moduleCommunicator.GetConfirmationBlocks(ctx)
+}
+
+// RefundRelayerFee refunds fee collected from relayer of applied mainchain staking event.
+// Refund is best effort, failure doesn't revert the applied staking event.
+func (k *Keeper) RefundRelayerFee(ctx sdk.Context) {
+	relayer, err := k.moduleCommunicator.RefundRelayerFee(ctx)
+	if err != nil {
+		k.Logger(ctx).Error("Unable to refund fee to relayer", "relayer", relayer.String(), "error", err)
+		return
+	}
+
+	k.Logger(ctx).Debug("Refunded fee to relayer", "relayer", relayer.String())
+}
+
+//
+// Delegations
+//
+
+// GetValidatorDelegationsKey returns prefix of delegation keys for validator
+func GetValidatorDelegationsKey(validatorID hmTypes.ValidatorID) []byte {
+	id := make([]byte, 8)
+	binary.BigEndian.PutUint64(id, validatorID.Uint64())
+	return append(append([]byte{}, DelegationKey...), id...)
+}
+
+// GetDelegationKey returns delegation key for validator and delegator
+func GetDelegationKey(validatorID hmTypes.ValidatorID, delegator hmTypes.HeimdallAddress) []byte {
+	return append(GetValidatorDelegationsKey(validatorID), delegator.Bytes()...)
+}
+
+// SetDelegation stores delegation
+func (k *Keeper) SetDelegation(ctx sdk.Context, delegation hmTypes.Delegation) error {
+	store := ctx.KVStore(k.storeKey)
+	// marshall delegation
+	bz, err := hmTypes.MarshallDelegation(k.cdc, delegation)
+	if err != nil {
+		return err
+	}
+
+	store.Set(GetDelegationKey(delegation.ValidatorID, delegation.Delegator), bz)
+	k.Logger(ctx).Debug("Delegation stored", "delegation", delegation.String())
+	return nil
+}
+
+// GetDelegation returns delegation of delegator to validator
+func (k *Keeper) GetDelegation(ctx sdk.Context, validatorID hmTypes.ValidatorID, delegator hmTypes.HeimdallAddress) (delegation hmTypes.Delegation, ok bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetDelegationKey(validatorID, delegator))
+	if bz == nil {
+		return delegation, false
+	}
+
+	delegation, err := hmTypes.UnMarshallDelegation(k.cdc, bz)
+	if err != nil {
+		return delegation, false
+	}
+
+	return delegation, true
+}
+
+// RemoveDelegation removes delegation of delegator to validator
+func (k *Keeper) RemoveDelegation(ctx sdk.Context, validatorID hmTypes.ValidatorID, delegator hmTypes.HeimdallAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetDelegationKey(validatorID, delegator))
+}
+
+// GetValidatorDelegations returns all delegations bonded to validator
+func (k *Keeper) GetValidatorDelegations(ctx sdk.Context, validatorID hmTypes.ValidatorID) (delegations []hmTypes.Delegation) {
+	k.IterateDelegationsByPrefixAndApplyFn(ctx, GetValidatorDelegationsKey(validatorID), func(delegation hmTypes.Delegation) error {
+		delegations = append(delegations, delegation)
+		return nil
+	})
+
+	return
+}
+
+// GetDelegatorDelegations returns all delegations of delegator
+func (k *Keeper) GetDelegatorDelegations(ctx sdk.Context, delegator hmTypes.HeimdallAddress) (delegations []hmTypes.Delegation) {
+	k.IterateDelegationsByPrefixAndApplyFn(ctx, DelegationKey, func(delegation hmTypes.Delegation) error {
+		if bytes.Equal(delegation.Delegator.Bytes(), delegator.Bytes()) {
+			delegations = append(delegations, delegation)
+		}
+		return nil
+	})
+
+	return
+}
+
+// GetAllDelegations returns all delegations
+func (k *Keeper) GetAllDelegations(ctx sdk.Context) (delegations []hmTypes.Delegation) {
+	k.IterateDelegationsByPrefixAndApplyFn(ctx, DelegationKey, func(delegation hmTypes.Delegation) error {
+		delegations = append(delegations, delegation)
+		return nil
+	})
+
+	return
+}
+
+// IterateDelegationsByPrefixAndApplyFn iterate delegations and apply the given function.
+func (k *Keeper) IterateDelegationsByPrefixAndApplyFn(ctx sdk.Context, prefix []byte, f func(delegation hmTypes.Delegation) error) {
+	store := ctx.KVStore(k.storeKey)
+
+	// get delegation iterator
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	// loop through delegations
+	for ; iterator.Valid(); iterator.Next() {
+		// unmarshall delegation
+		delegation, _ := hmTypes.UnMarshallDelegation(k.cdc, iterator.Value())
+		// call function and return if required
+		if err := f(delegation); err != nil {
+			return
+		}
+	}
+}
+
+//
+// Validator metadata
+//
+
+// GetValidatorMetadataKey returns validator metadata key for validator id
+func GetValidatorMetadataKey(validatorID hmTypes.ValidatorID) []byte {
+	return append(append([]byte{}, ValidatorMetadataKey...), sdk.Uint64ToBigEndian(validatorID.Uint64())...)
+}
+
+// SetValidatorMetadata stores metadata of validator
+func (k *Keeper) SetValidatorMetadata(ctx sdk.Context, metadata types.ValidatorMetadata) error {
+	bz, err := k.cdc.MarshalBinaryBare(metadata)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetValidatorMetadataKey(metadata.ID), bz)
+	k.Logger(ctx).Debug("Validator metadata stored", "metadata", metadata.String())
+	return nil
+}
+
+// GetValidatorMetadata returns metadata of validator
+func (k *Keeper) GetValidatorMetadata(ctx sdk.Context, validatorID hmTypes.ValidatorID) (metadata types.ValidatorMetadata, ok bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetValidatorMetadataKey(validatorID))
+	if bz == nil {
+		return metadata, false
+	}
+
+	if err := k.cdc.UnmarshalBinaryBare(bz, &metadata); err != nil {
+		return metadata, false
+	}
+	return metadata, true
+}
+
+// GetAllValidatorMetadata returns metadata of all validators
+func (k *Keeper) GetAllValidatorMetadata(ctx sdk.Context) (metadata []types.ValidatorMetadata) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, ValidatorMetadataKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var m types.ValidatorMetadata
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &m); err != nil {
+			continue
+		}
+		metadata = append(metadata, m)
+	}
+
+	return
+}
+
+//
+// Validator stake
+//
+
+// GetValidatorStakeKey returns validator stake key for validator id
+func GetValidatorStakeKey(validatorID hmTypes.ValidatorID) []byte {
+	return append(append([]byte{}, ValidatorStakeKey...), sdk.Uint64ToBigEndian(validatorID.Uint64())...)
+}
+
+// SetValidatorStake stores stake amount of validator and sets voting power derived from it on validator.
+// Validator needs to be added separately
+func (k *Keeper) SetValidatorStake(ctx sdk.Context, validator *hmTypes.Validator, amount hmTypes.Int) error {
+	power, err := k.GetPowerFromAmount(ctx, amount.BigInt())
+	if err != nil {
+		return err
+	}
+
+	if err := k.storeValidatorStake(ctx, types.NewValidatorStake(validator.ID, amount)); err != nil {
+		return err
+	}
+
+	validator.VotingPower = power
+	return nil
+}
+
+// storeValidatorStake stores stake amount of validator
+func (k *Keeper) storeValidatorStake(ctx sdk.Context, stake types.ValidatorStake) error {
+	bz, err := k.cdc.MarshalBinaryBare(stake)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetValidatorStakeKey(stake.ID), bz)
+	k.Logger(ctx).Debug("Validator stake stored", "stake", stake.String())
+	return nil
+}
+
+// GetValidatorStake returns stake amount of validator.
+// Stake of validators without stored stake is derived from voting power
+func (k *Keeper) GetValidatorStake(ctx sdk.Context, validator hmTypes.Validator) hmTypes.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetValidatorStakeKey(validator.ID))
+	if bz != nil {
+		var stake types.ValidatorStake
+		if err := k.cdc.UnmarshalBinaryBare(bz, &stake); err == nil {
+			return stake.Amount
+		}
+	}
+
+	return hmTypes.NewInt(validator.VotingPower).Mul(k.GetPowerReduction(ctx))
+}
+
+// GetAllValidatorStakes returns stored stake amounts of all validators
+func (k *Keeper) GetAllValidatorStakes(ctx sdk.Context) (stakes []types.ValidatorStake) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, ValidatorStakeKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var stake types.ValidatorStake
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &stake); err != nil {
+			continue
+		}
+		stakes = append(stakes, stake)
+	}
+
+	return
+}
+
+//
+// Validator set snapshots
+//
+
+// GetValidatorSetSnapshotKey returns validator set snapshot key for height at which validator set was updated
+func GetValidatorSetSnapshotKey(height int64) []byte {
+	return append(append([]byte{}, ValidatorSetSnapshotKey...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// snapshotValidatorSet stores validator set as snapshot of current height, one snapshot is kept per update.
+// Snapshots out of retention are pruned.
+func (k *Keeper) snapshotValidatorSet(ctx sdk.Context, validatorSet hmTypes.ValidatorSet) error {
+	ackCount := k.moduleCommunicator.GetACKCount(ctx)
+
+	snapshot := types.ValidatorSetSnapshot{
+		AckCount:     ackCount,
+		Height:       ctx.BlockHeight(),
+		ValidatorSet: validatorSet,
+	}
+
+	bz, err := k.cdc.MarshalBinaryBare(snapshot)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetValidatorSetSnapshotKey(snapshot.Height), bz)
+
+	k.PruneValidatorSetSnapshots(ctx, ackCount)
+	return nil
+}
+
+// GetValidatorSetSnapshot returns validator set which was active at end of ack count
+func (k *Keeper) GetValidatorSetSnapshot(ctx sdk.Context, ackCount uint64) (types.ValidatorSetSnapshot, bool) {
+	return k.getLatestValidatorSetSnapshot(ctx, func(snapshot types.ValidatorSetSnapshot) bool {
+		return snapshot.AckCount <= ackCount
+	})
+}
+
+// GetValidatorSetSnapshotAtHeight returns validator set which was active at end of block height
+func (k *Keeper) GetValidatorSetSnapshotAtHeight(ctx sdk.Context, height int64) (types.ValidatorSetSnapshot, bool) {
+	return k.getLatestValidatorSetSnapshot(ctx, func(snapshot types.ValidatorSetSnapshot) bool {
+		return snapshot.Height <= height
+	})
+}
+
+// getLatestValidatorSetSnapshot returns latest snapshot matching filter
+func (k *Keeper) getLatestValidatorSetSnapshot(ctx sdk.Context, filter func(types.ValidatorSetSnapshot) bool) (snapshot types.ValidatorSetSnapshot, ok bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStoreReversePrefixIterator(store, ValidatorSetSnapshotKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var result types.ValidatorSetSnapshot
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &result); err != nil {
+			continue
+		}
+
+		if filter(result) {
+			return result, true
+		}
+	}
+	return snapshot, false
+}
+
+// PruneValidatorSetSnapshots removes snapshots which are out of retention at ack count.
+// Latest snapshot before retention is kept, it is still active at first ack count of retention
+func (k *Keeper) PruneValidatorSetSnapshots(ctx sdk.Context, ackCount uint64) {
+	retention := k.GetValidatorSetSnapshotRetention(ctx)
+	if retention == 0 || ackCount <= retention {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, ValidatorSetSnapshotKey)
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.ValidatorSetSnapshot
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &snapshot); err != nil {
+			continue
+		}
+
+		if snapshot.AckCount >= ackCount-retention {
+			break
+		}
+		keys = append(keys, append([]byte{}, iterator.Key()...))
+	}
+	iterator.Close()
+
+	// keep snapshot active at start of retention
+	if len(keys) > 0 {
+		keys = keys[:len(keys)-1]
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// SetParams sets the staking module's parameters.
+// Voting power of validators is recomputed if power reduction changed
+func (k *Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.SetMaxValidators(ctx, params.MaxValidators)
+	k.SetMinSelfStake(ctx, params.MinSelfStake)
+	k.SetValidatorSetSnapshotRetention(ctx, params.ValidatorSetSnapshotRetention)
+	k.SetPowerReduction(ctx, params.PowerReduction)
+}
+
+// GetParams gets the staking module's parameters, params missing in store have default values
+func (k *Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.NewParams(
+		k.GetMaxValidators(ctx),
+		k.GetPowerReduction(ctx),
+		k.GetMinSelfStake(ctx),
+		k.GetValidatorSetSnapshotRetention(ctx),
+	)
+}
+
+// GetValidatorSetSnapshotRetention returns number of ack counts snapshots are kept for
+func (k *Keeper) GetValidatorSetSnapshotRetention(ctx sdk.Context) uint64 {
+	retention := types.DefaultValidatorSetSnapshotRetention
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyValidatorSetSnapshotRetention, &retention)
+	return retention
+}
+
+// SetValidatorSetSnapshotRetention sets number of ack counts snapshots are kept for, 0 keeps all snapshots
+func (k *Keeper) SetValidatorSetSnapshotRetention(ctx sdk.Context, retention uint64) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyValidatorSetSnapshotRetention, retention)
+}
+
+// GetMaxValidators returns max number of validators in validator set
+func (k *Keeper) GetMaxValidators(ctx sdk.Context) uint64 {
+	maxValidators := types.DefaultMaxValidators
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyMaxValidators, &maxValidators)
+	return maxValidators
+}
+
+// SetMaxValidators sets max number of validators in validator set, 0 means no limit
+func (k *Keeper) SetMaxValidators(ctx sdk.Context, maxValidators uint64) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyMaxValidators, maxValidators)
+}
+
+// GetPowerReduction returns stake amount backing one unit of voting power
+func (k *Keeper) GetPowerReduction(ctx sdk.Context) hmTypes.Int {
+	var powerReduction hmTypes.Int
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyPowerReduction, &powerReduction)
+	if powerReduction.I == nil {
+		return types.DefaultPowerReduction
+	}
+	return powerReduction
+}
+
+// SetPowerReduction sets stake amount backing one unit of voting power.
+// Voting power of validators is recomputed from their stake if power reduction changed
+func (k *Keeper) SetPowerReduction(ctx sdk.Context, powerReduction hmTypes.Int) {
+	if k.GetPowerReduction(ctx).Equal(powerReduction) {
+		k.paramSpace.Set(ctx, types.ParamStoreKeyPowerReduction, powerReduction)
+		return
+	}
+
+	// stake of validators without stored stake is derived from power with previous power reduction
+	for _, validator := range k.GetAllValidators(ctx) {
+		if err := k.storeValidatorStake(ctx, types.NewValidatorStake(validator.ID, k.GetValidatorStake(ctx, *validator))); err != nil {
+			k.Logger(ctx).Error("Unable to store validator stake", "error", err, "validatorId", validator.ID)
+		}
+	}
+
+	k.paramSpace.Set(ctx, types.ParamStoreKeyPowerReduction, powerReduction)
+
+	if migrated := k.MigrateValidatorPowers(ctx); migrated > 0 {
+		k.Logger(ctx).Info("Recomputed validator voting power with new power reduction", "validators", migrated)
+	}
+}
+
+// GetMinSelfStake returns min stake of validator to be part of validator set
+func (k *Keeper) GetMinSelfStake(ctx sdk.Context) hmTypes.Int {
+	var minSelfStake hmTypes.Int
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyMinSelfStake, &minSelfStake)
+	if minSelfStake.I == nil {
+		return types.DefaultMinSelfStake
+	}
+	return minSelfStake
+}
+
+// SetMinSelfStake sets min stake of validator to be part of validator set
+func (k *Keeper) SetMinSelfStake(ctx sdk.Context, minSelfStake hmTypes.Int) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyMinSelfStake, minSelfStake)
+}
+
+// GetPowerFromAmount returns voting power backed by stake amount
+func (k *Keeper) GetPowerFromAmount(ctx sdk.Context, amount *big.Int) (int64, error) {
+	power, err := helper.GetPowerFromAmountWithReduction(amount, k.GetPowerReduction(ctx).BigInt())
+	if err != nil {
+		return 0, err
+	}
+	return power.Int64(), nil
+}
+
+// HasMinSelfStake checks if validator stake is at least min self stake.
+// Validators with less stake stay standby
+func (k *Keeper) HasMinSelfStake(ctx sdk.Context, validator hmTypes.Validator) bool {
+	return k.GetValidatorStake(ctx, validator).GTE(k.GetMinSelfStake(ctx))
+}
+
+// MinSelfStakeFilter returns filter selecting validators with at least min self stake
+func (k *Keeper) MinSelfStakeFilter(ctx sdk.Context) func(*hmTypes.Validator) bool {
+	minSelfStake := k.GetMinSelfStake(ctx)
+	return func(validator *hmTypes.Validator) bool {
+		return k.GetValidatorStake(ctx, *validator).GTE(minSelfStake)
+	}
+}
+
+// MigrateValidatorPowers recomputes voting power of validators from their stake with current power reduction.
+// It returns number of updated validators
+func (k *Keeper) MigrateValidatorPowers(ctx sdk.Context) int {
+	powerReduction := k.GetPowerReduction(ctx)
+
+	updated := 0
+	for _, validator := range k.GetAllValidators(ctx) {
+		power := k.GetValidatorStake(ctx, *validator).Quo(powerReduction).Int64()
+		if power == validator.VotingPower {
+			continue
+		}
+
+		validator.VotingPower = power
+		if err := k.AddValidator(ctx, *validator); err != nil {
+			k.Logger(ctx).Error("Unable to migrate validator power", "error", err, "validatorId", validator.ID)
+			continue
+		}
+		updated++
+	}
+
+	return updated
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/hex"
 	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/staking"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
 	cmn "github.com/maticnetwork/heimdall/test"
 	"github.com/maticnetwork/heimdall/types"
 	"github.com/stretchr/testify/require"
@@ -163,7 +165,7 @@ func TestValidatorSetChange(t *testing.T) {
 		// 	keeper.GetAllValidators(ctx), // All validators
 		// 	5,                            // ack count
 		// )
-		setUpdates := helper.GetUpdatedValidators(currentValSet, keeper.GetAllValidators(ctx), 5)
+		setUpdates := helper.GetUpdatedValidators(currentValSet, keeper.GetAllValidators(ctx), 5, keeper.GetMaxValidators(ctx), keeper.MinSelfStakeFilter(ctx))
 		currentValSet.UpdateWithChangeSet(setUpdates)
 		updatedValSet := currentValSet
 		t.Log("Validators in updated validator set")
@@ -207,7 +209,7 @@ func TestValidatorSetChange(t *testing.T) {
 		// 	keeper.GetAllValidators(ctx), // All validators
 		// 	5,                            // ack count
 		// )
-		setUpdates := helper.GetUpdatedValidators(currentValSet, keeper.GetAllValidators(ctx), 5)
+		setUpdates := helper.GetUpdatedValidators(currentValSet, keeper.GetAllValidators(ctx), 5, keeper.GetMaxValidators(ctx), keeper.MinSelfStakeFilter(ctx))
 		currentValSet.UpdateWithChangeSet(setUpdates)
 
 		t.Log("Validators in updated validator set")
@@ -243,7 +245,7 @@ func TestValidatorSetChange(t *testing.T) {
 		// 	keeper.GetAllValidators(ctx), // All validators
 		// 	5,                            // ack count
 		// )
-		setUpdates := helper.GetUpdatedValidators(&currentValSet, keeper.GetAllValidators(ctx), 5)
+		setUpdates := helper.GetUpdatedValidators(&currentValSet, keeper.GetAllValidators(ctx), 5, keeper.GetMaxValidators(ctx), keeper.MinSelfStakeFilter(ctx))
 		currentValSet.UpdateWithChangeSet(setUpdates)
 		t.Log("Validators in updated validator set")
 		for _, v := range currentValSet.Validators {
@@ -318,3 +320,296 @@ func TestDividendAccountTree(t *testing.T) {
 // 	_, _ = checkpointTypes.GetRewardRootHash(divAccounts)
 
 // }
+
+func TestValidatorSetSnapshots(t *testing.T) {
+	ctx, keeper, checkpointKeeper := cmn.CreateTestInput(t, false)
+	keeper.SetValidatorSetSnapshotRetention(ctx, 2)
+
+	ctx = ctx.WithBlockHeight(1)
+	valSet := cmn.LoadValidatorSet(4, t, keeper, ctx, false, 10)
+
+	snapshot, ok := keeper.GetValidatorSetSnapshot(ctx, 0)
+	require.True(t, ok)
+	require.Equal(t, int64(1), snapshot.Height)
+	require.Equal(t, valSet.Hash(), snapshot.ValidatorSet.Hash())
+
+	// every ack rotates proposer, which stores validator set of new ack count
+	proposers := make(map[int64]types.ValidatorID)
+	for ack := uint64(1); ack <= 4; ack++ {
+		ctx = ctx.WithBlockHeight(int64(ack * 10))
+		checkpointKeeper.UpdateACKCount(ctx)
+		keeper.IncrementAccum(ctx, 1)
+		proposers[ctx.BlockHeight()] = keeper.GetValidatorSet(ctx).Proposer.ID
+
+		// validator set updated later at same ack count is kept as separate snapshot
+		ctx = ctx.WithBlockHeight(int64(ack*10 + 5))
+		keeper.IncrementAccum(ctx, 1)
+		proposers[ctx.BlockHeight()] = keeper.GetValidatorSet(ctx).Proposer.ID
+
+		snapshot, ok := keeper.GetValidatorSetSnapshot(ctx, ack)
+		require.True(t, ok)
+		require.Equal(t, ack, snapshot.AckCount)
+		require.Equal(t, int64(ack*10+5), snapshot.Height, "snapshot of ack should be validator set active at end of ack")
+		require.Equal(t, keeper.GetValidatorSet(ctx).Proposer.ID, snapshot.ValidatorSet.Proposer.ID)
+	}
+
+	// snapshot by height is validator set active at height
+	for _, height := range []int64{30, 34, 35, 44} {
+		snapshot, ok = keeper.GetValidatorSetSnapshotAtHeight(ctx, height)
+		require.True(t, ok)
+		effective := height - height%5
+		require.Equal(t, effective, snapshot.Height)
+		require.Equal(t, proposers[effective], snapshot.ValidatorSet.Proposer.ID)
+	}
+	snapshot, ok = keeper.GetValidatorSetSnapshotAtHeight(ctx, 1000)
+	require.True(t, ok)
+	require.Equal(t, uint64(4), snapshot.AckCount)
+
+	// ack count without validator set update has set of previous update
+	ctx = ctx.WithBlockHeight(50)
+	checkpointKeeper.UpdateACKCount(ctx)
+	snapshot, ok = keeper.GetValidatorSetSnapshot(ctx, 5)
+	require.True(t, ok)
+	require.Equal(t, int64(45), snapshot.Height)
+
+	// snapshots older than retention are pruned, latest one before retention is still active at start of retention
+	_, ok = keeper.GetValidatorSetSnapshot(ctx, 0)
+	require.False(t, ok, "snapshot of ack 0 should be pruned")
+	snapshot, ok = keeper.GetValidatorSetSnapshotAtHeight(ctx, 19)
+	require.True(t, ok)
+	require.Equal(t, int64(15), snapshot.Height)
+	_, ok = keeper.GetValidatorSetSnapshotAtHeight(ctx, 14)
+	require.False(t, ok, "snapshot before retention should be pruned")
+}
+
+func TestGetValidatorList(t *testing.T) {
+	ctx, keeper, checkpointKeeper := cmn.CreateTestInput(t, false)
+	cmn.LoadValidatorSet(4, t, keeper, ctx, false, 0)
+	checkpointKeeper.UpdateACKCountWithValue(ctx, 2)
+
+	list := keeper.GetValidatorList(ctx, "", 1, 10)
+	require.Equal(t, uint64(4), list.ValidatorCount)
+	require.Equal(t, uint64(2), list.AckCount)
+	require.Equal(t, int64(40), list.ActivePower)
+	require.Len(t, list.Validators, 4)
+	for i, validator := range list.Validators {
+		require.Equal(t, types.NewValidatorID(uint64(i+1)), validator.ID, "validators should be ordered by ID")
+	}
+
+	// jail first, unbond second and delay third validator
+	vals := list.Validators
+	vals[0].Jailed = true
+	vals[1].EndEpoch = 5
+	vals[2].StartEpoch = 10
+	for _, validator := range vals[:3] {
+		require.NoError(t, keeper.AddValidator(ctx, validator))
+	}
+
+	list = keeper.GetValidatorList(ctx, "", 1, 10)
+	require.Equal(t, uint64(4), list.ValidatorCount)
+	require.Equal(t, int64(10), list.ActivePower)
+	require.Equal(t, int64(10), list.UnbondingPower)
+
+	for status, id := range map[string]uint64{
+		stakingTypes.ValidatorStatusJailed:    1,
+		stakingTypes.ValidatorStatusUnbonding: 2,
+		stakingTypes.ValidatorStatusPending:   3,
+		stakingTypes.ValidatorStatusActive:    4,
+	} {
+		list = keeper.GetValidatorList(ctx, status, 1, 10)
+		require.Equal(t, uint64(1), list.ValidatorCount, "status %v", status)
+		require.Len(t, list.Validators, 1, "status %v", status)
+		require.Equal(t, types.NewValidatorID(id), list.Validators[0].ID, "status %v", status)
+	}
+
+	list = keeper.GetValidatorList(ctx, stakingTypes.ValidatorStatusExited, 1, 10)
+	require.Equal(t, uint64(0), list.ValidatorCount)
+	require.Empty(t, list.Validators)
+
+	// validators left out by max validators are standby
+	keeper.SetMaxValidators(ctx, 1)
+	vals[3].VotingPower = 5
+	require.NoError(t, keeper.AddValidator(ctx, vals[3]))
+	list = keeper.GetValidatorList(ctx, stakingTypes.ValidatorStatusStandby, 1, 10)
+	require.Equal(t, uint64(1), list.ValidatorCount)
+	require.Equal(t, types.NewValidatorID(4), list.Validators[0].ID)
+	list = keeper.GetValidatorList(ctx, "", 1, 10)
+	require.Equal(t, int64(0), list.ActivePower, "standby power should not be active")
+	require.Equal(t, int64(10), list.UnbondingPower)
+	keeper.SetMaxValidators(ctx, 0)
+
+	// pagination
+	list = keeper.GetValidatorList(ctx, "", 2, 3)
+	require.Equal(t, uint64(4), list.ValidatorCount)
+	require.Len(t, list.Validators, 1)
+	require.Equal(t, types.NewValidatorID(4), list.Validators[0].ID)
+
+	list = keeper.GetValidatorList(ctx, "", 3, 3)
+	require.NotNil(t, list.Validators)
+	require.Empty(t, list.Validators, "page out of range should be empty")
+}
+
+func TestGetProposerSchedule(t *testing.T) {
+	ctx, keeper, _ := cmn.CreateTestInput(t, false)
+	valSet := cmn.LoadValidatorSet(4, t, keeper, ctx, false, 0)
+
+	// validator leaving after second ack
+	leaving := *valSet.Validators[0]
+	leaving.EndEpoch = 2
+	require.NoError(t, keeper.AddValidator(ctx, leaving))
+
+	// validator joining after second ack
+	joining := cmn.GenRandomVal(1, 3, 10, 0, false, 5)[0]
+	joining.EndEpoch = 0
+	require.NoError(t, keeper.AddValidator(ctx, joining))
+
+	schedule := keeper.GetProposerSchedule(ctx, 3)
+	require.Len(t, schedule, 4)
+	for i, projection := range schedule {
+		require.Equal(t, uint64(i), projection.AckCount)
+	}
+
+	currentSet := keeper.GetValidatorSet(ctx)
+	require.Equal(t, currentSet.Hash(), schedule[0].ValidatorSetHash.Bytes())
+	require.Equal(t, keeper.GetCurrentProposer(ctx).Signer, schedule[0].Proposer.Signer)
+	require.Equal(t, keeper.GetNextProposer(ctx).Signer, schedule[1].Proposer.Signer)
+
+	// set changes only at ack count where joining and leaving validators are applied
+	require.Equal(t, schedule[0].ValidatorSetHash, schedule[1].ValidatorSetHash)
+	require.NotEqual(t, schedule[1].ValidatorSetHash, schedule[2].ValidatorSetHash)
+	require.Equal(t, schedule[2].ValidatorSetHash, schedule[3].ValidatorSetHash)
+
+	// projection doesn't modify stored validator set
+	storedSet := keeper.GetValidatorSet(ctx)
+	require.Equal(t, currentSet.Hash(), storedSet.Hash())
+}
+
+func TestMaxValidators(t *testing.T) {
+	ctx, keeper, _ := cmn.CreateTestInput(t, false)
+	cmn.LoadValidatorSet(4, t, keeper, ctx, false, 0)
+	require.Equal(t, stakingTypes.DefaultMaxValidators, keeper.GetMaxValidators(ctx))
+
+	// raise stake of last validator
+	validator, ok := keeper.GetValidatorFromValID(ctx, types.NewValidatorID(4))
+	require.True(t, ok)
+	validator.VotingPower = 20
+	require.NoError(t, keeper.AddValidator(ctx, validator))
+
+	keeper.SetMaxValidators(ctx, 2)
+	currentValidators := keeper.GetCurrentValidators(ctx)
+	require.Len(t, currentValidators, 2)
+	require.Equal(t, types.NewValidatorID(4), currentValidators[0].ID, "validator with highest power should be first")
+	require.Equal(t, types.NewValidatorID(1), currentValidators[1].ID, "ties should be broken by validator ID")
+
+	// standby validator stays in state
+	standby, ok := keeper.GetValidatorFromValID(ctx, types.NewValidatorID(3))
+	require.True(t, ok)
+	require.False(t, keeper.IsCurrentValidatorByAddress(ctx, standby.Signer.Bytes()))
+
+	// standby validators are removed from validator set
+	validatorSet := keeper.GetValidatorSet(ctx)
+	setUpdates := helper.GetUpdatedValidators(&validatorSet, keeper.GetAllValidators(ctx), 0, keeper.GetMaxValidators(ctx), keeper.MinSelfStakeFilter(ctx))
+	require.NoError(t, validatorSet.UpdateWithChangeSet(setUpdates))
+	require.Len(t, validatorSet.Validators, 2)
+
+	// standby validator joins once slot opens
+	keeper.SetMaxValidators(ctx, 3)
+	joining, ok := keeper.GetValidatorFromValID(ctx, types.NewValidatorID(2))
+	require.True(t, ok)
+	require.True(t, keeper.IsCurrentValidatorByAddress(ctx, joining.Signer.Bytes()))
+	setUpdates = helper.GetUpdatedValidators(&validatorSet, keeper.GetAllValidators(ctx), 0, keeper.GetMaxValidators(ctx), keeper.MinSelfStakeFilter(ctx))
+	require.NoError(t, validatorSet.UpdateWithChangeSet(setUpdates))
+	require.Len(t, validatorSet.Validators, 3)
+	_, joined := validatorSet.GetByAddress(joining.Signer.Bytes())
+	require.NotNil(t, joined)
+	_, notJoined := validatorSet.GetByAddress(standby.Signer.Bytes())
+	require.Nil(t, notJoined, "validator with higher ID should stay standby")
+}
+
+func TestMigrateValidatorPowers(t *testing.T) {
+	ctx, keeper, _ := cmn.CreateTestInput(t, false)
+	cmn.LoadValidatorSet(4, t, keeper, ctx, false, 0)
+
+	// nothing to migrate with default power reduction
+	require.Equal(t, 0, keeper.MigrateValidatorPowers(ctx))
+	require.Len(t, keeper.GetAllValidatorStakes(ctx), 0)
+
+	// 0.1 token per voting power, stake of existing validators is stored before migration
+	keeper.SetPowerReduction(ctx, types.NewIntWithDecimal(1, 17))
+	for _, validator := range keeper.GetAllValidators(ctx) {
+		require.Equal(t, int64(100), validator.VotingPower)
+	}
+	require.Len(t, keeper.GetAllValidatorStakes(ctx), 4)
+	require.Equal(t, 0, keeper.MigrateValidatorPowers(ctx), "migration should be applied once")
+
+	power, err := keeper.GetPowerFromAmount(ctx, big.NewInt(0).Mul(big.NewInt(3), helper.DefaultPowerReduction))
+	require.NoError(t, err)
+	require.Equal(t, int64(30), power)
+
+	// power is derived from stake, no precision is lost when power reduction changes back and forth
+	validator, ok := keeper.GetValidatorFromValID(ctx, types.NewValidatorID(1))
+	require.True(t, ok)
+	require.NoError(t, keeper.SetValidatorStake(ctx, &validator, types.NewIntWithDecimal(155, 17)))
+	require.NoError(t, keeper.AddValidator(ctx, validator))
+	require.Equal(t, int64(155), validator.VotingPower)
+
+	keeper.SetPowerReduction(ctx, types.NewIntFromBigInt(helper.DefaultPowerReduction))
+	validator, _ = keeper.GetValidatorFromValID(ctx, types.NewValidatorID(1))
+	require.Equal(t, int64(15), validator.VotingPower)
+
+	keeper.SetPowerReduction(ctx, types.NewIntWithDecimal(1, 17))
+	validator, _ = keeper.GetValidatorFromValID(ctx, types.NewValidatorID(1))
+	require.Equal(t, int64(155), validator.VotingPower)
+}
+
+func TestMinSelfStake(t *testing.T) {
+	ctx, keeper, _ := cmn.CreateTestInput(t, false)
+	cmn.LoadValidatorSet(4, t, keeper, ctx, false, 0)
+
+	// validator with 5 tokens stays standby if min self stake is 10 tokens
+	keeper.SetMinSelfStake(ctx, types.NewIntWithDecimal(10, 18))
+
+	validator, ok := keeper.GetValidatorFromValID(ctx, types.NewValidatorID(1))
+	require.True(t, ok)
+	require.NoError(t, keeper.SetValidatorStake(ctx, &validator, types.NewIntWithDecimal(5, 18)))
+	require.NoError(t, keeper.AddValidator(ctx, validator))
+	require.False(t, keeper.HasMinSelfStake(ctx, validator))
+
+	require.Len(t, keeper.GetCurrentValidators(ctx), 3)
+	require.False(t, keeper.IsCurrentValidatorByAddress(ctx, validator.Signer.Bytes()))
+
+	// raw stake is compared with min self stake, not voting power rounded down
+	keeper.SetMinSelfStake(ctx, types.NewIntWithDecimal(45, 17))
+	require.NoError(t, keeper.SetValidatorStake(ctx, &validator, types.NewIntWithDecimal(46, 17)))
+	require.NoError(t, keeper.AddValidator(ctx, validator))
+	require.Equal(t, int64(4), validator.VotingPower)
+	require.True(t, keeper.HasMinSelfStake(ctx, validator))
+	require.Len(t, keeper.GetCurrentValidators(ctx), 4)
+}
+
+func TestParams(t *testing.T) {
+	ctx, keeper, _ := cmn.CreateTestInput(t, false)
+	cmn.LoadValidatorSet(4, t, keeper, ctx, false, 0)
+
+	// params missing in store have default values, which don't limit validator set
+	require.Equal(t, stakingTypes.DefaultParams(), keeper.GetParams(ctx))
+	require.Len(t, keeper.GetCurrentValidators(ctx), 4)
+
+	// voting power is recomputed when power reduction changes through params
+	params := stakingTypes.NewParams(3, types.NewIntWithDecimal(1, 17), types.NewIntWithDecimal(1, 18), 10)
+	require.NoError(t, params.Validate())
+	keeper.SetParams(ctx, params)
+	require.Equal(t, params, keeper.GetParams(ctx))
+	validator, ok := keeper.GetValidatorFromValID(ctx, types.NewValidatorID(1))
+	require.True(t, ok)
+	require.Equal(t, int64(100), validator.VotingPower)
+	require.Len(t, keeper.GetCurrentValidators(ctx), 3)
+
+	// params are exported with genesis
+	genesis := staking.ExportGenesis(ctx, keeper)
+	require.Equal(t, params, genesis.Params)
+	require.NoError(t, stakingTypes.ValidateGenesis(genesis))
+
+	genesis.Params.PowerReduction = types.ZeroInt()
+	require.Error(t, stakingTypes.ValidateGenesis(genesis), "zero power reduction should be invalid")
+}
@@ -23,6 +23,7 @@ var (
 	_ module.AppModule            = AppModule{}
 	_ module.AppModuleBasic       = AppModuleBasic{}
 	_ hmTypes.HeimdallModuleBasic = AppModule{}
+	_ hmTypes.SideModule          = AppModule{}
 	// _ module.AppModuleSimulation = AppModule{}
 )
 
@@ -140,6 +141,16 @@ func (am AppModule) NewHandler() sdk.Handler {
 	return NewHandler(am.keeper, am.contractCaller)
 }
 
+// NewSideTxHandler returns side tx handler for the module.
+func (am AppModule) NewSideTxHandler() hmTypes.SideTxHandler {
+	return NewSideTxHandler(am.keeper, am.contractCaller)
+}
+
+// NewPostTxHandler returns post tx handler for the module.
+func (am AppModule) NewPostTxHandler() hmTypes.PostTxHandler {
+	return NewPostTxHandler(am.keeper)
+}
+
 // QuerierRoute returns the staking module's querier route name.
 func (AppModule) QuerierRoute() string {
 	return types.QuerierRoute
@@ -176,8 +187,8 @@ func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
 // BeginBlock returns the begin blocker for the auth module.
 func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
-// EndBlock returns the end blocker for the auth module. It returns no validator
-// updates.
-func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
-	return []abci.ValidatorUpdate{}
+// EndBlock returns the end blocker for the staking module. It returns validator
+// updates when ack count or validators changed in block.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	return EndBlocker(ctx, am.keeper)
 }
@@ -18,6 +18,8 @@ import (
 func NewQuerier(keeper Keeper) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
 		switch path[0] {
+		case types.QueryParams:
+			return handleQueryParams(ctx, req, keeper)
 		case types.QueryCurrentValidatorSet:
 			return handleQueryCurrentValidatorSet(ctx, req, keeper)
 		case types.QuerySigner:
@@ -30,6 +32,8 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return handleQueryProposer(ctx, req, keeper)
 		case types.QueryCurrentProposer:
 			return handleQueryCurrentProposer(ctx, req, keeper)
+		case types.QueryProposerSchedule:
+			return handleQueryProposerSchedule(ctx, req, keeper)
 		case types.QueryDividendAccount:
 			return handleQueryDividendAccount(ctx, req, keeper)
 		case types.QueryDividendAccountRoot:
@@ -38,6 +42,16 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return handleQueryAccountProof(ctx, req, keeper)
 		case types.QueryVerifyAccountProof:
 			return handleQueryVerifyAccountProof(ctx, req, keeper)
+		case types.QueryValidatorDelegations:
+			return handleQueryValidatorDelegations(ctx, req, keeper)
+		case types.QueryDelegatorDelegations:
+			return handleQueryDelegatorDelegations(ctx, req, keeper)
+		case types.QueryValidatorSetByAck:
+			return handleQueryValidatorSetByAck(ctx, req, keeper)
+		case types.QueryValidatorSetByHeight:
+			return handleQueryValidatorSetByHeight(ctx, req, keeper)
+		case types.QueryValidators:
+			return handleQueryValidators(ctx, req, keeper)
 
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown staking query endpoint")
@@ -45,6 +59,14 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 	}
 }
 
+func handleQueryParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := json.Marshal(keeper.GetParams(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 func handleQueryCurrentValidatorSet(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	// get validator set
 	validatorSet := keeper.GetValidatorSet(ctx)
@@ -70,7 +92,7 @@ func handleQuerySigner(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([
 	}
 
 	// json record
-	bz, err := json.Marshal(validator)
+	bz, err := json.Marshal(withValidatorMetadata(ctx, keeper, validator))
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
 	}
@@ -90,7 +112,37 @@ func handleQueryValidator(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 	}
 
 	// json record
-	bz, err := json.Marshal(validator)
+	bz, err := json.Marshal(withValidatorMetadata(ctx, keeper, validator))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+// withValidatorMetadata attaches stored metadata to validator
+func withValidatorMetadata(ctx sdk.Context, keeper Keeper, validator hmTypes.Validator) types.ValidatorWithMetadata {
+	result := types.ValidatorWithMetadata{Validator: validator}
+	if metadata, ok := keeper.GetValidatorMetadata(ctx, validator.ID); ok {
+		result.Metadata = &metadata
+	}
+	return result
+}
+
+func handleQueryValidators(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorsParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if params.Status != "" && !types.IsValidValidatorStatus(params.Status) {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("Invalid validator status %v", params.Status))
+	}
+
+	// get validator list
+	validatorList := keeper.GetValidatorList(ctx, params.Status, params.Page, params.Limit)
+
+	// json record
+	bz, err := json.Marshal(validatorList)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
 	}
@@ -155,6 +207,23 @@ func handleQueryCurrentProposer(ctx sdk.Context, req abci.RequestQuery, keeper K
 	return bz, nil
 }
 
+func handleQueryProposerSchedule(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryProposerParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	// get proposer schedule
+	schedule := keeper.GetProposerSchedule(ctx, params.Times)
+
+	// json record
+	bz, err := json.Marshal(schedule)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 func handleQueryDividendAccount(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	var params types.QueryDividendAccountParams
 	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
@@ -235,3 +304,83 @@ func handleQueryVerifyAccountProof(ctx sdk.Context, req abci.RequestQuery, keepe
 	}
 	return bz, nil
 }
+
+func handleQueryValidatorDelegations(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	// get delegations bonded to validator
+	delegations := keeper.GetValidatorDelegations(ctx, params.ValidatorID)
+	if delegations == nil {
+		delegations = []hmTypes.Delegation{}
+	}
+
+	// json record
+	bz, err := json.Marshal(delegations)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryDelegatorDelegations(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryDelegatorParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	// get delegations of delegator
+	delegations := keeper.GetDelegatorDelegations(ctx, params.DelegatorAddress)
+	if delegations == nil {
+		delegations = []hmTypes.Delegation{}
+	}
+
+	// json record
+	bz, err := json.Marshal(delegations)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryValidatorSetByAck(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorSetAckParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	// get validator set snapshot
+	snapshot, ok := keeper.GetValidatorSetSnapshot(ctx, params.AckCount)
+	if !ok {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("No validator set found for ack count %v", params.AckCount))
+	}
+
+	// json record
+	bz, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryValidatorSetByHeight(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorSetHeightParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	// get validator set snapshot
+	snapshot, ok := keeper.GetValidatorSetSnapshotAtHeight(ctx, params.Height)
+	if !ok {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("No validator set found for height %v", params.Height))
+	}
+
+	// json record
+	bz, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
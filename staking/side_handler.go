@@ -0,0 +1,959 @@
+package staking
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/staking/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// NewSideTxHandler returns side tx handler for staking module
+func NewSideTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxHandler {
+	return func(ctx sdk.Context, msg sdk.Msg) hmTypes.SideTxResultType {
+		switch msg := msg.(type) {
+		case types.MsgValidatorJoin:
+			return SideHandleMsgValidatorJoin(ctx, msg, k, contractCaller)
+		case types.MsgStakeUpdate:
+			return SideHandleMsgStakeUpdate(ctx, msg, k, contractCaller)
+		case types.MsgSignerUpdate:
+			return SideHandleMsgSignerUpdate(ctx, msg, k, contractCaller)
+		case types.MsgValidatorExit:
+			return SideHandleMsgValidatorExit(ctx, msg, k, contractCaller)
+		case types.MsgValidatorJailed:
+			return SideHandleMsgValidatorJailed(ctx, msg, k, contractCaller)
+		case types.MsgValidatorUnjailed:
+			return SideHandleMsgValidatorUnjailed(ctx, msg, k, contractCaller)
+		case types.MsgValidatorReStake:
+			return SideHandleMsgValidatorReStake(ctx, msg, k, contractCaller)
+		case types.MsgDelegatorBond:
+			return SideHandleMsgDelegatorBond(ctx, msg, k, contractCaller)
+		case types.MsgDelegatorUnbond:
+			return SideHandleMsgDelegatorUnbond(ctx, msg, k, contractCaller)
+		default:
+			return hmTypes.SideTxResultSkip
+		}
+	}
+}
+
+// NewPostTxHandler returns post tx handler for staking module
+func NewPostTxHandler(k Keeper) hmTypes.PostTxHandler {
+	return func(ctx sdk.Context, msg sdk.Msg, result hmTypes.SideTxResultType) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgValidatorJoin:
+			return PostHandleMsgValidatorJoin(ctx, msg, result, k)
+		case types.MsgStakeUpdate:
+			return PostHandleMsgStakeUpdate(ctx, msg, result, k)
+		case types.MsgSignerUpdate:
+			return PostHandleMsgSignerUpdate(ctx, msg, result, k)
+		case types.MsgValidatorExit:
+			return PostHandleMsgValidatorExit(ctx, msg, result, k)
+		case types.MsgValidatorJailed:
+			return PostHandleMsgValidatorJailed(ctx, msg, result, k)
+		case types.MsgValidatorUnjailed:
+			return PostHandleMsgValidatorUnjailed(ctx, msg, result, k)
+		case types.MsgValidatorReStake:
+			return PostHandleMsgValidatorReStake(ctx, msg, result, k)
+		case types.MsgDelegatorBond:
+			return PostHandleMsgDelegatorBond(ctx, msg, result, k)
+		case types.MsgDelegatorUnbond:
+			return PostHandleMsgDelegatorUnbond(ctx, msg, result, k)
+		default:
+			return sdk.ErrTxDecode("Invalid message in staking module").Result()
+		}
+	}
+}
+
+// SideHandleMsgValidatorJoin validates staked event of validator join against mainchain
+func SideHandleMsgValidatorJoin(ctx sdk.Context, msg types.MsgValidatorJoin, k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxResultType {
+	// get confirmed tx receipt
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), k.GetConfirmationBlocks(ctx))
+	if err != nil || receipt == nil {
+		k.Logger(ctx).Error("Unable to fetch confirmed tx receipt", "error", err, "txHash", msg.TxHash)
+		return hmTypes.SideTxResultSkip
+	}
+
+	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+		k.Logger(ctx).Error("BlockNumber in message doesn't match block number in receipt", "msgBlockNumber", msg.BlockNumber, "receiptBlockNumber", receipt.BlockNumber)
+		return hmTypes.SideTxResultNo
+	}
+
+	// decode staked event
+	eventLog, err := contractCaller.DecodeValidatorJoinEvent(receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Unable to decode staked event", "error", err, "txHash", msg.TxHash, "logIndex", msg.LogIndex)
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
+		k.Logger(ctx).Error("ID in message doesn't match with id in log", "msgID", msg.ID, "idFromLog", eventLog.ValidatorId)
+		return hmTypes.SideTxResultNo
+	}
+
+	if !bytes.Equal(eventLog.Signer.Bytes(), msg.SignerPubKey.Address().Bytes()) {
+		k.Logger(ctx).Error("Signer address does not match", "msgSigner", msg.SignerPubKey.Address().String(), "signerFromLog", eventLog.Signer.Hex())
+		return hmTypes.SideTxResultNo
+	}
+
+	if !bytes.Equal(eventLog.SignerPubkey, msg.SignerPubKey.Bytes()) {
+		k.Logger(ctx).Error("Signer pubkey in message doesn't match with pubkey in log", "msgPubkey", msg.SignerPubKey.String(), "pubkeyFromLog", hex.EncodeToString(eventLog.SignerPubkey))
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.ActivationEpoch.Uint64() != msg.ActivationEpoch {
+		k.Logger(ctx).Error("ActivationEpoch in message doesn't match with activation epoch in log", "msgActivationEpoch", msg.ActivationEpoch, "activationEpochFromLog", eventLog.ActivationEpoch)
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.Amount.Cmp(msg.Amount.BigInt()) != 0 {
+		k.Logger(ctx).Error("Amount in message doesn't match with amount in log", "msgAmount", msg.Amount, "amountFromLog", eventLog.Amount)
+		return hmTypes.SideTxResultNo
+	}
+
+	k.Logger(ctx).Debug("Validated staked event for validator join", "validatorId", msg.ID, "txHash", msg.TxHash)
+	return hmTypes.SideTxResultYes
+}
+
+// PostHandleMsgValidatorJoin adds voted validator to state
+func PostHandleMsgValidatorJoin(ctx sdk.Context, msg types.MsgValidatorJoin, result hmTypes.SideTxResultType, k Keeper) sdk.Result {
+	if result != hmTypes.SideTxResultYes {
+		k.Logger(ctx).Debug("Skipping validator join rejected by validators", "validatorId", msg.ID)
+		return sdk.Result{}
+	}
+
+	// state might have changed while validators were voting
+	if err := validateValidatorJoin(ctx, msg, k); err != nil {
+		return err.Result()
+	}
+
+	// sequence id
+	sequence := (msg.BlockNumber * hmTypes.DefaultLogIndexUnit) + msg.LogIndex
+
+	// create new validator
+	pubkey := msg.SignerPubKey
+	newValidator := hmTypes.Validator{
+		ID:          msg.ID,
+		StartEpoch:  msg.ActivationEpoch,
+		EndEpoch:    0,
+		PubKey:      pubkey,
+		Signer:      hmTypes.BytesToHeimdallAddress(pubkey.Address().Bytes()),
+		LastUpdated: sequence,
+	}
+
+	// voting power from stake amount, validator below min self stake stays standby
+	if err := k.SetValidatorStake(ctx, &newValidator, msg.Amount); err != nil {
+		k.Logger(ctx).Error("Unable to get voting power from amount", "error", err, "amount", msg.Amount)
+		return hmCommon.ErrInvalidMsg(k.Codespace(), "Invalid amount %v for validator %v", msg.Amount, msg.ID).Result()
+	}
+
+	// add validator to store
+	k.Logger(ctx).Debug("Adding new validator to state", "validator", newValidator.String())
+	if err := k.AddValidator(ctx, newValidator); err != nil {
+		k.Logger(ctx).Error("Unable to add validator to state", "error", err, "validator", newValidator.String())
+		return hmCommon.ErrValidatorSave(k.Codespace()).Result()
+	}
+
+	// save staking sequence
+	k.SetStakingSequence(ctx, sequence)
+
+	k.AfterValidatorJoined(ctx, newValidator)
+
+	// refund fee to relayer of applied event
+	k.RefundRelayerFee(ctx)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeValidatorJoin,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(newValidator.ID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeySigner, newValidator.Signer.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// SideHandleMsgStakeUpdate validates stake update event against mainchain
+func SideHandleMsgStakeUpdate(ctx sdk.Context, msg types.MsgStakeUpdate, k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxResultType {
+	// get confirmed tx receipt
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), k.GetConfirmationBlocks(ctx))
+	if err != nil || receipt == nil {
+		k.Logger(ctx).Error("Unable to fetch confirmed tx receipt", "error", err, "txHash", msg.TxHash)
+		return hmTypes.SideTxResultSkip
+	}
+
+	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+		k.Logger(ctx).Error("BlockNumber in message doesn't match block number in receipt", "msgBlockNumber", msg.BlockNumber, "receiptBlockNumber", receipt.BlockNumber)
+		return hmTypes.SideTxResultNo
+	}
+
+	// decode stake update event
+	eventLog, err := contractCaller.DecodeValidatorStakeUpdateEvent(receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Unable to decode stake update event", "error", err, "txHash", msg.TxHash, "logIndex", msg.LogIndex)
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
+		k.Logger(ctx).Error("ID in message doesn't match with id in log", "msgID", msg.ID, "idFromLog", eventLog.ValidatorId)
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.NewAmount.Cmp(msg.NewAmount.BigInt()) != 0 {
+		k.Logger(ctx).Error("NewAmount in message doesn't match with new amount in log", "msgNewAmount", msg.NewAmount, "newAmountFromLog", eventLog.NewAmount)
+		return hmTypes.SideTxResultNo
+	}
+
+	k.Logger(ctx).Debug("Validated stake update event", "validatorId", msg.ID, "txHash", msg.TxHash)
+	return hmTypes.SideTxResultYes
+}
+
+// PostHandleMsgStakeUpdate updates voted validator power from new stake
+func PostHandleMsgStakeUpdate(ctx sdk.Context, msg types.MsgStakeUpdate, result hmTypes.SideTxResultType, k Keeper) sdk.Result {
+	if result != hmTypes.SideTxResultYes {
+		k.Logger(ctx).Debug("Skipping stake update rejected by validators", "validatorId", msg.ID)
+		return sdk.Result{}
+	}
+
+	// state might have changed while validators were voting
+	validator, err := validateStakeUpdate(ctx, msg, k)
+	if err != nil {
+		return err.Result()
+	}
+
+	// sequence id
+	sequence := (msg.BlockNumber * hmTypes.DefaultLogIndexUnit) + msg.LogIndex
+
+	// update last updated
+	validator.LastUpdated = sequence
+
+	// set validator amount, validator below min self stake stays standby
+	prevPower := validator.VotingPower
+	if err := k.SetValidatorStake(ctx, &validator, msg.NewAmount); err != nil {
+		return hmCommon.ErrInvalidMsg(k.Codespace(), "Invalid amount for validator: %v", msg.ID).Result()
+	}
+
+	// save validator
+	if err := k.AddValidator(ctx, validator); err != nil {
+		k.Logger(ctx).Error("Unable to update stake", "error", err, "ValidatorID", validator.ID)
+		return hmCommon.ErrValidatorSave(k.Codespace()).Result()
+	}
+
+	// save staking sequence
+	k.SetStakingSequence(ctx, sequence)
+
+	k.AfterStakeUpdated(ctx, validator, prevPower)
+
+	// refund fee to relayer of applied event
+	k.RefundRelayerFee(ctx)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeStakeUpdate,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(validator.ID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeyUpdatedAt, strconv.FormatUint(validator.LastUpdated, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// SideHandleMsgSignerUpdate validates signer change event against mainchain
+func SideHandleMsgSignerUpdate(ctx sdk.Context, msg types.MsgSignerUpdate, k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxResultType {
+	// get confirmed tx receipt
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), k.GetConfirmationBlocks(ctx))
+	if err != nil || receipt == nil {
+		k.Logger(ctx).Error("Unable to fetch confirmed tx receipt", "error", err, "txHash", msg.TxHash)
+		return hmTypes.SideTxResultSkip
+	}
+
+	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+		k.Logger(ctx).Error("BlockNumber in message doesn't match block number in receipt", "msgBlockNumber", msg.BlockNumber, "receiptBlockNumber", receipt.BlockNumber)
+		return hmTypes.SideTxResultNo
+	}
+
+	// decode signer change event
+	eventLog, err := contractCaller.DecodeSignerUpdateEvent(receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Unable to decode signer change event", "error", err, "txHash", msg.TxHash, "logIndex", msg.LogIndex)
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
+		k.Logger(ctx).Error("ID in message doesn't match with id in log", "msgID", msg.ID, "idFromLog", eventLog.ValidatorId)
+		return hmTypes.SideTxResultNo
+	}
+
+	newSigner := msg.NewSignerPubKey.Address()
+	if !bytes.Equal(eventLog.NewSigner.Bytes(), newSigner.Bytes()) {
+		k.Logger(ctx).Error("Signer address does not match", "msgSigner", newSigner.String(), "signerFromLog", eventLog.NewSigner.Hex())
+		return hmTypes.SideTxResultNo
+	}
+
+	if !bytes.Equal(eventLog.SignerPubkey, msg.NewSignerPubKey.Bytes()) {
+		k.Logger(ctx).Error("Signer pubkey in message doesn't match with pubkey in log", "msgPubkey", msg.NewSignerPubKey.String(), "pubkeyFromLog", hex.EncodeToString(eventLog.SignerPubkey))
+		return hmTypes.SideTxResultNo
+	}
+
+	k.Logger(ctx).Debug("Validated signer change event", "validatorId", msg.ID, "txHash", msg.TxHash)
+	return hmTypes.SideTxResultYes
+}
+
+// PostHandleMsgSignerUpdate moves voted validator to new signer, old signer leaves validator set
+func PostHandleMsgSignerUpdate(ctx sdk.Context, msg types.MsgSignerUpdate, result hmTypes.SideTxResultType, k Keeper) sdk.Result {
+	if result != hmTypes.SideTxResultYes {
+		k.Logger(ctx).Debug("Skipping signer update rejected by validators", "validatorId", msg.ID)
+		return sdk.Result{}
+	}
+
+	// state might have changed while validators were voting
+	validator, err := validateSignerUpdate(ctx, msg, k)
+	if err != nil {
+		return err.Result()
+	}
+	oldValidator := validator.Copy()
+
+	// sequence id
+	sequence := (msg.BlockNumber * hmTypes.DefaultLogIndexUnit) + msg.LogIndex
+
+	// update last udpated
+	validator.LastUpdated = sequence
+
+	// check if we are actually updating signer
+	newPubKey := msg.NewSignerPubKey
+	newSigner := newPubKey.Address()
+	if !bytes.Equal(newSigner.Bytes(), validator.Signer.Bytes()) {
+		// Update signer in prev Validator
+		validator.Signer = hmTypes.HeimdallAddress(newSigner)
+		validator.PubKey = newPubKey
+		k.Logger(ctx).Debug("Updating new signer", "signer", newSigner.String(), "oldSigner", oldValidator.Signer.String(), "validatorID", msg.ID)
+	}
+
+	k.Logger(ctx).Debug("Removing old validator", "validator", oldValidator.String())
+
+	// remove old validator from HM
+	oldValidator.EndEpoch = k.moduleCommunicator.GetACKCount(ctx)
+
+	// remove old validator from TM
+	oldValidator.VotingPower = 0
+	// updated last
+	oldValidator.LastUpdated = sequence
+
+	// save old validator
+	if err := k.AddValidator(ctx, *oldValidator); err != nil {
+		k.Logger(ctx).Error("Unable to update signer", "error", err, "validatorId", validator.ID)
+		return hmCommon.ErrSignerUpdateError(k.Codespace()).Result()
+	}
+
+	// adding new validator
+	k.Logger(ctx).Debug("Adding new validator", "validator", validator.String())
+
+	// save validator
+	if err := k.AddValidator(ctx, validator); err != nil {
+		k.Logger(ctx).Error("Unable to update signer", "error", err, "ValidatorID", validator.ID)
+		return hmCommon.ErrSignerUpdateError(k.Codespace()).Result()
+	}
+
+	// save staking sequence
+	k.SetStakingSequence(ctx, sequence)
+
+	if !bytes.Equal(oldValidator.Signer.Bytes(), validator.Signer.Bytes()) {
+		k.AfterSignerChanged(ctx, validator, oldValidator.Signer)
+	}
+
+	// refund fee to relayer of applied event
+	k.RefundRelayerFee(ctx)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSignerUpdate,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(validator.ID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeyUpdatedAt, strconv.FormatUint(validator.LastUpdated, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// SideHandleMsgValidatorExit validates unstake init event against mainchain
+func SideHandleMsgValidatorExit(ctx sdk.Context, msg types.MsgValidatorExit, k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxResultType {
+	// get confirmed tx receipt
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), k.GetConfirmationBlocks(ctx))
+	if err != nil || receipt == nil {
+		k.Logger(ctx).Error("Unable to fetch confirmed tx receipt", "error", err, "txHash", msg.TxHash)
+		return hmTypes.SideTxResultSkip
+	}
+
+	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+		k.Logger(ctx).Error("BlockNumber in message doesn't match block number in receipt", "msgBlockNumber", msg.BlockNumber, "receiptBlockNumber", receipt.BlockNumber)
+		return hmTypes.SideTxResultNo
+	}
+
+	// decode unstake init event
+	eventLog, err := contractCaller.DecodeValidatorExitEvent(receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Unable to decode unstake init event", "error", err, "txHash", msg.TxHash, "logIndex", msg.LogIndex)
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
+		k.Logger(ctx).Error("ID in message doesn't match with id in log", "msgID", msg.ID, "idFromLog", eventLog.ValidatorId)
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.DeactivationEpoch.Uint64() != msg.DeactivationEpoch {
+		k.Logger(ctx).Error("DeactivationEpoch in message doesn't match with deactivation epoch in log", "msgDeactivationEpoch", msg.DeactivationEpoch, "deactivationEpochFromLog", eventLog.DeactivationEpoch)
+		return hmTypes.SideTxResultNo
+	}
+
+	k.Logger(ctx).Debug("Validated unstake init event", "validatorId", msg.ID, "txHash", msg.TxHash)
+	return hmTypes.SideTxResultYes
+}
+
+// PostHandleMsgValidatorExit sets deactivation epoch of voted validator
+func PostHandleMsgValidatorExit(ctx sdk.Context, msg types.MsgValidatorExit, result hmTypes.SideTxResultType, k Keeper) sdk.Result {
+	if result != hmTypes.SideTxResultYes {
+		k.Logger(ctx).Debug("Skipping validator exit rejected by validators", "validatorId", msg.ID)
+		return sdk.Result{}
+	}
+
+	// state might have changed while validators were voting
+	validator, err := validateValidatorExit(ctx, msg, k)
+	if err != nil {
+		return err.Result()
+	}
+
+	// sequence id
+	sequence := (msg.BlockNumber * hmTypes.DefaultLogIndexUnit) + msg.LogIndex
+
+	// update last updated
+	validator.LastUpdated = sequence
+
+	// Add deactivation time for validator
+	validator.EndEpoch = msg.DeactivationEpoch
+	if err := k.AddValidator(ctx, validator); err != nil {
+		k.Logger(ctx).Error("Error while setting deactivation epoch to validator", "error", err, "validatorID", validator.ID)
+		return hmCommon.ErrValidatorNotDeactivated(k.Codespace()).Result()
+	}
+
+	// save staking sequence
+	k.SetStakingSequence(ctx, sequence)
+
+	k.AfterValidatorExited(ctx, validator)
+
+	// refund fee to relayer of applied event
+	k.RefundRelayerFee(ctx)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeValidatorExit,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(validator.ID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeyUpdatedAt, strconv.FormatUint(validator.LastUpdated, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// SideHandleMsgValidatorJailed validates jailed event against mainchain
+func SideHandleMsgValidatorJailed(ctx sdk.Context, msg types.MsgValidatorJailed, k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxResultType {
+	// get confirmed tx receipt
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), k.GetConfirmationBlocks(ctx))
+	if err != nil || receipt == nil {
+		k.Logger(ctx).Error("Unable to fetch confirmed tx receipt", "error", err, "txHash", msg.TxHash)
+		return hmTypes.SideTxResultSkip
+	}
+
+	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+		k.Logger(ctx).Error("BlockNumber in message doesn't match block number in receipt", "msgBlockNumber", msg.BlockNumber, "receiptBlockNumber", receipt.BlockNumber)
+		return hmTypes.SideTxResultNo
+	}
+
+	// decode jailed event
+	eventLog, err := contractCaller.DecodeValidatorJailedEvent(receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Unable to decode jailed event", "error", err, "txHash", msg.TxHash, "logIndex", msg.LogIndex)
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
+		k.Logger(ctx).Error("ID in message doesn't match with id in log", "msgID", msg.ID, "idFromLog", eventLog.ValidatorId)
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.ExitEpoch.Uint64() != msg.ExitEpoch {
+		k.Logger(ctx).Error("ExitEpoch in message doesn't match with exit epoch in log", "msgExitEpoch", msg.ExitEpoch, "exitEpochFromLog", eventLog.ExitEpoch)
+		return hmTypes.SideTxResultNo
+	}
+
+	k.Logger(ctx).Debug("Validated jailed event", "validatorId", msg.ID, "txHash", msg.TxHash)
+	return hmTypes.SideTxResultYes
+}
+
+// PostHandleMsgValidatorJailed jails voted validator, validator might already be jailed by double sign slashing
+func PostHandleMsgValidatorJailed(ctx sdk.Context, msg types.MsgValidatorJailed, result hmTypes.SideTxResultType, k Keeper) sdk.Result {
+	if result != hmTypes.SideTxResultYes {
+		k.Logger(ctx).Debug("Skipping validator jailed rejected by validators", "validatorId", msg.ID)
+		return sdk.Result{}
+	}
+
+	// state might have changed while validators were voting
+	validator, err := validateValidatorJailed(ctx, msg, k)
+	if err != nil {
+		return err.Result()
+	}
+
+	// sequence id
+	sequence := (msg.BlockNumber * hmTypes.DefaultLogIndexUnit) + msg.LogIndex
+
+	// update last updated
+	validator.LastUpdated = sequence
+
+	// jail validator
+	wasJailed := validator.Jailed
+	validator.Jailed = true
+	validator.JailExitEpoch = msg.ExitEpoch
+
+	// save validator
+	if err := k.AddValidator(ctx, validator); err != nil {
+		k.Logger(ctx).Error("Unable to jail validator", "error", err, "ValidatorID", validator.ID)
+		return hmCommon.ErrValidatorSave(k.Codespace()).Result()
+	}
+
+	// save staking sequence
+	k.SetStakingSequence(ctx, sequence)
+
+	if !wasJailed {
+		k.AfterValidatorJailed(ctx, validator)
+	}
+
+	// refund fee to relayer of applied event
+	k.RefundRelayerFee(ctx)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeValidatorJail,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(validator.ID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeyJailExitEpoch, strconv.FormatUint(validator.JailExitEpoch, 10)),
+			sdk.NewAttribute(types.AttributeKeyUpdatedAt, strconv.FormatUint(validator.LastUpdated, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// SideHandleMsgValidatorUnjailed validates unjailed event against mainchain
+func SideHandleMsgValidatorUnjailed(ctx sdk.Context, msg types.MsgValidatorUnjailed, k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxResultType {
+	// get confirmed tx receipt
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), k.GetConfirmationBlocks(ctx))
+	if err != nil || receipt == nil {
+		k.Logger(ctx).Error("Unable to fetch confirmed tx receipt", "error", err, "txHash", msg.TxHash)
+		return hmTypes.SideTxResultSkip
+	}
+
+	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+		k.Logger(ctx).Error("BlockNumber in message doesn't match block number in receipt", "msgBlockNumber", msg.BlockNumber, "receiptBlockNumber", receipt.BlockNumber)
+		return hmTypes.SideTxResultNo
+	}
+
+	// decode unjailed event
+	eventLog, err := contractCaller.DecodeValidatorUnJailedEvent(receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Unable to decode unjailed event", "error", err, "txHash", msg.TxHash, "logIndex", msg.LogIndex)
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
+		k.Logger(ctx).Error("ID in message doesn't match with id in log", "msgID", msg.ID, "idFromLog", eventLog.ValidatorId)
+		return hmTypes.SideTxResultNo
+	}
+
+	k.Logger(ctx).Debug("Validated unjailed event", "validatorId", msg.ID, "txHash", msg.TxHash)
+	return hmTypes.SideTxResultYes
+}
+
+// PostHandleMsgValidatorUnjailed unjails voted validator, validator rejoins validator set
+func PostHandleMsgValidatorUnjailed(ctx sdk.Context, msg types.MsgValidatorUnjailed, result hmTypes.SideTxResultType, k Keeper) sdk.Result {
+	if result != hmTypes.SideTxResultYes {
+		k.Logger(ctx).Debug("Skipping validator unjailed rejected by validators", "validatorId", msg.ID)
+		return sdk.Result{}
+	}
+
+	// state might have changed while validators were voting
+	validator, err := validateValidatorUnjailed(ctx, msg, k)
+	if err != nil {
+		return err.Result()
+	}
+
+	// sequence id
+	sequence := (msg.BlockNumber * hmTypes.DefaultLogIndexUnit) + msg.LogIndex
+
+	// update last updated
+	validator.LastUpdated = sequence
+
+	// unjail validator
+	validator.Jailed = false
+	validator.JailExitEpoch = 0
+
+	// save validator
+	if err := k.AddValidator(ctx, validator); err != nil {
+		k.Logger(ctx).Error("Unable to unjail validator", "error", err, "ValidatorID", validator.ID)
+		return hmCommon.ErrValidatorSave(k.Codespace()).Result()
+	}
+
+	// save staking sequence
+	k.SetStakingSequence(ctx, sequence)
+
+	k.AfterValidatorUnjailed(ctx, validator)
+
+	// refund fee to relayer of applied event
+	k.RefundRelayerFee(ctx)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeValidatorUnjail,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(validator.ID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeyUpdatedAt, strconv.FormatUint(validator.LastUpdated, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// SideHandleMsgValidatorReStake validates restaked event against mainchain
+func SideHandleMsgValidatorReStake(ctx sdk.Context, msg types.MsgValidatorReStake, k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxResultType {
+	// get confirmed tx receipt
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), k.GetConfirmationBlocks(ctx))
+	if err != nil || receipt == nil {
+		k.Logger(ctx).Error("Unable to fetch confirmed tx receipt", "error", err, "txHash", msg.TxHash)
+		return hmTypes.SideTxResultSkip
+	}
+
+	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+		k.Logger(ctx).Error("BlockNumber in message doesn't match block number in receipt", "msgBlockNumber", msg.BlockNumber, "receiptBlockNumber", receipt.BlockNumber)
+		return hmTypes.SideTxResultNo
+	}
+
+	// decode restaked event
+	eventLog, err := contractCaller.DecodeValidatorReStakeEvent(receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Unable to decode restaked event", "error", err, "txHash", msg.TxHash, "logIndex", msg.LogIndex)
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
+		k.Logger(ctx).Error("ID in message doesn't match with id in log", "msgID", msg.ID, "idFromLog", eventLog.ValidatorId)
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.Amount.Cmp(msg.Amount.BigInt()) != 0 {
+		k.Logger(ctx).Error("Amount in message doesn't match with amount in log", "msgAmount", msg.Amount, "amountFromLog", eventLog.Amount)
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.Total.Cmp(msg.Total.BigInt()) != 0 {
+		k.Logger(ctx).Error("Total in message doesn't match with total in log", "msgTotal", msg.Total, "totalFromLog", eventLog.Total)
+		return hmTypes.SideTxResultNo
+	}
+
+	k.Logger(ctx).Debug("Validated restaked event", "validatorId", msg.ID, "txHash", msg.TxHash)
+	return hmTypes.SideTxResultYes
+}
+
+// PostHandleMsgValidatorReStake updates voted validator power, restaked validator re-enters validator set
+func PostHandleMsgValidatorReStake(ctx sdk.Context, msg types.MsgValidatorReStake, result hmTypes.SideTxResultType, k Keeper) sdk.Result {
+	if result != hmTypes.SideTxResultYes {
+		k.Logger(ctx).Debug("Skipping validator restake rejected by validators", "validatorId", msg.ID)
+		return sdk.Result{}
+	}
+
+	// state might have changed while validators were voting
+	validator, err := validateValidatorReStake(ctx, msg, k)
+	if err != nil {
+		return err.Result()
+	}
+
+	// sequence id
+	sequence := (msg.BlockNumber * hmTypes.DefaultLogIndexUnit) + msg.LogIndex
+
+	// update last updated
+	validator.LastUpdated = sequence
+
+	// set validator power from total stake after restake
+	prevPower := validator.VotingPower
+	if err := k.SetValidatorStake(ctx, &validator, msg.Total); err != nil {
+		return hmCommon.ErrInvalidMsg(k.Codespace(), "Invalid amount for validator: %v", msg.ID).Result()
+	}
+
+	// validator which started unbonding re-enters validator set
+	validator.EndEpoch = 0
+
+	// save validator
+	if err := k.AddValidator(ctx, validator); err != nil {
+		k.Logger(ctx).Error("Unable to restake validator", "error", err, "ValidatorID", validator.ID)
+		return hmCommon.ErrValidatorSave(k.Codespace()).Result()
+	}
+
+	// save staking sequence
+	k.SetStakingSequence(ctx, sequence)
+
+	k.AfterStakeUpdated(ctx, validator, prevPower)
+
+	// refund fee to relayer of applied event
+	k.RefundRelayerFee(ctx)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeValidatorReStake,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(validator.ID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeyVotingPower, strconv.FormatInt(validator.VotingPower, 10)),
+			sdk.NewAttribute(types.AttributeKeyUpdatedAt, strconv.FormatUint(validator.LastUpdated, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// SideHandleMsgDelegatorBond validates share minted event against mainchain
+func SideHandleMsgDelegatorBond(ctx sdk.Context, msg types.MsgDelegatorBond, k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxResultType {
+	// get confirmed tx receipt
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), k.GetConfirmationBlocks(ctx))
+	if err != nil || receipt == nil {
+		k.Logger(ctx).Error("Unable to fetch confirmed tx receipt", "error", err, "txHash", msg.TxHash)
+		return hmTypes.SideTxResultSkip
+	}
+
+	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+		k.Logger(ctx).Error("BlockNumber in message doesn't match block number in receipt", "msgBlockNumber", msg.BlockNumber, "receiptBlockNumber", receipt.BlockNumber)
+		return hmTypes.SideTxResultNo
+	}
+
+	// decode share minted event
+	eventLog, err := contractCaller.DecodeShareMintedEvent(receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Unable to decode share minted event", "error", err, "txHash", msg.TxHash, "logIndex", msg.LogIndex)
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
+		k.Logger(ctx).Error("ID in message doesn't match with id in log", "msgID", msg.ID, "idFromLog", eventLog.ValidatorId)
+		return hmTypes.SideTxResultNo
+	}
+
+	if !bytes.Equal(eventLog.User.Bytes(), msg.Delegator.Bytes()) {
+		k.Logger(ctx).Error("Delegator in message doesn't match with user in log", "msgDelegator", msg.Delegator, "userFromLog", eventLog.User.Hex())
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.Amount.Cmp(msg.Amount.BigInt()) != 0 {
+		k.Logger(ctx).Error("Amount in message doesn't match with amount in log", "msgAmount", msg.Amount, "amountFromLog", eventLog.Amount)
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.Tokens.Cmp(msg.Shares.BigInt()) != 0 {
+		k.Logger(ctx).Error("Shares in message don't match with tokens in log", "msgShares", msg.Shares, "tokensFromLog", eventLog.Tokens)
+		return hmTypes.SideTxResultNo
+	}
+
+	k.Logger(ctx).Debug("Validated share minted event", "validatorId", msg.ID, "txHash", msg.TxHash)
+	return hmTypes.SideTxResultYes
+}
+
+// SideHandleMsgDelegatorUnbond validates share burned event against mainchain
+func SideHandleMsgDelegatorUnbond(ctx sdk.Context, msg types.MsgDelegatorUnbond, k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxResultType {
+	// get confirmed tx receipt
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), k.GetConfirmationBlocks(ctx))
+	if err != nil || receipt == nil {
+		k.Logger(ctx).Error("Unable to fetch confirmed tx receipt", "error", err, "txHash", msg.TxHash)
+		return hmTypes.SideTxResultSkip
+	}
+
+	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+		k.Logger(ctx).Error("BlockNumber in message doesn't match block number in receipt", "msgBlockNumber", msg.BlockNumber, "receiptBlockNumber", receipt.BlockNumber)
+		return hmTypes.SideTxResultNo
+	}
+
+	// decode share burned event
+	eventLog, err := contractCaller.DecodeShareBurnedEvent(receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Unable to decode share burned event", "error", err, "txHash", msg.TxHash, "logIndex", msg.LogIndex)
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
+		k.Logger(ctx).Error("ID in message doesn't match with id in log", "msgID", msg.ID, "idFromLog", eventLog.ValidatorId)
+		return hmTypes.SideTxResultNo
+	}
+
+	if !bytes.Equal(eventLog.User.Bytes(), msg.Delegator.Bytes()) {
+		k.Logger(ctx).Error("Delegator in message doesn't match with user in log", "msgDelegator", msg.Delegator, "userFromLog", eventLog.User.Hex())
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.Amount.Cmp(msg.Amount.BigInt()) != 0 {
+		k.Logger(ctx).Error("Amount in message doesn't match with amount in log", "msgAmount", msg.Amount, "amountFromLog", eventLog.Amount)
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.Tokens.Cmp(msg.Shares.BigInt()) != 0 {
+		k.Logger(ctx).Error("Shares in message don't match with tokens in log", "msgShares", msg.Shares, "tokensFromLog", eventLog.Tokens)
+		return hmTypes.SideTxResultNo
+	}
+
+	k.Logger(ctx).Debug("Validated share burned event", "validatorId", msg.ID, "txHash", msg.TxHash)
+	return hmTypes.SideTxResultYes
+}
+
+// PostHandleMsgDelegatorBond adds voted bonded stake to delegation
+func PostHandleMsgDelegatorBond(ctx sdk.Context, msg types.MsgDelegatorBond, result hmTypes.SideTxResultType, k Keeper) sdk.Result {
+	if result != hmTypes.SideTxResultYes {
+		k.Logger(ctx).Debug("Skipping delegator bond rejected by validators", "validatorId", msg.ID)
+		return sdk.Result{}
+	}
+
+	// state might have changed while validators were voting
+	delegation, err := validateDelegatorBond(ctx, msg, k)
+	if err != nil {
+		return err.Result()
+	}
+
+	// sequence id
+	sequence := (msg.BlockNumber * hmTypes.DefaultLogIndexUnit) + msg.LogIndex
+
+	// add minted shares to delegation
+	if err := applyDelegationChange(ctx, k, &delegation, msg.Amount.BigInt(), msg.Shares.BigInt(), sequence); err != nil {
+		return err.Result()
+	}
+
+	// save staking sequence
+	k.SetStakingSequence(ctx, sequence)
+
+	// refund fee to relayer of applied event
+	k.RefundRelayerFee(ctx)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDelegatorBond,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(msg.ID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.Delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, msg.Shares.String()),
+			sdk.NewAttribute(types.AttributeKeyUpdatedAt, strconv.FormatUint(delegation.LastUpdated, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// PostHandleMsgDelegatorUnbond removes voted unbonded stake from delegation
+func PostHandleMsgDelegatorUnbond(ctx sdk.Context, msg types.MsgDelegatorUnbond, result hmTypes.SideTxResultType, k Keeper) sdk.Result {
+	if result != hmTypes.SideTxResultYes {
+		k.Logger(ctx).Debug("Skipping delegator unbond rejected by validators", "validatorId", msg.ID)
+		return sdk.Result{}
+	}
+
+	// state might have changed while validators were voting
+	delegation, err := validateDelegatorUnbond(ctx, msg, k)
+	if err != nil {
+		return err.Result()
+	}
+
+	// sequence id
+	sequence := (msg.BlockNumber * hmTypes.DefaultLogIndexUnit) + msg.LogIndex
+
+	// subtract burned shares from delegation
+	amount := new(big.Int).Neg(msg.Amount.BigInt())
+	shares := new(big.Int).Neg(msg.Shares.BigInt())
+	if err := applyDelegationChange(ctx, k, &delegation, amount, shares, sequence); err != nil {
+		return err.Result()
+	}
+
+	// save staking sequence
+	k.SetStakingSequence(ctx, sequence)
+
+	// refund fee to relayer of applied event
+	k.RefundRelayerFee(ctx)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDelegatorUnbond,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(msg.ID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.Delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, msg.Shares.String()),
+			sdk.NewAttribute(types.AttributeKeyUpdatedAt, strconv.FormatUint(delegation.LastUpdated, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// applyDelegationChange adds amount and shares to delegation and saves it.
+// Bond and unbond events can be voted out of mainchain order, so shares stay negative until
+// the bond they burn is applied. Delegation is removed once all of its shares are burned.
+func applyDelegationChange(ctx sdk.Context, k Keeper, delegation *hmTypes.Delegation, amount *big.Int, shares *big.Int, sequence uint64) sdk.Error {
+	delegationAmount, _ := big.NewInt(0).SetString(delegation.Amount, 10)
+	delegationShares, _ := big.NewInt(0).SetString(delegation.Shares, 10)
+	if delegationAmount == nil || delegationShares == nil {
+		return hmCommon.ErrInvalidMsg(k.Codespace(), "Invalid delegation stored for validator: %v", delegation.ValidatorID)
+	}
+
+	delegationAmount.Add(delegationAmount, amount)
+	delegationShares.Add(delegationShares, shares)
+	if delegationAmount.Sign() < 0 && delegationShares.Sign() > 0 {
+		// rewards withdrawn with shares can exceed bonded amount
+		delegationAmount.SetInt64(0)
+	}
+
+	delegation.Amount = delegationAmount.String()
+	delegation.Shares = delegationShares.String()
+	if sequence > delegation.LastUpdated {
+		delegation.LastUpdated = sequence
+	}
+
+	if delegationShares.Sign() == 0 {
+		k.RemoveDelegation(ctx, delegation.ValidatorID, delegation.Delegator)
+		return nil
+	}
+
+	if err := k.SetDelegation(ctx, *delegation); err != nil {
+		k.Logger(ctx).Error("Unable to save delegation", "error", err, "ValidatorID", delegation.ValidatorID)
+		return hmCommon.ErrInvalidMsg(k.Codespace(), "Unable to save delegation")
+	}
+
+	return nil
+}
@@ -13,6 +13,12 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSignerUpdate{}, "staking/MsgSignerUpdate", nil)
 	cdc.RegisterConcrete(MsgValidatorExit{}, "staking/MsgValidatorExit", nil)
 	cdc.RegisterConcrete(MsgStakeUpdate{}, "staking/MsgStakeUpdate", nil)
+	cdc.RegisterConcrete(MsgValidatorJailed{}, "staking/MsgValidatorJailed", nil)
+	cdc.RegisterConcrete(MsgValidatorUnjailed{}, "staking/MsgValidatorUnjailed", nil)
+	cdc.RegisterConcrete(MsgValidatorReStake{}, "staking/MsgValidatorReStake", nil)
+	cdc.RegisterConcrete(MsgDelegatorBond{}, "staking/MsgDelegatorBond", nil)
+	cdc.RegisterConcrete(MsgDelegatorUnbond{}, "staking/MsgDelegatorUnbond", nil)
+	cdc.RegisterConcrete(MsgEditValidatorMetadata{}, "staking/MsgEditValidatorMetadata", nil)
 }
 
 func RegisterPulp(pulp *authTypes.Pulp) {
@@ -20,6 +26,12 @@ func RegisterPulp(pulp *authTypes.Pulp) {
 	pulp.RegisterConcrete(MsgSignerUpdate{})
 	pulp.RegisterConcrete(MsgValidatorExit{})
 	pulp.RegisterConcrete(MsgStakeUpdate{})
+	pulp.RegisterConcrete(MsgValidatorJailed{})
+	pulp.RegisterConcrete(MsgValidatorUnjailed{})
+	pulp.RegisterConcrete(MsgValidatorReStake{})
+	pulp.RegisterConcrete(MsgDelegatorBond{})
+	pulp.RegisterConcrete(MsgDelegatorUnbond{})
+	pulp.RegisterConcrete(MsgEditValidatorMetadata{})
 }
 
 // ModuleCdc generic sealed codec to be used throughout module
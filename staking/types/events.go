@@ -2,17 +2,34 @@ package types
 
 // Checkpoint tags
 var (
-	EventTypeNewProposer   = "new-proposer"
-	EventTypeValidatorJoin = "validator-join"
-	EventTypeSignerUpdate  = "signer-update"
-	EventTypeStakeUpdate   = "stake-update"
-	EventTypeValidatorExit = "validator-exit"
+	EventTypeNewProposer       = "new-proposer"
+	EventTypeValidatorJoin     = "validator-join"
+	EventTypeSignerUpdate      = "signer-update"
+	EventTypeStakeUpdate       = "stake-update"
+	EventTypeValidatorExit     = "validator-exit"
+	EventTypeValidatorJail     = "validator-jail"
+	EventTypeValidatorUnjail   = "validator-unjail"
+	EventTypeValidatorReStake  = "validator-restake"
+	EventTypeDelegatorBond     = "delegator-bond"
+	EventTypeDelegatorUnbond   = "delegator-unbond"
+	EventTypeValidatorMetadata = "validator-metadata"
+
+	EventTypeValidatorSetAdd         = "validator-set-add"
+	EventTypeValidatorSetRemove      = "validator-set-remove"
+	EventTypeValidatorSetPowerChange = "validator-set-power-change"
 
 	AttributeKeySigner            = "signer"
 	AttributeKeyDeactivationEpoch = "deactivation-epoch"
 	AttributeKeyActivationEpoch   = "activation-epoch"
 	AttributeKeyValidatorID       = "validator-id"
 	AttributeKeyUpdatedAt         = "updated-at"
+	AttributeKeyJailExitEpoch     = "jail-exit-epoch"
+	AttributeKeyVotingPower       = "voting-power"
+	AttributeKeyDelegator         = "delegator"
+	AttributeKeyAmount            = "amount"
+	AttributeKeyShares            = "shares"
+	AttributeKeyAckCount          = "ack-count"
+	AttributeKeyMoniker           = "moniker"
 
 	AttributeValueCategory = ModuleName
 )
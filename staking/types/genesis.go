@@ -31,39 +31,75 @@ func (v *GenesisValidator) HeimdallValidator() hmTypes.Validator {
 
 // GenesisState is the checkpoint state that must be provided at genesis.
 type GenesisState struct {
-	Validators       []*hmTypes.Validator      `json:"validators" yaml:"validators"`
-	CurrentValSet    hmTypes.ValidatorSet      `json:"current_val_set" yaml:"current_val_set"`
-	DividentAccounts []hmTypes.DividendAccount `json:"dividend_accounts" yaml:"dividend_accounts"`
+	Params            Params                    `json:"params" yaml:"params"`
+	Validators        []*hmTypes.Validator      `json:"validators" yaml:"validators"`
+	CurrentValSet     hmTypes.ValidatorSet      `json:"current_val_set" yaml:"current_val_set"`
+	DividentAccounts  []hmTypes.DividendAccount `json:"dividend_accounts" yaml:"dividend_accounts"`
+	Delegations       []hmTypes.Delegation      `json:"delegations" yaml:"delegations"`
+	ValidatorMetadata []ValidatorMetadata       `json:"validator_metadata" yaml:"validator_metadata"`
+	ValidatorStakes   []ValidatorStake          `json:"validator_stakes" yaml:"validator_stakes"`
 }
 
 // NewGenesisState creates a new genesis state.
 func NewGenesisState(
+	params Params,
 	validators []*hmTypes.Validator,
 	currentValSet hmTypes.ValidatorSet,
 	dividentAccounts []hmTypes.DividendAccount,
-
+	delegations []hmTypes.Delegation,
+	validatorMetadata []ValidatorMetadata,
+	validatorStakes []ValidatorStake,
 ) GenesisState {
 	return GenesisState{
-		Validators:       validators,
-		CurrentValSet:    currentValSet,
-		DividentAccounts: dividentAccounts,
+		Params:            params,
+		Validators:        validators,
+		CurrentValSet:     currentValSet,
+		DividentAccounts:  dividentAccounts,
+		Delegations:       delegations,
+		ValidatorMetadata: validatorMetadata,
+		ValidatorStakes:   validatorStakes,
 	}
 }
 
 // DefaultGenesisState returns a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(nil, hmTypes.ValidatorSet{}, nil)
+	return NewGenesisState(DefaultParams(), nil, hmTypes.ValidatorSet{}, nil, nil, nil, nil)
 }
 
 // ValidateGenesis performs basic validation of bor genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
 	for _, validator := range data.Validators {
 		if !validator.ValidateBasic() {
 			return errors.New("Invalid validator")
 		}
 	}
 
+	for _, delegation := range data.Delegations {
+		if delegation.ValidatorID == 0 || delegation.Delegator.Empty() {
+			return errors.New("Invalid delegation")
+		}
+	}
+
+	for _, metadata := range data.ValidatorMetadata {
+		if metadata.ID == 0 {
+			return errors.New("Invalid validator metadata")
+		}
+		if err := metadata.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, stake := range data.ValidatorStakes {
+		if stake.ID == 0 || stake.Amount.I == nil || stake.Amount.IsNegative() {
+			return errors.New("Invalid validator stake")
+		}
+	}
+
 	return nil
 }
 
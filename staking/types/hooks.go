@@ -0,0 +1,75 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// StakingHooks event hooks for validator lifecycle, other modules subscribe to them instead of polling staking state
+type StakingHooks interface {
+	AfterValidatorJoined(ctx sdk.Context, validator hmTypes.Validator)                                         // Must be called after new validator is added to state
+	AfterStakeUpdated(ctx sdk.Context, validator hmTypes.Validator, prevPower int64)                           // Must be called after validator power is updated
+	AfterSignerChanged(ctx sdk.Context, validator hmTypes.Validator, prevSigner hmTypes.HeimdallAddress)       // Must be called after validator signer is updated
+	AfterValidatorExited(ctx sdk.Context, validator hmTypes.Validator)                                         // Must be called after validator deactivation epoch is set
+	AfterValidatorJailed(ctx sdk.Context, validator hmTypes.Validator)                                         // Must be called after validator is jailed
+	AfterValidatorUnjailed(ctx sdk.Context, validator hmTypes.Validator)                                       // Must be called after validator is unjailed
+	BeforeValidatorSetUpdate(ctx sdk.Context, validatorSet hmTypes.ValidatorSet, updates []*hmTypes.Validator) // Must be called before updates are applied to current validator set
+}
+
+// MultiStakingHooks combines multiple staking hooks, all hook functions are run in array sequence
+type MultiStakingHooks []StakingHooks
+
+// NewMultiStakingHooks creates new multi staking hooks
+func NewMultiStakingHooks(hooks ...StakingHooks) MultiStakingHooks {
+	return hooks
+}
+
+// AfterValidatorJoined runs AfterValidatorJoined of all hooks
+func (h MultiStakingHooks) AfterValidatorJoined(ctx sdk.Context, validator hmTypes.Validator) {
+	for i := range h {
+		h[i].AfterValidatorJoined(ctx, validator)
+	}
+}
+
+// AfterStakeUpdated runs AfterStakeUpdated of all hooks
+func (h MultiStakingHooks) AfterStakeUpdated(ctx sdk.Context, validator hmTypes.Validator, prevPower int64) {
+	for i := range h {
+		h[i].AfterStakeUpdated(ctx, validator, prevPower)
+	}
+}
+
+// AfterSignerChanged runs AfterSignerChanged of all hooks
+func (h MultiStakingHooks) AfterSignerChanged(ctx sdk.Context, validator hmTypes.Validator, prevSigner hmTypes.HeimdallAddress) {
+	for i := range h {
+		h[i].AfterSignerChanged(ctx, validator, prevSigner)
+	}
+}
+
+// AfterValidatorExited runs AfterValidatorExited of all hooks
+func (h MultiStakingHooks) AfterValidatorExited(ctx sdk.Context, validator hmTypes.Validator) {
+	for i := range h {
+		h[i].AfterValidatorExited(ctx, validator)
+	}
+}
+
+// AfterValidatorJailed runs AfterValidatorJailed of all hooks
+func (h MultiStakingHooks) AfterValidatorJailed(ctx sdk.Context, validator hmTypes.Validator) {
+	for i := range h {
+		h[i].AfterValidatorJailed(ctx, validator)
+	}
+}
+
+// AfterValidatorUnjailed runs AfterValidatorUnjailed of all hooks
+func (h MultiStakingHooks) AfterValidatorUnjailed(ctx sdk.Context, validator hmTypes.Validator) {
+	for i := range h {
+		h[i].AfterValidatorUnjailed(ctx, validator)
+	}
+}
+
+// BeforeValidatorSetUpdate runs BeforeValidatorSetUpdate of all hooks
+func (h MultiStakingHooks) BeforeValidatorSetUpdate(ctx sdk.Context, validatorSet hmTypes.ValidatorSet, updates []*hmTypes.Validator) {
+	for i := range h {
+		h[i].BeforeValidatorSetUpdate(ctx, validatorSet, updates)
+	}
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Max lengths of validator metadata fields
+const (
+	MaxMonikerLength  = 70
+	MaxWebsiteLength  = 140
+	MaxContactLength  = 140
+	MaxIdentityLength = 64
+)
+
+// ValidatorMetadata is description of validator provided by its signer
+type ValidatorMetadata struct {
+	ID       hmTypes.ValidatorID `json:"id" yaml:"id"`
+	Moniker  string              `json:"moniker" yaml:"moniker"`
+	Website  string              `json:"website" yaml:"website"`
+	Contact  string              `json:"contact" yaml:"contact"`
+	Identity string              `json:"identity" yaml:"identity"`
+}
+
+// NewValidatorMetadata creates new validator metadata
+func NewValidatorMetadata(id hmTypes.ValidatorID, moniker string, website string, contact string, identity string) ValidatorMetadata {
+	return ValidatorMetadata{
+		ID:       id,
+		Moniker:  moniker,
+		Website:  website,
+		Contact:  contact,
+		Identity: identity,
+	}
+}
+
+// Validate checks length limits of metadata fields
+func (m ValidatorMetadata) Validate() error {
+	if len(m.Moniker) > MaxMonikerLength {
+		return fmt.Errorf("moniker is longer than %v characters", MaxMonikerLength)
+	}
+	if len(m.Website) > MaxWebsiteLength {
+		return fmt.Errorf("website is longer than %v characters", MaxWebsiteLength)
+	}
+	if len(m.Contact) > MaxContactLength {
+		return fmt.Errorf("contact is longer than %v characters", MaxContactLength)
+	}
+	if len(m.Identity) > MaxIdentityLength {
+		return fmt.Errorf("identity is longer than %v characters", MaxIdentityLength)
+	}
+	return nil
+}
+
+// String implements the stringer interface.
+func (m ValidatorMetadata) String() string {
+	return fmt.Sprintf("ValidatorMetadata{%v %v %v %v %v}",
+		m.ID,
+		m.Moniker,
+		m.Website,
+		m.Contact,
+		m.Identity)
+}
+
+// ValidatorWithMetadata is validator with its metadata, if metadata was set
+type ValidatorWithMetadata struct {
+	hmTypes.Validator
+	Metadata *ValidatorMetadata `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+}
@@ -21,28 +21,37 @@ var cdc = codec.New()
 var _ sdk.Msg = &MsgValidatorJoin{}
 
 type MsgValidatorJoin struct {
-	From         hmTypes.HeimdallAddress `json:"from"`
-	ID           hmTypes.ValidatorID     `json:"id"`
-	SignerPubKey hmTypes.PubKey          `json:"pub_key"`
-	TxHash       hmTypes.HeimdallHash    `json:"tx_hash"`
-	LogIndex     uint64                  `json:"log_index"`
+	From            hmTypes.HeimdallAddress `json:"from"`
+	ID              hmTypes.ValidatorID     `json:"id"`
+	ActivationEpoch uint64                  `json:"activation_epoch"`
+	Amount          hmTypes.Int             `json:"amount"`
+	SignerPubKey    hmTypes.PubKey          `json:"pub_key"`
+	TxHash          hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex        uint64                  `json:"log_index"`
+	BlockNumber     uint64                  `json:"block_number"`
 }
 
 // NewMsgValidatorJoin creates new validator-join
 func NewMsgValidatorJoin(
 	from hmTypes.HeimdallAddress,
 	id uint64,
+	activationEpoch uint64,
+	amount hmTypes.Int,
 	pubkey hmTypes.PubKey,
 	txhash hmTypes.HeimdallHash,
 	logIndex uint64,
+	blockNumber uint64,
 ) MsgValidatorJoin {
 
 	return MsgValidatorJoin{
-		From:         from,
-		ID:           hmTypes.NewValidatorID(id),
-		SignerPubKey: pubkey,
-		TxHash:       txhash,
-		LogIndex:     logIndex,
+		From:            from,
+		ID:              hmTypes.NewValidatorID(id),
+		ActivationEpoch: activationEpoch,
+		Amount:          amount,
+		SignerPubKey:    pubkey,
+		TxHash:          txhash,
+		LogIndex:        logIndex,
+		BlockNumber:     blockNumber,
 	}
 }
 
@@ -71,6 +80,10 @@ func (msg MsgValidatorJoin) ValidateBasic() sdk.Error {
 		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
 	}
 
+	if msg.Amount.I == nil || !msg.Amount.IsPositive() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid amount %v", msg.Amount)
+	}
+
 	if bytes.Equal(msg.SignerPubKey.Bytes(), helper.ZeroPubKey.Bytes()) {
 		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid pub key %v", msg.SignerPubKey.String())
 	}
@@ -82,6 +95,11 @@ func (msg MsgValidatorJoin) ValidateBasic() sdk.Error {
 	return nil
 }
 
+// IsSideTxMsg marks validator join as side tx msg, staked event is validated by validators before validator gets added
+func (msg MsgValidatorJoin) IsSideTxMsg() bool {
+	return true
+}
+
 // GetTxHash Returns tx hash
 func (msg MsgValidatorJoin) GetTxHash() types.HeimdallHash {
 	return msg.TxHash
@@ -104,19 +122,23 @@ var _ sdk.Msg = &MsgStakeUpdate{}
 
 // MsgStakeUpdate represents stake update
 type MsgStakeUpdate struct {
-	From     hmTypes.HeimdallAddress `json:"from"`
-	ID       hmTypes.ValidatorID     `json:"id"`
-	TxHash   hmTypes.HeimdallHash    `json:"tx_hash"`
-	LogIndex uint64                  `json:"log_index"`
+	From        hmTypes.HeimdallAddress `json:"from"`
+	ID          hmTypes.ValidatorID     `json:"id"`
+	NewAmount   hmTypes.Int             `json:"amount"`
+	TxHash      hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex    uint64                  `json:"log_index"`
+	BlockNumber uint64                  `json:"block_number"`
 }
 
 // NewMsgStakeUpdate represents stake update
-func NewMsgStakeUpdate(from hmTypes.HeimdallAddress, id uint64, txhash hmTypes.HeimdallHash, logIndex uint64) MsgStakeUpdate {
+func NewMsgStakeUpdate(from hmTypes.HeimdallAddress, id uint64, newAmount hmTypes.Int, txhash hmTypes.HeimdallHash, logIndex uint64, blockNumber uint64) MsgStakeUpdate {
 	return MsgStakeUpdate{
-		From:     from,
-		ID:       hmTypes.NewValidatorID(id),
-		TxHash:   txhash,
-		LogIndex: logIndex,
+		From:        from,
+		ID:          hmTypes.NewValidatorID(id),
+		NewAmount:   newAmount,
+		TxHash:      txhash,
+		LogIndex:    logIndex,
+		BlockNumber: blockNumber,
 	}
 }
 
@@ -149,9 +171,18 @@ func (msg MsgStakeUpdate) ValidateBasic() sdk.Error {
 		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.From.String())
 	}
 
+	if msg.NewAmount.I == nil || msg.NewAmount.IsNegative() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid amount %v", msg.NewAmount)
+	}
+
 	return nil
 }
 
+// IsSideTxMsg marks stake update as side tx msg, stake update event is validated by validators before power gets updated
+func (msg MsgStakeUpdate) IsSideTxMsg() bool {
+	return true
+}
+
 // GetTxHash Returns tx hash
 func (msg MsgStakeUpdate) GetTxHash() types.HeimdallHash {
 	return msg.TxHash
@@ -175,6 +206,7 @@ type MsgSignerUpdate struct {
 	NewSignerPubKey hmTypes.PubKey          `json:"pubKey"`
 	TxHash          hmTypes.HeimdallHash    `json:"tx_hash"`
 	LogIndex        uint64                  `json:"log_index"`
+	BlockNumber     uint64                  `json:"block_number"`
 }
 
 func NewMsgSignerUpdate(
@@ -183,6 +215,7 @@ func NewMsgSignerUpdate(
 	pubKey hmTypes.PubKey,
 	txhash hmTypes.HeimdallHash,
 	logIndex uint64,
+	blockNumber uint64,
 ) MsgSignerUpdate {
 	return MsgSignerUpdate{
 		From:            from,
@@ -190,6 +223,7 @@ func NewMsgSignerUpdate(
 		NewSignerPubKey: pubKey,
 		TxHash:          txhash,
 		LogIndex:        logIndex,
+		BlockNumber:     blockNumber,
 	}
 }
 
@@ -229,6 +263,11 @@ func (msg MsgSignerUpdate) ValidateBasic() sdk.Error {
 	return nil
 }
 
+// IsSideTxMsg marks signer update as side tx msg, signer change event is validated by validators before signer gets updated
+func (msg MsgSignerUpdate) IsSideTxMsg() bool {
+	return true
+}
+
 // GetTxHash Returns tx hash
 func (msg MsgSignerUpdate) GetTxHash() types.HeimdallHash {
 	return msg.TxHash
@@ -246,18 +285,22 @@ func (msg MsgSignerUpdate) GetLogIndex() uint64 {
 var _ sdk.Msg = &MsgValidatorExit{}
 
 type MsgValidatorExit struct {
-	From     hmTypes.HeimdallAddress `json:"from"`
-	ID       hmTypes.ValidatorID     `json:"id"`
-	TxHash   hmTypes.HeimdallHash    `json:"tx_hash"`
-	LogIndex uint64                  `json:"log_index"`
+	From              hmTypes.HeimdallAddress `json:"from"`
+	ID                hmTypes.ValidatorID     `json:"id"`
+	DeactivationEpoch uint64                  `json:"deactivation_epoch"`
+	TxHash            hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex          uint64                  `json:"log_index"`
+	BlockNumber       uint64                  `json:"block_number"`
 }
 
-func NewMsgValidatorExit(from hmTypes.HeimdallAddress, id uint64, txhash hmTypes.HeimdallHash, logIndex uint64) MsgValidatorExit {
+func NewMsgValidatorExit(from hmTypes.HeimdallAddress, id uint64, deactivationEpoch uint64, txhash hmTypes.HeimdallHash, logIndex uint64, blockNumber uint64) MsgValidatorExit {
 	return MsgValidatorExit{
-		From:     from,
-		ID:       hmTypes.NewValidatorID(id),
-		TxHash:   txhash,
-		LogIndex: logIndex,
+		From:              from,
+		ID:                hmTypes.NewValidatorID(id),
+		DeactivationEpoch: deactivationEpoch,
+		TxHash:            txhash,
+		LogIndex:          logIndex,
+		BlockNumber:       blockNumber,
 	}
 }
 
@@ -286,6 +329,10 @@ func (msg MsgValidatorExit) ValidateBasic() sdk.Error {
 		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
 	}
 
+	if msg.DeactivationEpoch == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid deactivation epoch %v", msg.DeactivationEpoch)
+	}
+
 	if msg.From.Empty() {
 		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.From.String())
 	}
@@ -293,6 +340,11 @@ func (msg MsgValidatorExit) ValidateBasic() sdk.Error {
 	return nil
 }
 
+// IsSideTxMsg marks validator exit as side tx msg, unstake init event is validated by validators before validator starts unbonding
+func (msg MsgValidatorExit) IsSideTxMsg() bool {
+	return true
+}
+
 // GetTxHash Returns tx hash
 func (msg MsgValidatorExit) GetTxHash() types.HeimdallHash {
 	return msg.TxHash
@@ -302,3 +354,516 @@ func (msg MsgValidatorExit) GetTxHash() types.HeimdallHash {
 func (msg MsgValidatorExit) GetLogIndex() uint64 {
 	return msg.LogIndex
 }
+
+//
+// validator jailed
+//
+
+var _ sdk.Msg = &MsgValidatorJailed{}
+
+// MsgValidatorJailed represents validator jailed on mainchain
+type MsgValidatorJailed struct {
+	From        hmTypes.HeimdallAddress `json:"from"`
+	ID          hmTypes.ValidatorID     `json:"id"`
+	ExitEpoch   uint64                  `json:"exit_epoch"`
+	TxHash      hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex    uint64                  `json:"log_index"`
+	BlockNumber uint64                  `json:"block_number"`
+}
+
+// NewMsgValidatorJailed creates new validator jailed msg
+func NewMsgValidatorJailed(
+	from hmTypes.HeimdallAddress,
+	id uint64,
+	exitEpoch uint64,
+	txhash hmTypes.HeimdallHash,
+	logIndex uint64,
+	blockNumber uint64,
+) MsgValidatorJailed {
+	return MsgValidatorJailed{
+		From:        from,
+		ID:          hmTypes.NewValidatorID(id),
+		ExitEpoch:   exitEpoch,
+		TxHash:      txhash,
+		LogIndex:    logIndex,
+		BlockNumber: blockNumber,
+	}
+}
+
+func (msg MsgValidatorJailed) Type() string {
+	return "validator-jailed"
+}
+
+func (msg MsgValidatorJailed) Route() string {
+	return RouterKey
+}
+
+func (msg MsgValidatorJailed) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgValidatorJailed) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgValidatorJailed) ValidateBasic() sdk.Error {
+	if msg.ID <= 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
+	}
+
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.From.String())
+	}
+
+	return nil
+}
+
+// IsSideTxMsg marks validator jailed as side tx msg, jailed event is validated by validators before validator gets jailed
+func (msg MsgValidatorJailed) IsSideTxMsg() bool {
+	return true
+}
+
+// GetTxHash Returns tx hash
+func (msg MsgValidatorJailed) GetTxHash() types.HeimdallHash {
+	return msg.TxHash
+}
+
+// GetLogIndex Returns log index
+func (msg MsgValidatorJailed) GetLogIndex() uint64 {
+	return msg.LogIndex
+}
+
+//
+// validator unjailed
+//
+
+var _ sdk.Msg = &MsgValidatorUnjailed{}
+
+// MsgValidatorUnjailed represents validator unjailed on mainchain
+type MsgValidatorUnjailed struct {
+	From        hmTypes.HeimdallAddress `json:"from"`
+	ID          hmTypes.ValidatorID     `json:"id"`
+	TxHash      hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex    uint64                  `json:"log_index"`
+	BlockNumber uint64                  `json:"block_number"`
+}
+
+// NewMsgValidatorUnjailed creates new validator unjailed msg
+func NewMsgValidatorUnjailed(from hmTypes.HeimdallAddress, id uint64, txhash hmTypes.HeimdallHash, logIndex uint64, blockNumber uint64) MsgValidatorUnjailed {
+	return MsgValidatorUnjailed{
+		From:        from,
+		ID:          hmTypes.NewValidatorID(id),
+		TxHash:      txhash,
+		LogIndex:    logIndex,
+		BlockNumber: blockNumber,
+	}
+}
+
+func (msg MsgValidatorUnjailed) Type() string {
+	return "validator-unjailed"
+}
+
+func (msg MsgValidatorUnjailed) Route() string {
+	return RouterKey
+}
+
+func (msg MsgValidatorUnjailed) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgValidatorUnjailed) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgValidatorUnjailed) ValidateBasic() sdk.Error {
+	if msg.ID <= 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
+	}
+
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.From.String())
+	}
+
+	return nil
+}
+
+// IsSideTxMsg marks validator unjailed as side tx msg, unjailed event is validated by validators before validator gets unjailed
+func (msg MsgValidatorUnjailed) IsSideTxMsg() bool {
+	return true
+}
+
+// GetTxHash Returns tx hash
+func (msg MsgValidatorUnjailed) GetTxHash() types.HeimdallHash {
+	return msg.TxHash
+}
+
+// GetLogIndex Returns log index
+func (msg MsgValidatorUnjailed) GetLogIndex() uint64 {
+	return msg.LogIndex
+}
+
+//
+// validator restake
+//
+
+var _ sdk.Msg = &MsgValidatorReStake{}
+
+// MsgValidatorReStake represents validator restake on mainchain
+type MsgValidatorReStake struct {
+	From        hmTypes.HeimdallAddress `json:"from"`
+	ID          hmTypes.ValidatorID     `json:"id"`
+	Amount      hmTypes.Int             `json:"amount"`
+	Total       hmTypes.Int             `json:"total"`
+	TxHash      hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex    uint64                  `json:"log_index"`
+	BlockNumber uint64                  `json:"block_number"`
+}
+
+// NewMsgValidatorReStake creates new validator restake msg
+func NewMsgValidatorReStake(
+	from hmTypes.HeimdallAddress,
+	id uint64,
+	amount hmTypes.Int,
+	total hmTypes.Int,
+	txhash hmTypes.HeimdallHash,
+	logIndex uint64,
+	blockNumber uint64,
+) MsgValidatorReStake {
+	return MsgValidatorReStake{
+		From:        from,
+		ID:          hmTypes.NewValidatorID(id),
+		Amount:      amount,
+		Total:       total,
+		TxHash:      txhash,
+		LogIndex:    logIndex,
+		BlockNumber: blockNumber,
+	}
+}
+
+func (msg MsgValidatorReStake) Type() string {
+	return "validator-restake"
+}
+
+func (msg MsgValidatorReStake) Route() string {
+	return RouterKey
+}
+
+func (msg MsgValidatorReStake) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgValidatorReStake) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgValidatorReStake) ValidateBasic() sdk.Error {
+	if msg.ID <= 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
+	}
+
+	if msg.Total.I == nil || !msg.Total.IsPositive() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid total stake %v", msg.Total)
+	}
+
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.From.String())
+	}
+
+	return nil
+}
+
+// IsSideTxMsg marks validator restake as side tx msg, restaked event is validated by validators before power gets updated
+func (msg MsgValidatorReStake) IsSideTxMsg() bool {
+	return true
+}
+
+// GetTxHash Returns tx hash
+func (msg MsgValidatorReStake) GetTxHash() types.HeimdallHash {
+	return msg.TxHash
+}
+
+// GetLogIndex Returns log index
+func (msg MsgValidatorReStake) GetLogIndex() uint64 {
+	return msg.LogIndex
+}
+
+//
+// delegator bond
+//
+
+var _ sdk.Msg = &MsgDelegatorBond{}
+
+// MsgDelegatorBond represents delegator shares minted on mainchain
+type MsgDelegatorBond struct {
+	From        hmTypes.HeimdallAddress `json:"from"`
+	ID          hmTypes.ValidatorID     `json:"id"`
+	Delegator   hmTypes.HeimdallAddress `json:"delegator"`
+	Amount      hmTypes.Int             `json:"amount"`
+	Shares      hmTypes.Int             `json:"shares"`
+	TxHash      hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex    uint64                  `json:"log_index"`
+	BlockNumber uint64                  `json:"block_number"`
+}
+
+// NewMsgDelegatorBond creates new delegator bond msg
+func NewMsgDelegatorBond(
+	from hmTypes.HeimdallAddress,
+	id uint64,
+	delegator hmTypes.HeimdallAddress,
+	amount hmTypes.Int,
+	shares hmTypes.Int,
+	txhash hmTypes.HeimdallHash,
+	logIndex uint64,
+	blockNumber uint64,
+) MsgDelegatorBond {
+	return MsgDelegatorBond{
+		From:        from,
+		ID:          hmTypes.NewValidatorID(id),
+		Delegator:   delegator,
+		Amount:      amount,
+		Shares:      shares,
+		TxHash:      txhash,
+		LogIndex:    logIndex,
+		BlockNumber: blockNumber,
+	}
+}
+
+func (msg MsgDelegatorBond) Type() string {
+	return "delegator-bond"
+}
+
+func (msg MsgDelegatorBond) Route() string {
+	return RouterKey
+}
+
+func (msg MsgDelegatorBond) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgDelegatorBond) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgDelegatorBond) ValidateBasic() sdk.Error {
+	if msg.ID <= 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
+	}
+
+	if msg.Delegator.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid delegator %v", msg.Delegator.String())
+	}
+
+	if msg.Amount.I == nil || msg.Amount.IsNegative() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid amount %v", msg.Amount)
+	}
+
+	if msg.Shares.I == nil || msg.Shares.IsNegative() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid shares %v", msg.Shares)
+	}
+
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.From.String())
+	}
+
+	return nil
+}
+
+// IsSideTxMsg marks delegator bond as side tx msg, share minted event is validated by validators before delegation gets updated
+func (msg MsgDelegatorBond) IsSideTxMsg() bool {
+	return true
+}
+
+// GetTxHash Returns tx hash
+func (msg MsgDelegatorBond) GetTxHash() types.HeimdallHash {
+	return msg.TxHash
+}
+
+// GetLogIndex Returns log index
+func (msg MsgDelegatorBond) GetLogIndex() uint64 {
+	return msg.LogIndex
+}
+
+//
+// delegator unbond
+//
+
+var _ sdk.Msg = &MsgDelegatorUnbond{}
+
+// MsgDelegatorUnbond represents delegator shares burned on mainchain
+type MsgDelegatorUnbond struct {
+	From        hmTypes.HeimdallAddress `json:"from"`
+	ID          hmTypes.ValidatorID     `json:"id"`
+	Delegator   hmTypes.HeimdallAddress `json:"delegator"`
+	Amount      hmTypes.Int             `json:"amount"`
+	Shares      hmTypes.Int             `json:"shares"`
+	TxHash      hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex    uint64                  `json:"log_index"`
+	BlockNumber uint64                  `json:"block_number"`
+}
+
+// NewMsgDelegatorUnbond creates new delegator unbond msg
+func NewMsgDelegatorUnbond(
+	from hmTypes.HeimdallAddress,
+	id uint64,
+	delegator hmTypes.HeimdallAddress,
+	amount hmTypes.Int,
+	shares hmTypes.Int,
+	txhash hmTypes.HeimdallHash,
+	logIndex uint64,
+	blockNumber uint64,
+) MsgDelegatorUnbond {
+	return MsgDelegatorUnbond{
+		From:        from,
+		ID:          hmTypes.NewValidatorID(id),
+		Delegator:   delegator,
+		Amount:      amount,
+		Shares:      shares,
+		TxHash:      txhash,
+		LogIndex:    logIndex,
+		BlockNumber: blockNumber,
+	}
+}
+
+func (msg MsgDelegatorUnbond) Type() string {
+	return "delegator-unbond"
+}
+
+func (msg MsgDelegatorUnbond) Route() string {
+	return RouterKey
+}
+
+func (msg MsgDelegatorUnbond) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgDelegatorUnbond) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgDelegatorUnbond) ValidateBasic() sdk.Error {
+	if msg.ID <= 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
+	}
+
+	if msg.Delegator.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid delegator %v", msg.Delegator.String())
+	}
+
+	if msg.Amount.I == nil || msg.Amount.IsNegative() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid amount %v", msg.Amount)
+	}
+
+	if msg.Shares.I == nil || msg.Shares.IsNegative() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid shares %v", msg.Shares)
+	}
+
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.From.String())
+	}
+
+	return nil
+}
+
+// IsSideTxMsg marks delegator unbond as side tx msg, share burned event is validated by validators before delegation gets updated
+func (msg MsgDelegatorUnbond) IsSideTxMsg() bool {
+	return true
+}
+
+// GetTxHash Returns tx hash
+func (msg MsgDelegatorUnbond) GetTxHash() types.HeimdallHash {
+	return msg.TxHash
+}
+
+// GetLogIndex Returns log index
+func (msg MsgDelegatorUnbond) GetLogIndex() uint64 {
+	return msg.LogIndex
+}
+
+//
+// validator edit metadata
+//
+
+var _ sdk.Msg = &MsgEditValidatorMetadata{}
+
+// MsgEditValidatorMetadata represents validator metadata update signed by validator signer
+type MsgEditValidatorMetadata struct {
+	From     hmTypes.HeimdallAddress `json:"from"`
+	ID       hmTypes.ValidatorID     `json:"id"`
+	Moniker  string                  `json:"moniker"`
+	Website  string                  `json:"website"`
+	Contact  string                  `json:"contact"`
+	Identity string                  `json:"identity"`
+}
+
+// NewMsgEditValidatorMetadata creates new validator metadata msg
+func NewMsgEditValidatorMetadata(from hmTypes.HeimdallAddress, id uint64, moniker string, website string, contact string, identity string) MsgEditValidatorMetadata {
+	return MsgEditValidatorMetadata{
+		From:     from,
+		ID:       hmTypes.NewValidatorID(id),
+		Moniker:  moniker,
+		Website:  website,
+		Contact:  contact,
+		Identity: identity,
+	}
+}
+
+func (msg MsgEditValidatorMetadata) Type() string {
+	return "validator-edit-metadata"
+}
+
+func (msg MsgEditValidatorMetadata) Route() string {
+	return RouterKey
+}
+
+func (msg MsgEditValidatorMetadata) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgEditValidatorMetadata) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgEditValidatorMetadata) ValidateBasic() sdk.Error {
+	if msg.ID <= 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
+	}
+
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid signer %v", msg.From.String())
+	}
+
+	if err := msg.GetMetadata().Validate(); err != nil {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid metadata: %v", err)
+	}
+
+	return nil
+}
+
+// GetMetadata returns validator metadata in msg
+func (msg MsgEditValidatorMetadata) GetMetadata() ValidatorMetadata {
+	return NewValidatorMetadata(msg.ID, msg.Moniker, msg.Website, msg.Contact, msg.Identity)
+}
@@ -1,21 +1,120 @@
 package types
 
 import (
-	"github.com/cosmos/cosmos-sdk/x/params"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
 const (
 
 	// DefaultProposerBonusPercent - Proposer Signer Reward Ratio
 	DefaultProposerBonusPercent = int64(10)
+
+	// DefaultValidatorSetSnapshotRetention - number of ack counts validator set snapshots are kept for, 0 keeps all
+	DefaultValidatorSetSnapshotRetention = uint64(1000)
+
+	// DefaultMaxValidators - max number of validators in validator set, 0 means no limit
+	DefaultMaxValidators = uint64(0)
 )
 
 // ParamStoreKeyProposerBonusPercent - Store's Key for Reward amount
 var ParamStoreKeyProposerBonusPercent = []byte("proposerbonuspercent")
 
+// ParamStoreKeyValidatorSetSnapshotRetention - Store's Key for validator set snapshot retention
+var ParamStoreKeyValidatorSetSnapshotRetention = []byte("validatorsetsnapshotretention")
+
+// ParamStoreKeyMaxValidators - Store's Key for max validators
+var ParamStoreKeyMaxValidators = []byte("maxvalidators")
+
+// DefaultPowerReduction - stake amount backing one unit of voting power, 1 token
+var DefaultPowerReduction = hmTypes.NewIntWithDecimal(1, 18)
+
+// DefaultMinSelfStake - min stake of validator to be part of validator set, 0 means no limit
+var DefaultMinSelfStake = hmTypes.ZeroInt()
+
+// ParamStoreKeyPowerReduction - Store's Key for power reduction
+var ParamStoreKeyPowerReduction = []byte("powerreduction")
+
+// ParamStoreKeyMinSelfStake - Store's Key for min self stake
+var ParamStoreKeyMinSelfStake = []byte("minselfstake")
+
+var _ subspace.ParamSet = &Params{}
+
+// Params defines the parameters for the staking module.
+type Params struct {
+	MaxValidators                 uint64      `json:"max_validators" yaml:"max_validators"`
+	PowerReduction                hmTypes.Int `json:"power_reduction" yaml:"power_reduction"`
+	MinSelfStake                  hmTypes.Int `json:"min_self_stake" yaml:"min_self_stake"`
+	ValidatorSetSnapshotRetention uint64      `json:"validator_set_snapshot_retention" yaml:"validator_set_snapshot_retention"`
+}
+
+// NewParams creates a new Params object
+func NewParams(
+	maxValidators uint64,
+	powerReduction hmTypes.Int,
+	minSelfStake hmTypes.Int,
+	validatorSetSnapshotRetention uint64,
+) Params {
+	return Params{
+		MaxValidators:                 maxValidators,
+		PowerReduction:                powerReduction,
+		MinSelfStake:                  minSelfStake,
+		ValidatorSetSnapshotRetention: validatorSetSnapshotRetention,
+	}
+}
+
 // ParamKeyTable type declaration for parameters
-func ParamKeyTable() params.KeyTable {
-	return params.NewKeyTable(
+func ParamKeyTable() subspace.KeyTable {
+	return subspace.NewKeyTable(
 		ParamStoreKeyProposerBonusPercent, DefaultProposerBonusPercent,
-	)
+	).RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of staking module's parameters.
+func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
+	return subspace.ParamSetPairs{
+		{Key: ParamStoreKeyMaxValidators, Value: &p.MaxValidators},
+		{Key: ParamStoreKeyPowerReduction, Value: &p.PowerReduction},
+		{Key: ParamStoreKeyMinSelfStake, Value: &p.MinSelfStake},
+		{Key: ParamStoreKeyValidatorSetSnapshotRetention, Value: &p.ValidatorSetSnapshotRetention},
+	}
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return Params{
+		MaxValidators:                 DefaultMaxValidators,
+		PowerReduction:                DefaultPowerReduction,
+		MinSelfStake:                  DefaultMinSelfStake,
+		ValidatorSetSnapshotRetention: DefaultValidatorSetSnapshotRetention,
+	}
+}
+
+// String implements the stringer interface.
+func (p Params) String() string {
+	var sb strings.Builder
+	sb.WriteString("Params: \n")
+	sb.WriteString(fmt.Sprintf("MaxValidators: %d\n", p.MaxValidators))
+	sb.WriteString(fmt.Sprintf("PowerReduction: %s\n", p.PowerReduction))
+	sb.WriteString(fmt.Sprintf("MinSelfStake: %s\n", p.MinSelfStake))
+	sb.WriteString(fmt.Sprintf("ValidatorSetSnapshotRetention: %d\n", p.ValidatorSetSnapshotRetention))
+	return sb.String()
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if p.PowerReduction.I == nil || !p.PowerReduction.IsPositive() {
+		return fmt.Errorf("invalid power reduction: %s", p.PowerReduction)
+	}
+
+	if p.MinSelfStake.I == nil || p.MinSelfStake.IsNegative() {
+		return fmt.Errorf("invalid min self stake: %s", p.MinSelfStake)
+	}
+
+	return nil
 }
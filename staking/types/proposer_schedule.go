@@ -0,0 +1,22 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// ProposerProjection is expected checkpoint proposer and validator set at ack count
+type ProposerProjection struct {
+	AckCount         uint64               `json:"ack_count" yaml:"ack_count"`
+	Proposer         hmTypes.Validator    `json:"proposer" yaml:"proposer"`
+	ValidatorSetHash hmTypes.HeimdallHash `json:"validator_set_hash" yaml:"validator_set_hash"`
+}
+
+// String implements the stringer interface.
+func (p ProposerProjection) String() string {
+	return fmt.Sprintf("ProposerProjection{%v %v %v}",
+		p.AckCount,
+		p.Proposer.Signer.String(),
+		p.ValidatorSetHash.String())
+}
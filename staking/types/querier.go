@@ -9,6 +9,7 @@ import (
 
 // query endpoints supported by the staking Querier
 const (
+	QueryParams               = "params"
 	QueryCurrentValidatorSet  = "current-validator-set"
 	QuerySigner               = "signer"
 	QueryValidator            = "validator"
@@ -21,6 +22,12 @@ const (
 	QueryAccountProof         = "dividend-account-proof"
 	QueryVerifyAccountProof   = "verify-account-proof"
 	QuerySlashValidator       = "slash-validator"
+	QueryValidatorDelegations = "validator-delegations"
+	QueryDelegatorDelegations = "delegator-delegations"
+	QueryValidatorSetByAck    = "validator-set-by-ack"
+	QueryValidatorSetByHeight = "validator-set-by-height"
+	QueryValidators           = "validators"
+	QueryProposerSchedule     = "proposer-schedule"
 )
 
 // QuerySignerParams defines the params for querying by address
@@ -43,6 +50,48 @@ func NewQueryValidatorParams(validatorID types.ValidatorID) QueryValidatorParams
 	return QueryValidatorParams{ValidatorID: validatorID}
 }
 
+// QueryDelegatorParams defines the params for querying delegations of delegator
+type QueryDelegatorParams struct {
+	DelegatorAddress types.HeimdallAddress `json:"delegator_address"`
+}
+
+// NewQueryDelegatorParams creates a new instance of QueryDelegatorParams.
+func NewQueryDelegatorParams(delegatorAddress types.HeimdallAddress) QueryDelegatorParams {
+	return QueryDelegatorParams{DelegatorAddress: delegatorAddress}
+}
+
+// QueryValidatorSetAckParams defines the params for querying validator set snapshot by ack count
+type QueryValidatorSetAckParams struct {
+	AckCount uint64 `json:"ack_count"`
+}
+
+// NewQueryValidatorSetAckParams creates a new instance of QueryValidatorSetAckParams.
+func NewQueryValidatorSetAckParams(ackCount uint64) QueryValidatorSetAckParams {
+	return QueryValidatorSetAckParams{AckCount: ackCount}
+}
+
+// QueryValidatorSetHeightParams defines the params for querying validator set snapshot by block height
+type QueryValidatorSetHeightParams struct {
+	Height int64 `json:"height"`
+}
+
+// NewQueryValidatorSetHeightParams creates a new instance of QueryValidatorSetHeightParams.
+func NewQueryValidatorSetHeightParams(height int64) QueryValidatorSetHeightParams {
+	return QueryValidatorSetHeightParams{Height: height}
+}
+
+// QueryValidatorsParams defines the params for querying validator list
+type QueryValidatorsParams struct {
+	Status string `json:"status"` // empty status lists validators of all statuses
+	Page   uint64 `json:"page"`
+	Limit  uint64 `json:"limit"`
+}
+
+// NewQueryValidatorsParams creates a new instance of QueryValidatorsParams.
+func NewQueryValidatorsParams(status string, page uint64, limit uint64) QueryValidatorsParams {
+	return QueryValidatorsParams{Status: status, Page: page, Limit: limit}
+}
+
 // QueryDividendAccountParams defines the params for querying dividend account status.
 type QueryDividendAccountParams struct {
 	DividendAccountID types.DividendAccountID `json:"dividend_account_id"`
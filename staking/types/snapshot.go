@@ -0,0 +1,22 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// ValidatorSetSnapshot is validator set which became active at Height, while ack count was at AckCount
+type ValidatorSetSnapshot struct {
+	AckCount     uint64               `json:"ack_count" yaml:"ack_count"`
+	Height       int64                `json:"height" yaml:"height"` // height at which validator set was updated
+	ValidatorSet hmTypes.ValidatorSet `json:"validator_set" yaml:"validator_set"`
+}
+
+// String implements the stringer interface.
+func (s ValidatorSetSnapshot) String() string {
+	return fmt.Sprintf("ValidatorSetSnapshot{%v %v %v}",
+		s.AckCount,
+		s.Height,
+		s.ValidatorSet.String())
+}
@@ -0,0 +1,26 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// ValidatorStake is stake amount voting power of validator is derived from
+type ValidatorStake struct {
+	ID     hmTypes.ValidatorID `json:"id" yaml:"id"`
+	Amount hmTypes.Int         `json:"amount" yaml:"amount"`
+}
+
+// NewValidatorStake creates new validator stake
+func NewValidatorStake(id hmTypes.ValidatorID, amount hmTypes.Int) ValidatorStake {
+	return ValidatorStake{
+		ID:     id,
+		Amount: amount,
+	}
+}
+
+// String implements the stringer interface.
+func (s ValidatorStake) String() string {
+	return fmt.Sprintf("ValidatorStake{%v %v}", s.ID, s.Amount)
+}
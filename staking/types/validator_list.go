@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Validator statuses used to filter validator list
+const (
+	ValidatorStatusActive    = "active"
+	ValidatorStatusPending   = "pending"
+	ValidatorStatusStandby   = "standby"
+	ValidatorStatusUnbonding = "unbonding"
+	ValidatorStatusExited    = "exited"
+	ValidatorStatusJailed    = "jailed"
+)
+
+// DefaultValidatorListLimit is page size of validator list if limit is not provided
+const DefaultValidatorListLimit = 30
+
+// IsValidValidatorStatus checks if status is known validator status
+func IsValidValidatorStatus(status string) bool {
+	switch status {
+	case ValidatorStatusActive, ValidatorStatusPending, ValidatorStatusStandby, ValidatorStatusUnbonding, ValidatorStatusExited, ValidatorStatusJailed:
+		return true
+	}
+	return false
+}
+
+// GetValidatorStatus returns status of validator at ack count, selected tells if validator is in current validator set.
+// Unbonding validator has deactivation epoch set, but is still in current validator set.
+// Standby validator is valid for current epoch, but is left out by max validators or min self stake.
+func GetValidatorStatus(validator hmTypes.Validator, ackCount uint64, selected bool) string {
+	// current epoch will be ack count + 1
+	currentEpoch := ackCount + 1
+
+	switch {
+	case validator.Jailed:
+		return ValidatorStatusJailed
+	case validator.EndEpoch != 0 && validator.EndEpoch < currentEpoch:
+		return ValidatorStatusExited
+	case validator.VotingPower <= 0:
+		return ValidatorStatusExited
+	case validator.StartEpoch > currentEpoch:
+		return ValidatorStatusPending
+	case !selected:
+		return ValidatorStatusStandby
+	case validator.EndEpoch != 0:
+		return ValidatorStatusUnbonding
+	default:
+		return ValidatorStatusActive
+	}
+}
+
+// ValidatorList is page of validators with aggregate stake statistics of all validators
+type ValidatorList struct {
+	Validators     []hmTypes.Validator `json:"validators" yaml:"validators"`
+	ValidatorCount uint64              `json:"validator_count" yaml:"validator_count"` // number of validators matching status filter
+	ActivePower    int64               `json:"active_power" yaml:"active_power"`
+	UnbondingPower int64               `json:"unbonding_power" yaml:"unbonding_power"`
+	AckCount       uint64              `json:"ack_count" yaml:"ack_count"`
+}
+
+// String implements the stringer interface.
+func (l ValidatorList) String() string {
+	return fmt.Sprintf("ValidatorList{%v %v %v %v %v}",
+		len(l.Validators),
+		l.ValidatorCount,
+		l.ActivePower,
+		l.UnbondingPower,
+		l.AckCount)
+}
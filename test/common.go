@@ -81,36 +81,59 @@ func CreateTestInput(t *testing.T, isCheckTx bool) (sdk.Context, staking.Keeper,
 	//pulp := MakeTestPulp()
 	paramsKeeper := params.NewKeeper(cdc, keyParams, tKeyParams, common.DefaultCodespace)
 
-	dummyStakingKeeper := staking.Keeper{}
+	// staking keeper reads ack count through checkpoint keeper created below
+	var checkpointKeeper checkpoint.Keeper
 
-	checkpointKeeper := checkpoint.NewKeeper(
+	stakingKeeper := staking.NewKeeper(
 		cdc,
-		keyCheckpoint,
-		paramsKeeper.Subspace(checkpointTypes.DefaultParamspace),
+		keyStaking,
+		paramsKeeper.Subspace(stakingTypes.DefaultParamspace),
 		common.DefaultCodespace,
-		dummyStakingKeeper,
+		moduleCommunicator{&checkpointKeeper},
 	)
 
-	stakingKeeper := staking.NewKeeper(
+	checkpointKeeper = checkpoint.NewKeeper(
 		cdc,
-		keyStaking,
-		paramsKeeper.Subspace(stakingTypes.DefaultParamspace),
+		keyCheckpoint,
+		paramsKeeper.Subspace(checkpointTypes.DefaultParamspace),
 		common.DefaultCodespace,
-		checkpointKeeper,
+		stakingKeeper,
 	)
+	checkpointKeeper = *checkpointKeeper.SetHooks(checkpointTypes.NewMultiCheckpointHooks(stakingKeeper.CheckpointHooks()))
+	checkpointKeeper.SetParams(ctx, checkpointTypes.DefaultParams())
+
 	return ctx, stakingKeeper, checkpointKeeper
 }
 
+// moduleCommunicator returns ack count from checkpoint keeper
+type moduleCommunicator struct {
+	keeper *checkpoint.Keeper
+}
+
+// GetACKCount returns ack count
+func (r moduleCommunicator) GetACKCount(ctx sdk.Context) uint64 {
+	return r.keeper.GetACKCount(ctx)
+}
+
+// GetConfirmationBlocks returns confirmation blocks from checkpoint params
+func (r moduleCommunicator) GetConfirmationBlocks(ctx sdk.Context) uint64 {
+	return r.keeper.GetParams(ctx).ConfirmationBlocks
+}
+
+// RefundRelayerFee does nothing, test input has no fee collector
+func (r moduleCommunicator) RefundRelayerFee(ctx sdk.Context) (types.HeimdallAddress, sdk.Error) {
+	return types.HeimdallAddress{}, nil
+}
+
 // create random header block
 func GenRandCheckpointHeader(start int, headerSize int) (headerBlock types.CheckpointBlockHeader, err error) {
-	start = start
 	end := start + headerSize
-	roothash, err := checkpointTypes.GetHeaders(uint64(start), uint64(end))
+	roothash, err := checkpointTypes.GetHeaders(uint64(start), uint64(end), checkpointTypes.DefaultAvgCheckpointLength, checkpointTypes.DefaultLeafVersion)
 	if err != nil {
 		return headerBlock, err
 	}
 	proposer := ethcmn.Address{}
-	headerBlock = types.CreateBlock(uint64(start), uint64(end), types.HexToHeimdallHash(hex.EncodeToString(roothash)), types.HexToHeimdallHash(hex.EncodeToString(roothash)), types.HexToHeimdallAddress(proposer.String()), uint64(time.Now().UTC().Unix()))
+	headerBlock = types.CreateBlock(uint64(start), uint64(end), types.HexToHeimdallHash(hex.EncodeToString(roothash)), types.HexToHeimdallHash(hex.EncodeToString(roothash)), types.HexToHeimdallAddress(proposer.String()), uint64(time.Now().UTC().Unix()), checkpointTypes.DefaultLeafVersion)
 
 	return headerBlock, nil
 }
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// Delegation contains stake and shares of delegator bonded to validator on mainchain
+type Delegation struct {
+	ValidatorID ValidatorID     `json:"validator_id"`
+	Delegator   HeimdallAddress `json:"delegator"`
+	Amount      string          `json:"amount"` // string representation of big.Int
+	Shares      string          `json:"shares"` // string representation of big.Int
+	LastUpdated uint64          `json:"last_updated"`
+}
+
+// NewDelegation creates new delegation
+func NewDelegation(validatorID ValidatorID, delegator HeimdallAddress, amount string, shares string) Delegation {
+	return Delegation{
+		ValidatorID: validatorID,
+		Delegator:   delegator,
+		Amount:      amount,
+		Shares:      shares,
+	}
+}
+
+func (d *Delegation) String() string {
+	if d == nil {
+		return "nil-Delegation"
+	}
+
+	return fmt.Sprintf("Delegation{%v %v %v %v %v}",
+		d.ValidatorID,
+		d.Delegator.String(),
+		d.Amount,
+		d.Shares,
+		d.LastUpdated)
+}
+
+// MarshallDelegation - amino Marshall Delegation
+func MarshallDelegation(cdc *codec.Codec, delegation Delegation) (bz []byte, err error) {
+	bz, err = cdc.MarshalBinaryBare(delegation)
+	if err != nil {
+		return bz, err
+	}
+
+	return bz, nil
+}
+
+// UnMarshallDelegation - amino Unmarshall Delegation
+func UnMarshallDelegation(cdc *codec.Codec, value []byte) (Delegation, error) {
+	var delegation Delegation
+	err := cdc.UnmarshalBinaryBare(value, &delegation)
+	if err != nil {
+		return delegation, err
+	}
+	return delegation, nil
+}
+
+// SortDelegations - Sorts Delegations by validator ID and delegator address
+func SortDelegations(delegations []Delegation) []Delegation {
+	sort.Slice(delegations, func(i, j int) bool {
+		if delegations[i].ValidatorID != delegations[j].ValidatorID {
+			return delegations[i].ValidatorID < delegations[j].ValidatorID
+		}
+		return delegations[i].Delegator.String() < delegations[j].Delegator.String()
+	})
+	return delegations
+}
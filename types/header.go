@@ -13,10 +13,11 @@ type CheckpointBlockHeader struct {
 	RootHash        HeimdallHash    `json:"rootHash"`
 	AccountRootHash HeimdallHash    `json:"accountRootHash"`
 	TimeStamp       uint64          `json:"timestamp"`
+	Version         uint64          `json:"version"` // leaf version used to build root hash
 }
 
 // CreateBlock generate new block
-func CreateBlock(start uint64, end uint64, rootHash HeimdallHash, accountRootHash HeimdallHash, proposer HeimdallAddress, timestamp uint64) CheckpointBlockHeader {
+func CreateBlock(start uint64, end uint64, rootHash HeimdallHash, accountRootHash HeimdallHash, proposer HeimdallAddress, timestamp uint64, version uint64) CheckpointBlockHeader {
 	return CheckpointBlockHeader{
 		StartBlock:      start,
 		EndBlock:        end,
@@ -24,6 +25,7 @@ func CreateBlock(start uint64, end uint64, rootHash HeimdallHash, accountRootHas
 		AccountRootHash: accountRootHash,
 		Proposer:        proposer,
 		TimeStamp:       timestamp,
+		Version:         version,
 	}
 }
 
@@ -38,12 +40,13 @@ func SortHeaders(headers []CheckpointBlockHeader) []CheckpointBlockHeader {
 // String returns human redable string
 func (m CheckpointBlockHeader) String() string {
 	return fmt.Sprintf(
-		"CheckpointBlockHeader {%v (%d:%d) %v %v %v}",
+		"CheckpointBlockHeader {%v (%d:%d) %v %v %v v%v}",
 		m.Proposer.String(),
 		m.StartBlock,
 		m.EndBlock,
 		m.RootHash.Hex(),
 		m.AccountRootHash.Hex(),
 		m.TimeStamp,
+		m.Version,
 	)
 }
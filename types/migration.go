@@ -0,0 +1,73 @@
+package types
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ParseLegacyKeyID parses id from key suffix written in decimal format by older versions.
+// Returns false if suffix is not a decimal string (eg. already big endian).
+func ParseLegacyKeyID(prefix []byte, key []byte) (uint64, bool) {
+	suffix := key[len(prefix):]
+	if len(suffix) == 0 {
+		return 0, false
+	}
+
+	for _, c := range suffix {
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+	}
+
+	id, err := strconv.ParseUint(string(suffix), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return id, true
+}
+
+// MigrateLegacyKeysOnce migrates legacy keys with MigrateLegacyKeys unless migratedKey is already set in store.
+// Sets migratedKey afterwards, so migration runs only on the first call (eg. first block after upgrade).
+func MigrateLegacyKeysOnce(kvs sdk.KVStore, migratedKey []byte, prefix []byte, getKey func(id uint64) []byte) int {
+	if kvs.Has(migratedKey) {
+		return 0
+	}
+
+	migrated := MigrateLegacyKeys(kvs, prefix, getKey)
+	kvs.Set(migratedKey, []byte{0x01})
+	return migrated
+}
+
+// MigrateLegacyKeys moves all values stored under decimal keys with given prefix to keys generated by getKey.
+// Big endian keys have leading zero bytes for any realistic id, so already migrated keys are skipped.
+// Returns number of migrated keys.
+func MigrateLegacyKeys(kvs sdk.KVStore, prefix []byte, getKey func(id uint64) []byte) int {
+	type legacyEntry struct {
+		key   []byte
+		id    uint64
+		value []byte
+	}
+
+	// collect legacy entries first, store can't be modified while iterating
+	var entries []legacyEntry
+	iterator := sdk.KVStorePrefixIterator(kvs, prefix)
+	for ; iterator.Valid(); iterator.Next() {
+		if id, ok := ParseLegacyKeyID(prefix, iterator.Key()); ok {
+			entries = append(entries, legacyEntry{
+				key:   iterator.Key(),
+				id:    id,
+				value: iterator.Value(),
+			})
+		}
+	}
+	iterator.Close()
+
+	for _, entry := range entries {
+		kvs.Delete(entry.key)
+		kvs.Set(getKey(entry.id), entry.value)
+	}
+
+	return len(entries)
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestMigrateLegacyKeys(t *testing.T) {
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	prefix := []byte{0x13}
+	getKey := func(id uint64) []byte {
+		return append(prefix, sdk.Uint64ToBigEndian(id)...)
+	}
+
+	// legacy decimal keys
+	for i := uint64(1); i <= 12; i++ {
+		id := i * 10000
+		store.Set(append(prefix, []byte(strconv.FormatUint(id, 10))...), []byte(strconv.FormatUint(id, 10)))
+	}
+
+	require.Equal(t, 12, MigrateLegacyKeys(store, prefix, getKey))
+	require.Equal(t, 0, MigrateLegacyKeys(store, prefix, getKey), "migration should be a no-op once keys are migrated")
+
+	// migration with flag runs once
+	migratedKey := []byte{0x14}
+	store.Set(append(prefix, []byte("120000")...), []byte("120000"))
+	require.Equal(t, 1, MigrateLegacyKeysOnce(store, migratedKey, prefix, getKey))
+	require.True(t, store.Has(migratedKey))
+	store.Set(append(prefix, []byte("130000")...), []byte("130000"))
+	require.Equal(t, 0, MigrateLegacyKeysOnce(store, migratedKey, prefix, getKey), "migration should not run again once flagged")
+	store.Delete(append(prefix, []byte("130000")...))
+
+	// values must now be iterated in numeric order
+	iterator := KVStorePrefixIteratorPaginated(store, prefix, 2, 5)
+	defer iterator.Close()
+
+	expected := uint64(60000)
+	for ; iterator.Valid(); iterator.Next() {
+		require.Equal(t, getKey(expected), iterator.Key())
+		require.Equal(t, strconv.FormatUint(expected, 10), string(iterator.Value()))
+		expected += 10000
+	}
+	require.Equal(t, uint64(110000), expected)
+}
@@ -12,3 +12,12 @@ type HeimdallModuleBasic interface {
 	// verify genesis
 	VerifyGenesis(map[string]json.RawMessage) error
 }
+
+// SideModule is a module which handles side tx msgs
+type SideModule interface {
+	// side tx handler
+	NewSideTxHandler() SideTxHandler
+
+	// post tx handler
+	NewPostTxHandler() PostTxHandler
+}
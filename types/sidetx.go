@@ -0,0 +1,89 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SideTxResultType represents vote of a validator on side tx
+type SideTxResultType byte
+
+// Side tx results
+const (
+	SideTxResultSkip SideTxResultType = 0x00
+	SideTxResultYes  SideTxResultType = 0x01
+	SideTxResultNo   SideTxResultType = 0x02
+)
+
+// sideTxResultNames maps side tx result with its name
+var sideTxResultNames = map[SideTxResultType]string{
+	SideTxResultSkip: "skip",
+	SideTxResultYes:  "yes",
+	SideTxResultNo:   "no",
+}
+
+// SideTxResultFromString returns side tx result from its name
+func SideTxResultFromString(str string) (SideTxResultType, error) {
+	for result, name := range sideTxResultNames {
+		if strings.EqualFold(name, str) {
+			return result, nil
+		}
+	}
+
+	return SideTxResultSkip, fmt.Errorf("'%s' is not a valid side tx result", str)
+}
+
+// IsValid returns true if side tx result is known
+func (r SideTxResultType) IsValid() bool {
+	_, ok := sideTxResultNames[r]
+	return ok
+}
+
+// String implements the Stringer interface
+func (r SideTxResultType) String() string {
+	if name, ok := sideTxResultNames[r]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown(%d)", byte(r))
+}
+
+// MarshalJSON marshals side tx result to its name
+func (r SideTxResultType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.String())
+}
+
+// UnmarshalJSON unmarshals side tx result from its name
+func (r *SideTxResultType) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+
+	result, err := SideTxResultFromString(str)
+	if err != nil {
+		return err
+	}
+
+	*r = result
+	return nil
+}
+
+// SideTxMsg is a msg which depends on data from external chains.
+// It gets applied only after 2/3+ of voting power agrees on external data.
+type SideTxMsg interface {
+	sdk.Msg
+
+	// IsSideTxMsg marks msg as side tx msg
+	IsSideTxMsg() bool
+}
+
+// SideTxHandler checks external data for side tx msg and returns vote of current validator.
+// It runs off the consensus path, so it can call external chains.
+type SideTxHandler func(ctx sdk.Context, msg sdk.Msg) SideTxResultType
+
+// PostTxHandler applies side tx msg to the state once voting on it is finished.
+// It runs during consensus, so it must not call external chains.
+type PostTxHandler func(ctx sdk.Context, msg sdk.Msg, result SideTxResultType) sdk.Result
@@ -21,7 +21,9 @@ type Validator struct {
 	Signer      HeimdallAddress `json:"signer"`
 	LastUpdated uint64          `json:"last_updated"`
 
-	ProposerPriority int64 `json:"accum"`
+	ProposerPriority int64  `json:"accum"`
+	Jailed           bool   `json:"jailed"`
+	JailExitEpoch    uint64 `json:"jail_exit_epoch"` // epoch from which jailed validator can unjail on mainchain
 }
 
 func NewValidator(id ValidatorID, startEpoch uint64, endEpoch uint64, power int64, pubKey PubKey, signer HeimdallAddress) *Validator {
@@ -48,6 +50,11 @@ func (v *Validator) IsCurrentValidator(ackCount uint64) bool {
 	// current epoch will be ack count + 1
 	currentEpoch := ackCount + 1
 
+	// jailed validator stays out of validator set
+	if v.Jailed {
+		return false
+	}
+
 	// validator hasnt initialised unstake
 	if v.StartEpoch <= currentEpoch && (v.EndEpoch == 0 || v.EndEpoch >= currentEpoch) && v.VotingPower > 0 {
 		return true
@@ -197,7 +204,7 @@ func (valID ValidatorID) Uint64() uint64 {
 
 // Uint64 converts validator ID to int
 func (valID ValidatorID) String() string {
-	return string(valID)
+	return strconv.FormatUint(valID.Uint64(), 10)
 }
 
 // --------